@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// APIKeyPrefix 是个人 API key 的前缀，用于和 ChatGPT accessToken 区分
+const APIKeyPrefix = "cgc_"
+
+// apiKeyDisplayLength 是列表中展示的 key 开头部分的长度
+const apiKeyDisplayLength = len(APIKeyPrefix) + 8
+
+// NewAPIKey 生成一个新的 API key，返回 key、用于展示的开头部分和保存的哈希值
+//
+// key 只在创建时返回给用户一次，数据库中只保存哈希值。
+func NewAPIKey() (key, prefix, hash string, err error) {
+	secret, err := RandomString()
+	if err != nil {
+		return "", "", "", err
+	}
+	key = APIKeyPrefix + secret
+	return key, key[:apiKeyDisplayLength], HashAPIKey(key), nil
+}
+
+// HashAPIKey 计算 API key 的哈希值
+//
+// key 包含 256 位随机数，不需要加盐和慢哈希，使用 SHA-256 即可按哈希值查询。
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAPIKey 判断 Authorization header 中的值是否为个人 API key
+func IsAPIKey(value string) bool {
+	return strings.HasPrefix(value, APIKeyPrefix)
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSigner(t *testing.T) {
+	s, err := NewSigner([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token := s.Sign(PurposeSession, "user-1", "", time.Now().Add(time.Hour))
+	if subject, err := s.Verify(token, PurposeSession, ""); err != nil || subject != "user-1" {
+		t.Errorf("Verify() = %q, %v", subject, err)
+	}
+	if Subject(token) != "user-1" {
+		t.Errorf("Subject() = %q", Subject(token))
+	}
+	if _, err := s.Verify(token, PurposeResetPassword, ""); err != ErrInvalidToken {
+		t.Errorf("token must not be valid for another purpose: %v", err)
+	}
+	if _, err := s.Verify(token, PurposeSession, "changed"); err != ErrInvalidToken {
+		t.Errorf("token must not be valid after stamp changed: %v", err)
+	}
+
+	other, _ := NewSigner([]byte("other"))
+	if _, err := other.Verify(token, PurposeSession, ""); err != ErrInvalidToken {
+		t.Errorf("token must not be valid with another key: %v", err)
+	}
+
+	expired := s.Sign(PurposeSession, "user-1", "", time.Now().Add(-time.Minute))
+	if _, err := s.Verify(expired, PurposeSession, ""); err != ErrExpiredToken {
+		t.Errorf("expired token: %v", err)
+	}
+}
+
+func TestPassword(t *testing.T) {
+	if _, err := HashPassword("short"); err != ErrWeakPassword {
+		t.Errorf("short password: %v", err)
+	}
+	hash, err := HashPassword("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !CheckPassword(hash, "correct horse") {
+		t.Error("password doesn't match")
+	}
+	if CheckPassword(hash, "wrong horse") || CheckPassword("", "") {
+		t.Error("wrong password matches")
+	}
+}
+
+func TestAPIKey(t *testing.T) {
+	key, prefix, hash, err := NewAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsAPIKey(key) || !IsAPIKey(prefix) || key[:len(prefix)] != prefix {
+		t.Errorf("NewAPIKey() = %q, %q", key, prefix)
+	}
+	if HashAPIKey(key) != hash {
+		t.Error("hash doesn't match")
+	}
+	if other, _, _, _ := NewAPIKey(); other == key {
+		t.Error("api keys must be random")
+	}
+}
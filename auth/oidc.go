@@ -0,0 +1,395 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidIDToken ID Token 的签名或声明不正确
+var ErrInvalidIDToken = errors.New("auth: invalid id token")
+
+// ClaimMapping 是 ID Token 中的声明到论坛用户字段的映射，为空时使用标准声明
+type ClaimMapping struct {
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	Image  string `json:"image"`
+	Groups string `json:"groups"`
+}
+
+// OIDCConfig 是 OpenID Connect 登录的配置
+type OIDCConfig struct {
+	// Issuer 是身份提供方的地址，通过 Issuer + /.well-known/openid-configuration 获取接口地址
+	Issuer       string   `json:"issuer"`
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	RedirectURL  string   `json:"redirect_url"`
+	Scopes       []string `json:"scopes"`
+	// Claims 是声明的映射，例如将 Keycloak 的 roles 映射为 groups
+	Claims ClaimMapping `json:"claims"`
+}
+
+// Identity 是身份提供方认证的用户
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Image         string
+	Groups        []string
+}
+
+// ID 返回用户在所有身份提供方中唯一的 ID
+func (i *Identity) ID() string {
+	return i.Issuer + "#" + i.Subject
+}
+
+// discovery 是 /.well-known/openid-configuration 中使用的部分
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// OIDCProvider 使用授权码模式（PKCE）登录 OpenID Connect 身份提供方
+//
+// 接口地址在第一次使用时获取，签名密钥在遇到未知的 kid 时重新获取，只支持 RS256 签名。
+type OIDCProvider struct {
+	config *OIDCConfig
+	client *http.Client
+
+	mutex     sync.Mutex
+	discovery *discovery
+	keys      map[string]*rsa.PublicKey
+}
+
+// NewOIDCProvider 创建一个 OIDCProvider
+func NewOIDCProvider(config *OIDCConfig) *OIDCProvider {
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &OIDCProvider{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+		keys:   make(map[string]*rsa.PublicKey),
+	}
+}
+
+// RandomString 返回一个随机的 URL 安全字符串，用作 state、nonce 和 PKCE verifier
+func RandomString() (string, error) {
+	bs := make([]byte, 32)
+	if _, err := rand.Read(bs); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+func (p *OIDCProvider) getJSON(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	return p.do(req, v)
+}
+
+func (p *OIDCProvider) do(req *http.Request, v interface{}) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("auth: %s %s: %s", req.Method, req.URL.Path, resp.Status)
+	}
+	return json.Unmarshal(bs, v)
+}
+
+// endpoints 获取身份提供方的接口地址
+func (p *OIDCProvider) endpoints(ctx context.Context) (*discovery, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var d discovery
+	issuer := strings.TrimRight(p.config.Issuer, "/")
+	if err := p.getJSON(ctx, issuer+"/.well-known/openid-configuration", &d); err != nil {
+		return nil, err
+	}
+	if strings.TrimRight(d.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("auth: issuer mismatch: %s", d.Issuer)
+	}
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+// AuthCodeURL 返回身份提供方的登录地址
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	d, err := p.endpoints(ctx)
+	if err != nil {
+		return "", err
+	}
+	challenge := sha256.Sum256([]byte(verifier))
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.config.ClientID},
+		"redirect_uri":          {p.config.RedirectURL},
+		"scope":                 {strings.Join(p.config.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+	sep := "?"
+	if strings.Contains(d.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return d.AuthorizationEndpoint + sep + query.Encode(), nil
+}
+
+// Exchange 使用授权码获取并验证 ID Token，返回认证的用户
+func (p *OIDCProvider) Exchange(ctx context.Context, code, nonce, verifier string) (*Identity, error) {
+	d, err := p.endpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"client_id":     {p.config.ClientID},
+		"code_verifier": {verifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		IDToken     string `json:"id_token"`
+	}
+	if err = p.do(req, &token); err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, ErrInvalidIDToken
+	}
+
+	claims, err := p.verify(ctx, d, token.IDToken)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, ErrInvalidIDToken
+	}
+
+	// ID Token 中没有映射的声明时，从 userinfo 接口获取
+	mapping := p.mapping()
+	if d.UserinfoEndpoint != "" && token.AccessToken != "" && claims[mapping.Email] == nil {
+		var userinfo map[string]interface{}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.UserinfoEndpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+		if err = p.do(req, &userinfo); err != nil {
+			return nil, err
+		}
+		if userinfo["sub"] == claims["sub"] {
+			for k, v := range userinfo {
+				if _, ok := claims[k]; !ok {
+					claims[k] = v
+				}
+			}
+		}
+	}
+
+	return p.identity(d.Issuer, claims), nil
+}
+
+func (p *OIDCProvider) mapping() ClaimMapping {
+	m := p.config.Claims
+	if m.Email == "" {
+		m.Email = "email"
+	}
+	if m.Name == "" {
+		m.Name = "name"
+	}
+	if m.Image == "" {
+		m.Image = "picture"
+	}
+	if m.Groups == "" {
+		m.Groups = "groups"
+	}
+	return m
+}
+
+// identity 根据声明映射生成用户
+func (p *OIDCProvider) identity(issuer string, claims map[string]interface{}) *Identity {
+	m := p.mapping()
+	i := &Identity{Issuer: issuer}
+	i.Subject, _ = claims["sub"].(string)
+	i.Email, _ = claims[m.Email].(string)
+	i.EmailVerified, _ = claims["email_verified"].(bool)
+	i.Name, _ = claims[m.Name].(string)
+	i.Image, _ = claims[m.Image].(string)
+	switch groups := claims[m.Groups].(type) {
+	case []interface{}:
+		for _, g := range groups {
+			if s, ok := g.(string); ok {
+				i.Groups = append(i.Groups, s)
+			}
+		}
+	case string:
+		i.Groups = strings.Fields(groups)
+	}
+	return i
+}
+
+// verify 验证 ID Token 的签名、签发者、受众和有效期，返回其中的声明
+func (p *OIDCProvider) verify(ctx context.Context, d *discovery, idToken string) (map[string]interface{}, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidIDToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "RS256" {
+		return nil, ErrInvalidIDToken
+	}
+	key, err := p.key(ctx, d, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidIDToken
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) != nil {
+		return nil, ErrInvalidIDToken
+	}
+
+	var claims map[string]interface{}
+	if err = decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrInvalidIDToken
+	}
+	if iss, _ := claims["iss"].(string); iss != d.Issuer {
+		return nil, ErrInvalidIDToken
+	}
+	if !hasAudience(claims["aud"], p.config.ClientID) {
+		return nil, ErrInvalidIDToken
+	}
+	if exp, _ := claims["exp"].(float64); time.Now().Unix() > int64(exp) {
+		return nil, ErrExpiredToken
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return nil, ErrInvalidIDToken
+	}
+	return claims, nil
+}
+
+func hasAudience(aud interface{}, clientID string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == clientID
+	case []interface{}:
+		for _, a := range aud {
+			if a == clientID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	bs, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, v)
+}
+
+// key 获取 kid 对应的签名公钥，未知的 kid 会重新获取 jwks
+func (p *OIDCProvider) key(ctx context.Context, d *discovery, kid string) (*rsa.PublicKey, error) {
+	p.mutex.Lock()
+	key, ok := p.keys[kid]
+	p.mutex.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := p.getJSON(ctx, d.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	p.mutex.Lock()
+	p.keys = keys
+	p.mutex.Unlock()
+
+	if key, ok = keys[kid]; !ok {
+		return nil, ErrInvalidIDToken
+	}
+	return key, nil
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// mockIdP 是测试使用的 OpenID Connect 身份提供方
+type mockIdP struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]interface{}
+	// challenge 是登录时的 code_challenge，兑换授权码时检查 code_verifier
+	challenge string
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp := &mockIdP{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.URL,
+			"authorization_endpoint": idp.URL + "/authorize",
+			"token_endpoint":         idp.URL + "/token",
+			"jwks_uri":               idp.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		sum := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+		if r.FormValue("code") != "code" || base64.RawURLEncoding.EncodeToString(sum[:]) != idp.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "access",
+			"id_token":     idp.sign(t, idp.claims),
+		})
+	})
+	idp.Server = httptest.NewServer(mux)
+	return idp
+}
+
+func (idp *mockIdP) sign(t *testing.T, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "test"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, idp.key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestOIDCProvider(t *testing.T) {
+	idp := newMockIdP(t)
+	defer idp.Close()
+
+	p := NewOIDCProvider(&OIDCConfig{
+		Issuer:      idp.URL,
+		ClientID:    "community",
+		RedirectURL: "http://localhost/callback",
+		Claims:      ClaimMapping{Groups: "roles"},
+	})
+	ctx := context.Background()
+
+	authURL, err := p.AuthCodeURL(ctx, "state", "nonce", "verifier")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(authURL)
+	if u.Query().Get("state") != "state" || u.Query().Get("code_challenge_method") != "S256" {
+		t.Fatalf("AuthCodeURL() = %s", authURL)
+	}
+	idp.challenge = u.Query().Get("code_challenge")
+
+	idp.claims = map[string]interface{}{
+		"iss":            idp.URL,
+		"aud":            "community",
+		"sub":            "alice",
+		"exp":            time.Now().Add(time.Minute).Unix(),
+		"nonce":          "nonce",
+		"email":          "alice@example.com",
+		"email_verified": true,
+		"name":           "Alice",
+		"roles":          []string{"moderator"},
+	}
+	identity, err := p.Exchange(ctx, "code", "nonce", "verifier")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity.ID() != idp.URL+"#alice" || identity.Email != "alice@example.com" || !identity.EmailVerified ||
+		identity.Name != "Alice" || len(identity.Groups) != 1 || identity.Groups[0] != "moderator" {
+		t.Errorf("Exchange() = %+v", identity)
+	}
+
+	if _, err = p.Exchange(ctx, "code", "other", "verifier"); err != ErrInvalidIDToken {
+		t.Errorf("nonce mismatch: %v", err)
+	}
+	if _, err = p.Exchange(ctx, "code", "nonce", "wrong"); err == nil {
+		t.Error("PKCE verifier mismatch must fail")
+	}
+
+	idp.claims["aud"] = "other"
+	if _, err = p.Exchange(ctx, "code", "nonce", "verifier"); err != ErrInvalidIDToken {
+		t.Errorf("audience mismatch: %v", err)
+	}
+	idp.claims["aud"] = "community"
+	idp.claims["exp"] = time.Now().Add(-time.Minute).Unix()
+	if _, err = p.Exchange(ctx, "code", "nonce", "verifier"); err != ErrExpiredToken {
+		t.Errorf("expired id token: %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MinPasswordLength 是密码的最小长度
+const MinPasswordLength = 8
+
+// ErrWeakPassword 密码长度不足或超过 bcrypt 的上限
+var ErrWeakPassword = errors.New("auth: password must be 8 to 72 bytes")
+
+// HashPassword 使用 bcrypt 计算密码的哈希值
+func HashPassword(password string) (string, error) {
+	if utf8.RuneCountInString(password) < MinPasswordLength || len(password) > 72 {
+		return "", ErrWeakPassword
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword 判断密码是否与哈希值匹配
+func CheckPassword(hash, password string) bool {
+	if hash == "" {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// PasswordStamp 返回密码哈希的摘要，作为重置密码令牌的 stamp
+//
+// 密码修改后 stamp 改变，已经使用过的重置密码令牌随之失效。
+func PasswordStamp(hash string) string {
+	if len(hash) < 16 {
+		return hash
+	}
+	return hash[len(hash)-16:]
+}
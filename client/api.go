@@ -0,0 +1,439 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// OpenAPI 获取接口的 OpenAPI 文档
+func (c *Client) OpenAPI(ctx context.Context) (json.RawMessage, error) {
+	var spec json.RawMessage
+	err := c.do(ctx, "getOpenAPI", &request{}, &spec)
+	return spec, err
+}
+
+// UpdateCaptcha 更新 Cloudflare 验证的 cf_clearance，需要 moderation.manage 权限
+func (c *Client) UpdateCaptcha(ctx context.Context, cfClearance, userAgent string) error {
+	body := map[string]string{"cfClearance": cfClearance, "userAgent": userAgent}
+	return c.do(ctx, "postCaptcha", &request{Body: body}, nil)
+}
+
+// UpdateChatGPTSession 使用 ChatGPT 的 sessionToken 更新上游凭据
+func (c *Client) UpdateChatGPTSession(ctx context.Context, sessionToken string) (*Session, error) {
+	var session Session
+	header := http.Header{"Authorization": {sessionToken}}
+	err := c.do(ctx, "updateChatGPTSession", &request{Header: header}, &session)
+	return &session, err
+}
+
+// Register 使用邮箱和密码注册本地账号，需要验证邮箱后才能登录
+func (c *Client) Register(ctx context.Context, name, email, password string) (*User, error) {
+	var u User
+	body := map[string]string{"name": name, "email": email, "password": password}
+	err := c.do(ctx, "postAccountRegister", &request{Body: body}, &u)
+	return &u, err
+}
+
+// VerifyEmail 使用验证邮件中的令牌验证邮箱
+func (c *Client) VerifyEmail(ctx context.Context, token string) error {
+	return c.do(ctx, "getAccountVerify", &request{Query: url.Values{"token": {token}}}, nil)
+}
+
+// Login 使用邮箱和密码登录，HTTPClient 需要设置 cookie jar 保存会话 cookie
+func (c *Client) Login(ctx context.Context, email, password string) (*User, error) {
+	var u User
+	body := map[string]string{"email": email, "password": password}
+	err := c.do(ctx, "postAccountLogin", &request{Body: body}, &u)
+	return &u, err
+}
+
+// Logout 退出登录
+func (c *Client) Logout(ctx context.Context) error {
+	return c.do(ctx, "postAccountLogout", &request{}, nil)
+}
+
+// ForgotPassword 发送重置密码的邮件
+func (c *Client) ForgotPassword(ctx context.Context, email string) error {
+	return c.do(ctx, "postAccountPasswordForgot", &request{Body: map[string]string{"email": email}}, nil)
+}
+
+// ResetPassword 使用重置密码邮件中的令牌设置新密码
+func (c *Client) ResetPassword(ctx context.Context, token, password string) error {
+	body := map[string]string{"token": token, "password": password}
+	return c.do(ctx, "postAccountPasswordReset", &request{Body: body}, nil)
+}
+
+// OIDCLoginURL 返回 OpenID Connect 登录的地址，需要在浏览器中打开，
+// 身份提供方登录后会跳转到 getOIDCCallback 接口
+func (c *Client) OIDCLoginURL() string {
+	return c.BaseURL + operations["getOIDCLogin"].Path
+}
+
+// ExportData 在后台导出当前用户的全部数据，已经有正在生成的导出时返回该任务
+func (c *Client) ExportData(ctx context.Context) (*DataExport, error) {
+	var e DataExport
+	err := c.do(ctx, "postAccountExport", &request{}, &e)
+	return &e, err
+}
+
+// GetDataExports 获取当前用户未过期的导出任务
+func (c *Client) GetDataExports(ctx context.Context) ([]*DataExport, error) {
+	var exports []*DataExport
+	err := c.do(ctx, "getAccountExports", &request{}, &exports)
+	return exports, err
+}
+
+// DownloadDataExport 下载导出的 zip 归档，调用方需要关闭返回的 io.ReadCloser
+func (c *Client) DownloadDataExport(ctx context.Context, id string) (io.ReadCloser, error) {
+	req, err := c.newRequest(ctx, "getAccountExportDownload", &request{ID: id})
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// RequestAccountDeletion 申请注销当前账号，返回的令牌需要在有效期内传给 DeleteAccount
+func (c *Client) RequestAccountDeletion(ctx context.Context) (*AccountDeletion, error) {
+	var d AccountDeletion
+	err := c.do(ctx, "postAccountDeletion", &request{}, &d)
+	return &d, err
+}
+
+// DeleteAccount 使用确认令牌注销当前账号
+func (c *Client) DeleteAccount(ctx context.Context, token string) error {
+	return c.do(ctx, "deleteAccount", &request{Body: map[string]string{"token": token}}, nil)
+}
+
+// PostConversation 向 ChatGPT 提问，等待完整的回复
+//
+// 提问或回复等待人工审核时，返回结果的 Moderation 不为空。
+func (c *Client) PostConversation(ctx context.Context, body *ConversationRequest) (*ConversationResult, error) {
+	req, err := c.newRequest(ctx, "postConversation", &request{Body: body})
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var result ConversationResult
+	if res.StatusCode == http.StatusAccepted {
+		err = json.NewDecoder(res.Body).Decode(&result)
+	} else {
+		err = json.NewDecoder(res.Body).Decode(&result.Message)
+	}
+	return &result, err
+}
+
+// GetConversation 获取一个会话的消息
+func (c *Client) GetConversation(ctx context.Context, id string) ([]*Message, error) {
+	var messages []*Message
+	err := c.do(ctx, "getConversation", &request{Query: url.Values{"id": {id}}}, &messages)
+	return messages, err
+}
+
+// ExportConversation 导出会话中以 messageID 结尾的分支，调用方需要关闭返回的 io.ReadCloser
+//
+// format 为 md、html、json 或 txt，为空时导出 Markdown；messageID 为空时导出最新消息所在的分支。
+func (c *Client) ExportConversation(ctx context.Context, id, format, messageID string) (io.ReadCloser, error) {
+	query := url.Values{}
+	if format != "" {
+		query.Set("format", format)
+	}
+	if messageID != "" {
+		query.Set("message_id", messageID)
+	}
+	req, err := c.newRequest(ctx, "getConversationExport", &request{ID: id, Query: query})
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// GetConversations 获取当前用户的会话，按最后活动时间倒序
+func (c *Client) GetConversations(ctx context.Context, page int) ([]*Conversation, error) {
+	var conversations []*Conversation
+	err := c.do(ctx, "getConversations", &request{Query: pageQuery("", "", page)}, &conversations)
+	return conversations, err
+}
+
+// GetDeletedConversations 获取当前用户回收站中的会话，按删除时间倒序
+func (c *Client) GetDeletedConversations(ctx context.Context, page int) ([]*Conversation, error) {
+	var conversations []*Conversation
+	err := c.do(ctx, "getConversations", &request{Query: pageQuery("deleted", "true", page)}, &conversations)
+	return conversations, err
+}
+
+// ImportConversations 导入 ChatGPT 导出数据中的 conversations.json，options 为 nil 时只导入不发布
+func (c *Client) ImportConversations(ctx context.Context, conversationsJSON io.Reader, options *ImportOptions) ([]*ImportResult, error) {
+	bs, err := io.ReadAll(conversationsJSON)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	if options != nil {
+		for _, id := range options.Publish {
+			query.Add("publish", id)
+		}
+		if options.Category != "" {
+			query.Set("category", options.Category)
+		}
+		if options.DryRun {
+			query.Set("dry_run", "true")
+		}
+	}
+	var results []*ImportResult
+	err = c.do(ctx, "postConversationImport", &request{Query: query, Body: json.RawMessage(bs)}, &results)
+	return results, err
+}
+
+// UpdateConversation 修改自己的会话的标题或系统提示，为 nil 的字段不修改
+func (c *Client) UpdateConversation(ctx context.Context, id string, body *ConversationUpdateRequest) (*Conversation, error) {
+	var conversation Conversation
+	err := c.do(ctx, "patchConversation", &request{ID: id, Body: body}, &conversation)
+	return &conversation, err
+}
+
+// DeleteConversation 删除自己的会话，会话进入回收站
+func (c *Client) DeleteConversation(ctx context.Context, id string) error {
+	return c.do(ctx, "deleteConversation", &request{ID: id}, nil)
+}
+
+// RestoreConversation 从回收站恢复自己的会话
+func (c *Client) RestoreConversation(ctx context.Context, id string) error {
+	return c.do(ctx, "postConversationRestore", &request{ID: id}, nil)
+}
+
+// DeleteOwnMessage 删除自己的一条消息
+func (c *Client) DeleteOwnMessage(ctx context.Context, id string) error {
+	return c.do(ctx, "deleteMessage", &request{ID: id}, nil)
+}
+
+// RestoreOwnMessage 恢复自己删除的一条消息
+func (c *Client) RestoreOwnMessage(ctx context.Context, id string) error {
+	return c.do(ctx, "postMessageRestore", &request{ID: id}, nil)
+}
+
+// GetMessage 获取一条消息
+func (c *Client) GetMessage(ctx context.Context, id string) (*Message, error) {
+	var message Message
+	err := c.do(ctx, "getMessage", &request{Query: url.Values{"id": {id}}}, &message)
+	return &message, err
+}
+
+// PostTopic 发布或修改会话对应的主题
+func (c *Client) PostTopic(ctx context.Context, body *TopicRequest) (*Topic, error) {
+	var t Topic
+	err := c.do(ctx, "postTopic", &request{Body: body}, &t)
+	return &t, err
+}
+
+// DeleteOwnTopic 删除自己发布的主题
+func (c *Client) DeleteOwnTopic(ctx context.Context, id string) error {
+	return c.do(ctx, "deleteTopic", &request{ID: id}, nil)
+}
+
+// RestoreOwnTopic 恢复自己删除的主题
+func (c *Client) RestoreOwnTopic(ctx context.Context, id string) error {
+	return c.do(ctx, "postTopicRestore", &request{ID: id}, nil)
+}
+
+// PostShare 创建会话的只读分享
+func (c *Client) PostShare(ctx context.Context, conversationID, title string) (*Share, error) {
+	var s Share
+	body := map[string]string{"conversation_id": conversationID, "title": title}
+	err := c.do(ctx, "postShare", &request{Body: body}, &s)
+	return &s, err
+}
+
+// DeleteShare 撤销一个自己创建的分享
+func (c *Client) DeleteShare(ctx context.Context, id string) error {
+	return c.do(ctx, "deleteShare", &request{Query: url.Values{"id": {id}}}, nil)
+}
+
+// PostReport 举报消息或主题
+func (c *Client) PostReport(ctx context.Context, body *ReportRequest) (*Report, error) {
+	var r Report
+	err := c.do(ctx, "postReport", &request{Body: body}, &r)
+	return &r, err
+}
+
+// GetAPIKeys 获取当前用户未撤销的 API key
+func (c *Client) GetAPIKeys(ctx context.Context) ([]*APIKey, error) {
+	var keys []*APIKey
+	err := c.do(ctx, "getAPIKeys", &request{}, &keys)
+	return keys, err
+}
+
+// PostAPIKey 创建 API key，返回结果中的 Key 只返回一次
+func (c *Client) PostAPIKey(ctx context.Context, body *APIKeyRequest) (*APIKey, error) {
+	var k APIKey
+	err := c.do(ctx, "postAPIKey", &request{Body: body}, &k)
+	return &k, err
+}
+
+// RotateAPIKey 轮换 API key，旧的 key 立即失效
+func (c *Client) RotateAPIKey(ctx context.Context, id int) (*APIKey, error) {
+	var k APIKey
+	err := c.do(ctx, "postAPIKeyRotate", &request{ID: strconv.Itoa(id)}, &k)
+	return &k, err
+}
+
+// RevokeAPIKey 撤销 API key
+func (c *Client) RevokeAPIKey(ctx context.Context, id int) error {
+	return c.do(ctx, "deleteAPIKey", &request{ID: strconv.Itoa(id)}, nil)
+}
+
+// pageQuery 创建分页查询参数，status 为空时使用服务端的默认值
+func pageQuery(name, value string, page int) url.Values {
+	query := url.Values{}
+	if value != "" {
+		query.Set(name, value)
+	}
+	if page > 1 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	return query
+}
+
+// GetModerations 获取审核记录，review 为空时返回等待审核的队列
+func (c *Client) GetModerations(ctx context.Context, review string, page int) ([]*Moderation, error) {
+	var moderations []*Moderation
+	err := c.do(ctx, "getModerations", &request{Query: pageQuery("review", review, page)}, &moderations)
+	return moderations, err
+}
+
+// ReviewModeration 通过或拒绝一条等待审核的记录
+func (c *Client) ReviewModeration(ctx context.Context, id int, approve bool) (*Moderation, error) {
+	var m Moderation
+	action := "reject"
+	if approve {
+		action = "approve"
+	}
+	body := map[string]string{"action": action}
+	err := c.do(ctx, "postModerationReview", &request{ID: strconv.Itoa(id), Body: body}, &m)
+	return &m, err
+}
+
+// GetReports 获取举报列表，status 为空时返回未处理的举报
+func (c *Client) GetReports(ctx context.Context, status string, page int) ([]*Report, error) {
+	var reports []*Report
+	err := c.do(ctx, "getReports", &request{Query: pageQuery("status", status, page)}, &reports)
+	return reports, err
+}
+
+// TriageReport 处理一个举报，action 为 resolve 或 dismiss
+func (c *Client) TriageReport(ctx context.Context, id int, action string) (*Report, error) {
+	var r Report
+	body := map[string]string{"action": action}
+	err := c.do(ctx, "postReportTriage", &request{ID: strconv.Itoa(id), Body: body}, &r)
+	return &r, err
+}
+
+// SetMessageHidden 隐藏或公开一条消息
+func (c *Client) SetMessageHidden(ctx context.Context, id string, hidden bool) error {
+	body := map[string]bool{"hidden": hidden}
+	return c.do(ctx, "postAdminMessageHidden", &request{ID: id, Body: body}, nil)
+}
+
+// DeleteMessage 删除一条消息
+func (c *Client) DeleteMessage(ctx context.Context, id string) error {
+	return c.do(ctx, "deleteAdminMessage", &request{ID: id}, nil)
+}
+
+// RestoreMessage 恢复一条被删除的消息
+func (c *Client) RestoreMessage(ctx context.Context, id string) error {
+	return c.do(ctx, "postAdminMessageRestore", &request{ID: id}, nil)
+}
+
+// SetTopicHidden 隐藏或公开一个主题
+func (c *Client) SetTopicHidden(ctx context.Context, id string, hidden bool) error {
+	body := map[string]bool{"hidden": hidden}
+	return c.do(ctx, "postAdminTopicHidden", &request{ID: id, Body: body}, nil)
+}
+
+// SetTopicLocked 锁定或解锁一个主题
+func (c *Client) SetTopicLocked(ctx context.Context, id string, locked bool) error {
+	body := map[string]bool{"locked": locked}
+	return c.do(ctx, "postAdminTopicLocked", &request{ID: id, Body: body}, nil)
+}
+
+// DeleteTopic 删除一个主题
+func (c *Client) DeleteTopic(ctx context.Context, id string) error {
+	return c.do(ctx, "deleteAdminTopic", &request{ID: id}, nil)
+}
+
+// RestoreTopic 恢复一个被删除的主题
+func (c *Client) RestoreTopic(ctx context.Context, id string) error {
+	return c.do(ctx, "postAdminTopicRestore", &request{ID: id}, nil)
+}
+
+// GetAuditEvents 查询审计记录
+func (c *Client) GetAuditEvents(ctx context.Context, filter *AuditFilter, page int) ([]*AuditEvent, error) {
+	query := pageQuery("", "", page)
+	for name, value := range map[string]string{
+		"actor":       filter.ActorID,
+		"action":      filter.Action,
+		"target_type": filter.TargetType,
+		"target_id":   filter.TargetID,
+		"ip":          filter.IP,
+	} {
+		if value != "" {
+			query.Set(name, value)
+		}
+	}
+	if !filter.Since.IsZero() {
+		query.Set("since", filter.Since.Format(time.RFC3339))
+	}
+	if !filter.Until.IsZero() {
+		query.Set("until", filter.Until.Format(time.RFC3339))
+	}
+
+	var events []*AuditEvent
+	err := c.do(ctx, "getAuditEvents", &request{Query: query}, &events)
+	return events, err
+}
+
+// ExportCategory 将一个分类中所有公开的主题导出为 zip 归档，调用方需要关闭返回的 io.ReadCloser
+func (c *Client) ExportCategory(ctx context.Context, category, format string) (io.ReadCloser, error) {
+	query := url.Values{"category": {category}}
+	if format != "" {
+		query.Set("format", format)
+	}
+	req, err := c.newRequest(ctx, "getAdminExport", &request{Query: query})
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// BanUser 封禁或解封一个用户
+func (c *Client) BanUser(ctx context.Context, id string, banned bool, reason string) error {
+	body := map[string]interface{}{"banned": banned, "reason": reason}
+	return c.do(ctx, "postAdminUserBan", &request{ID: id, Body: body}, nil)
+}
+
+// SetUserRole 授予用户论坛角色，role 为空时清除授予的角色
+func (c *Client) SetUserRole(ctx context.Context, id, role string) error {
+	body := map[string]string{"role": role}
+	return c.do(ctx, "postAdminUserRole", &request{ID: id, Body: body}, nil)
+}
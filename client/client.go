@@ -0,0 +1,204 @@
+// Package client 是 ChatGPT Community REST API 的 Go 客户端
+//
+// 接口与 restapi/openapi.json 保持一致，client_test.go 会检查每个接口都有对应的方法。
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Error 是接口返回的错误
+type Error struct {
+	StatusCode int         `json:"-"`
+	Code       string      `json:"code"`
+	Message    string      `json:"message"`
+	Details    interface{} `json:"details,omitempty"`
+	RequestID  string      `json:"request_id"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d %s: %s (request id: %s)", e.StatusCode, e.Code, e.Message, e.RequestID)
+}
+
+// Client 调用 ChatGPT Community 的接口
+//
+// 使用 APIKey 认证时，每个请求都会带上 Authorization header；
+// 使用邮箱和密码登录时，HTTPClient 需要设置 cookie jar 保存会话 cookie。
+type Client struct {
+	// BaseURL 是站点的根地址，例如 https://example.com
+	BaseURL string
+	// APIKey 是以 cgc_ 开头的个人 API key
+	APIKey string
+	// HTTPClient 是发送请求使用的 http.Client，为空时使用 http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// New 创建一个使用个人 API key 认证的客户端
+func New(baseURL, apiKey string) *Client {
+	return &Client{BaseURL: strings.TrimRight(baseURL, "/"), APIKey: apiKey}
+}
+
+// operation 是 OpenAPI 文档中的一个接口
+type operation struct {
+	Method string
+	Path   string
+}
+
+// operations 是 OpenAPI 文档中所有接口的 operationId 到方法和路径的映射
+var operations = map[string]operation{
+	"getOpenAPI":                {http.MethodGet, "/api/v1/openapi.json"},
+	"postCaptcha":               {http.MethodPost, "/api/v1/captcha"},
+	"updateChatGPTSession":      {http.MethodGet, "/api/v1/session"},
+	"postAccountRegister":       {http.MethodPost, "/api/v1/account/register"},
+	"getAccountVerify":          {http.MethodGet, "/api/v1/account/verify"},
+	"postAccountLogin":          {http.MethodPost, "/api/v1/account/login"},
+	"postAccountLogout":         {http.MethodPost, "/api/v1/account/logout"},
+	"postAccountPasswordForgot": {http.MethodPost, "/api/v1/account/password/forgot"},
+	"postAccountPasswordReset":  {http.MethodPost, "/api/v1/account/password/reset"},
+	"getOIDCLogin":              {http.MethodGet, "/api/v1/account/oidc/login"},
+	"getOIDCCallback":           {http.MethodGet, "/api/v1/account/oidc/callback"},
+	"postAccountExport":         {http.MethodPost, "/api/v1/account/exports"},
+	"getAccountExports":         {http.MethodGet, "/api/v1/account/exports"},
+	"getAccountExportDownload":  {http.MethodGet, "/api/v1/account/exports/{id}/download"},
+	"postAccountDeletion":       {http.MethodPost, "/api/v1/account/deletion"},
+	"deleteAccount":             {http.MethodDelete, "/api/v1/account"},
+	"postConversation":          {http.MethodPost, "/api/v1/conversation"},
+	"getConversation":           {http.MethodGet, "/api/v1/conversation"},
+	"getConversationExport":     {http.MethodGet, "/api/v1/conversation/{id}/export"},
+	"getConversations":          {http.MethodGet, "/api/v1/conversations"},
+	"postConversationImport":    {http.MethodPost, "/api/v1/conversations/import"},
+	"patchConversation":         {http.MethodPatch, "/api/v1/conversations/{id}"},
+	"deleteConversation":        {http.MethodDelete, "/api/v1/conversations/{id}"},
+	"postConversationRestore":   {http.MethodPost, "/api/v1/conversations/{id}/restore"},
+	"getMessage":                {http.MethodGet, "/api/v1/message"},
+	"deleteMessage":             {http.MethodDelete, "/api/v1/messages/{id}"},
+	"postMessageRestore":        {http.MethodPost, "/api/v1/messages/{id}/restore"},
+	"postTopic":                 {http.MethodPost, "/api/v1/topic"},
+	"deleteTopic":               {http.MethodDelete, "/api/v1/topics/{id}"},
+	"postTopicRestore":          {http.MethodPost, "/api/v1/topics/{id}/restore"},
+	"postShare":                 {http.MethodPost, "/api/v1/share"},
+	"deleteShare":               {http.MethodDelete, "/api/v1/share"},
+	"postReport":                {http.MethodPost, "/api/v1/report"},
+	"getAPIKeys":                {http.MethodGet, "/api/v1/keys"},
+	"postAPIKey":                {http.MethodPost, "/api/v1/keys"},
+	"postAPIKeyRotate":          {http.MethodPost, "/api/v1/keys/{id}/rotate"},
+	"deleteAPIKey":              {http.MethodDelete, "/api/v1/keys/{id}"},
+	"getModerations":            {http.MethodGet, "/api/v1/admin/moderations"},
+	"postModerationReview":      {http.MethodPost, "/api/v1/admin/moderations/{id}"},
+	"getReports":                {http.MethodGet, "/api/v1/admin/reports"},
+	"postReportTriage":          {http.MethodPost, "/api/v1/admin/reports/{id}"},
+	"postAdminMessageHidden":    {http.MethodPost, "/api/v1/admin/messages/{id}/hidden"},
+	"deleteAdminMessage":        {http.MethodDelete, "/api/v1/admin/messages/{id}"},
+	"postAdminMessageRestore":   {http.MethodPost, "/api/v1/admin/messages/{id}/restore"},
+	"postAdminTopicHidden":      {http.MethodPost, "/api/v1/admin/topics/{id}/hidden"},
+	"postAdminTopicLocked":      {http.MethodPost, "/api/v1/admin/topics/{id}/locked"},
+	"deleteAdminTopic":          {http.MethodDelete, "/api/v1/admin/topics/{id}"},
+	"postAdminTopicRestore":     {http.MethodPost, "/api/v1/admin/topics/{id}/restore"},
+	"getAuditEvents":            {http.MethodGet, "/api/v1/admin/audit"},
+	"getAdminExport":            {http.MethodGet, "/api/v1/admin/export"},
+	"postAdminUserBan":          {http.MethodPost, "/api/v1/admin/users/{id}/ban"},
+	"postAdminUserRole":         {http.MethodPost, "/api/v1/admin/users/{id}/role"},
+}
+
+// request 是一次接口调用的参数
+type request struct {
+	ID     string
+	Query  url.Values
+	Header http.Header
+	Body   interface{}
+}
+
+// newRequest 创建接口请求，路径中的 {id} 替换为 r.ID
+func (c *Client) newRequest(ctx context.Context, operationID string, r *request) (*http.Request, error) {
+	op, ok := operations[operationID]
+	if !ok {
+		return nil, fmt.Errorf("client: unknown operation %s", operationID)
+	}
+	u := c.BaseURL + strings.Replace(op.Path, "{id}", url.PathEscape(r.ID), 1)
+	if len(r.Query) > 0 {
+		u += "?" + r.Query.Encode()
+	}
+
+	var body io.Reader
+	if r.Body != nil {
+		bs, err := json.Marshal(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(bs)
+	}
+	req, err := http.NewRequestWithContext(ctx, op.Method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range r.Header {
+		req.Header[name] = values
+	}
+	if r.Body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.APIKey != "" && req.Header.Get("Authorization") == "" {
+		req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	}
+	return req, nil
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// send 发送请求，状态码不是 2xx 时返回 *Error
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	res, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return res, nil
+	}
+	defer res.Body.Close()
+	return nil, decodeError(res)
+}
+
+// decodeError 解析接口返回的错误，不是 JSON 格式时使用响应的内容作为 message
+func decodeError(res *http.Response) error {
+	bs, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	e := &Error{StatusCode: res.StatusCode}
+	if json.Unmarshal(bs, e) != nil || e.Code == "" {
+		e.Code = http.StatusText(res.StatusCode)
+		e.Message = strings.TrimSpace(string(bs))
+		e.RequestID = res.Header.Get("X-Request-ID")
+	}
+	return e
+}
+
+// do 调用接口，out 不为空时解析 JSON 格式的响应
+func (c *Client) do(ctx context.Context, operationID string, r *request, out interface{}) error {
+	req, err := c.newRequest(ctx, operationID, r)
+	if err != nil {
+		return err
+	}
+	res, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if out == nil {
+		_, err = io.Copy(io.Discard, res.Body)
+		return err
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestOperationsMatchOpenAPI 检查客户端的接口和 restapi/openapi.json 是否一致
+func TestOperationsMatchOpenAPI(t *testing.T) {
+	bs, err := os.ReadFile("../restapi/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+		} `json:"paths"`
+	}
+	if err = json.Unmarshal(bs, &spec); err != nil {
+		t.Fatal(err)
+	}
+
+	documented := make(map[string]bool)
+	for path, methods := range spec.Paths {
+		for method, op := range methods {
+			documented[op.OperationID] = true
+			got, ok := operations[op.OperationID]
+			if !ok {
+				t.Errorf("operation %s (%s %s) is missing", op.OperationID, strings.ToUpper(method), path)
+				continue
+			}
+			if got.Method != strings.ToUpper(method) || got.Path != path {
+				t.Errorf("operation %s = %s %s, want %s %s", op.OperationID, got.Method, got.Path, strings.ToUpper(method), path)
+			}
+		}
+	}
+	for id := range operations {
+		if !documented[id] {
+			t.Errorf("operation %s is not documented", id)
+		}
+	}
+}
+
+func TestClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer cgc_test" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"code": "unauthorized", "message": "Authorization is required", "request_id": "1"})
+			return
+		}
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v1/message":
+			if r.URL.Query().Get("id") != "m1" {
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(map[string]string{"code": "not_found", "message": "conversation not found", "request_id": "2"})
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]string{"id": "m1", "role": "user", "content": "hi", "content_html": "<p>hi</p>"})
+		case "POST /api/v1/keys/7/rotate":
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 8, "name": "cli", "prefix": "cgc_abcd", "key": "cgc_new"})
+		case "POST /api/v1/conversation":
+			var body ConversationRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			w.Header().Set("Content-Type", "text/event-stream")
+			for _, part := range []string{"Hel", "Hello"} {
+				fmt.Fprintf(w, "data:{\"message\":{\"id\":\"a1\",\"role\":\"assistant\",\"content\":{\"content_type\":\"text\",\"parts\":[%q]}},\"conversation_id\":\"c1\"}\n\n", part)
+			}
+			fmt.Fprint(w, "event:moderation\ndata:{\"decision\":\"hold\",\"reason\":\""+body.Prompt+"\"}\n\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	if _, err := New(server.URL, "").GetMessage(ctx, "m1"); !isCode(err, "unauthorized") {
+		t.Errorf("without api key: %v", err)
+	}
+
+	c := New(server.URL+"/", "cgc_test")
+	m, err := c.GetMessage(ctx, "m1")
+	if err != nil || m.ContentHTML != "<p>hi</p>" {
+		t.Errorf("GetMessage() = %+v, %v", m, err)
+	}
+	if _, err = c.GetMessage(ctx, "m2"); !isCode(err, "not_found") {
+		t.Errorf("GetMessage(m2) error = %v", err)
+	}
+
+	k, err := c.RotateAPIKey(ctx, 7)
+	if err != nil || k.ID != 8 || k.Key != "cgc_new" {
+		t.Errorf("RotateAPIKey() = %+v, %v", k, err)
+	}
+
+	var events []*Event
+	err = c.PostConversationStream(ctx, &ConversationRequest{Prompt: "flagged"}, func(e *Event) error {
+		events = append(events, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 || events[1].Chunk.Message.Content.Parts[0] != "Hello" ||
+		events[2].Name != "moderation" || events[2].Moderation.Reason != "flagged" {
+		t.Errorf("PostConversationStream() events = %+v", events)
+	}
+}
+
+func isCode(err error, code string) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Code == code
+}
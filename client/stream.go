@@ -0,0 +1,96 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Event 是流模式下的一个 SSE 事件，根据 Name 只有一个字段不为空
+type Event struct {
+	// Name 为 message、moderation 或 error
+	Name string
+	// Chunk 是 message 事件的回复片段
+	Chunk *ChatResponseBody
+	// Moderation 是 moderation 事件中回复的审核结果
+	Moderation *ModerationResult
+	// Error 是开始回复后发生的错误
+	Error *Error
+}
+
+// PostConversationStream 向 ChatGPT 提问，以 text/event-stream 流模式接收回复
+//
+// 每收到一个事件调用一次 handle，handle 返回错误时停止接收。
+// 提问等待人工审核时服务端不会以流模式回复，handle 收到一个 moderation 事件。
+func (c *Client) PostConversationStream(ctx context.Context, body *ConversationRequest, handle func(*Event) error) error {
+	header := http.Header{"Accept": {"text/event-stream"}}
+	req, err := c.newRequest(ctx, "postConversation", &request{Header: header, Body: body})
+	if err != nil {
+		return err
+	}
+	res, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusAccepted {
+		var result ConversationResult
+		if err = json.NewDecoder(res.Body).Decode(&result); err != nil {
+			return err
+		}
+		return handle(&Event{Name: "moderation", Moderation: result.Moderation})
+	}
+
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	name, data := "", ""
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if data != "" {
+				event, err := decodeEvent(name, data, res.StatusCode)
+				if err != nil {
+					return err
+				}
+				if err = handle(event); err != nil {
+					return err
+				}
+			}
+			name, data = "", ""
+		case strings.HasPrefix(line, "event:"):
+			name = strings.TrimPrefix(strings.TrimPrefix(line, "event:"), " ")
+		case strings.HasPrefix(line, "data:"):
+			if data != "" {
+				data += "\n"
+			}
+			data += strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " ")
+		}
+	}
+	return scanner.Err()
+}
+
+// decodeEvent 解析一个 SSE 事件的数据
+func decodeEvent(name, data string, statusCode int) (*Event, error) {
+	if name == "" {
+		name = "message"
+	}
+	event := &Event{Name: name}
+	var err error
+	switch name {
+	case "message":
+		err = json.Unmarshal([]byte(data), &event.Chunk)
+	case "moderation":
+		err = json.Unmarshal([]byte(data), &event.Moderation)
+	case "error":
+		event.Error = &Error{StatusCode: statusCode}
+		err = json.Unmarshal([]byte(data), event.Error)
+	default:
+		err = fmt.Errorf("client: unknown event %s", name)
+	}
+	return event, err
+}
@@ -0,0 +1,287 @@
+package client
+
+import "time"
+
+// User 是论坛用户
+type User struct {
+	ID              string     `json:"id"`
+	Name            string     `json:"name,omitempty"`
+	Email           string     `json:"Email,omitempty"`
+	Image           string     `json:"image,omitempty"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	OpenaiID        *string    `json:"openai_id,omitempty"`
+	OidcID          *string    `json:"oidc_id,omitempty"`
+	Groups          []string   `json:"groups,omitempty"`
+	Features        []string   `json:"features,omitempty"`
+	Role            *string    `json:"role,omitempty"`
+	BannedAt        *time.Time `json:"banned_at,omitempty"`
+	BanReason       string     `json:"ban_reason,omitempty"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// DataExport 是用户数据的导出任务，Status 为 ready 时可以下载
+type DataExport struct {
+	ID          string    `json:"id"`
+	Status      string    `json:"status"`
+	Size        int64     `json:"size,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	DownloadURL string    `json:"download_url,omitempty"`
+}
+
+// AccountDeletion 是申请注销账号的结果，Token 用于确认注销
+type AccountDeletion struct {
+	Token   string    `json:"token"`
+	Policy  string    `json:"policy"`
+	Expires time.Time `json:"expires"`
+}
+
+// ImportOptions 是导入 ChatGPT 导出数据的选项，Publish 包含 all 时发布所有导入的会话
+type ImportOptions struct {
+	Publish  []string
+	Category string
+	DryRun   bool
+}
+
+// ImportResult 是导入一个会话的结果
+type ImportResult struct {
+	ConversationID string `json:"conversation_id,omitempty"`
+	UpstreamID     string `json:"upstream_id"`
+	Title          string `json:"title"`
+	Status         string `json:"status"`
+	Added          int    `json:"added"`
+	TopicID        string `json:"topic_id,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// Session 是更新 ChatGPT 会话的结果
+type Session struct {
+	User    *User     `json:"user"`
+	Expires time.Time `json:"expires"`
+}
+
+// Message 是会话中的一条消息
+type Message struct {
+	ID              string `json:"id"`
+	Content         string `json:"content"`
+	ContentType     string `json:"content_type,omitempty"`
+	Role            string `json:"role"`
+	ConversationID  string `json:"conversation_id,omitempty"`
+	ParentMessageID string `json:"parent_message_id,omitempty"`
+	Hidden          bool   `json:"hidden,omitempty"`
+	// Status 是提问所在这一轮的状态，pending 和 streaming 的提问还在等待回复
+	Status    string    `json:"status,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// DeletedAt 不为空时消息已经被删除，Content 为空，只作为会话中的占位
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ContentHTML 是服务端渲染的 Markdown 内容
+	ContentHTML string `json:"content_html"`
+}
+
+// Conversation 是用户和 ChatGPT 的一个会话
+type Conversation struct {
+	ID string `json:"id"`
+	// UpstreamID 是 ChatGPT 中的会话 ID，为空的会话不能继续提问
+	UpstreamID string `json:"upstream_id,omitempty"`
+	Title      string `json:"title"`
+	// CustomTitle 为 true 时标题由用户修改过，不再自动生成
+	CustomTitle  bool   `json:"custom_title,omitempty"`
+	Model        string `json:"model,omitempty"`
+	SystemPrompt string `json:"system_prompt,omitempty"`
+	Visibility   string `json:"visibility"`
+	// Summary 是自动生成的一段会话摘要
+	Summary             string    `json:"summary,omitempty"`
+	MessageCount        int       `json:"message_count"`
+	SummaryMessageCount int       `json:"summary_message_count,omitempty"`
+	LastActivityAt      time.Time `json:"last_activity_at"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+	// DeletedAt 不为空时会话在回收站中
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy string     `json:"deleted_by,omitempty"`
+}
+
+// ConversationUpdateRequest 是修改会话的请求，为 nil 的字段不修改
+type ConversationUpdateRequest struct {
+	Title        *string `json:"title,omitempty"`
+	SystemPrompt *string `json:"system_prompt,omitempty"`
+}
+
+// ConversationRequest 是向 ChatGPT 提问的请求
+//
+// 继续已有的会话时需要同时提供 ConversationID 和 ParentMessageID。
+type ConversationRequest struct {
+	Prompt          string `json:"prompt"`
+	ConversationID  string `json:"conversation_id,omitempty"`
+	ParentMessageID string `json:"parent_message_id,omitempty"`
+	Model           string `json:"model,omitempty"`
+}
+
+// ModerationResult 是提问或回复的审核结果
+type ModerationResult struct {
+	Decision string `json:"decision"`
+	Reason   string `json:"reason,omitempty"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// ConversationResult 是提问的结果，等待审核时 Moderation 不为空
+type ConversationResult struct {
+	Message    *Message          `json:"message"`
+	Moderation *ModerationResult `json:"moderation,omitempty"`
+}
+
+// ChatResponseContent 是流式回复的内容
+type ChatResponseContent struct {
+	ContentType string   `json:"content_type"`
+	Parts       []string `json:"parts"`
+}
+
+// ChatResponseMessage 是流式回复中的消息
+type ChatResponseMessage struct {
+	ID         string               `json:"id"`
+	Role       string               `json:"role"`
+	CreateTime string               `json:"create_time,omitempty"`
+	UpdateTime string               `json:"update_time,omitempty"`
+	Content    *ChatResponseContent `json:"content"`
+	EndTurn    interface{}          `json:"end_turn,omitempty"`
+	Weight     float64              `json:"weight"`
+	Recipient  string               `json:"recipient"`
+}
+
+// ChatResponseBody 是流式回复的一个片段，Parts 是到目前为止的完整回复
+type ChatResponseBody struct {
+	Message        *ChatResponseMessage `json:"message"`
+	ConversationID string               `json:"conversation_id"`
+	Error          string               `json:"error,omitempty"`
+}
+
+// Topic 是会话发布的主题
+type Topic struct {
+	ID             string     `json:"id"`
+	ConversationID string     `json:"conversation_id"`
+	Title          string     `json:"title"`
+	Summary        string     `json:"summary,omitempty"`
+	Category       string     `json:"category,omitempty"`
+	Visibility     string     `json:"visibility,omitempty"`
+	Hidden         bool       `json:"hidden,omitempty"`
+	Locked         bool       `json:"locked,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy 是删除者的用户 ID，作者只能恢复自己删除的主题
+	DeletedBy string `json:"deleted_by,omitempty"`
+}
+
+// TopicRequest 是发布或修改主题的请求
+type TopicRequest struct {
+	ConversationID string `json:"conversation_id"`
+	Title          string `json:"title"`
+	Visibility     string `json:"visibility,omitempty"`
+	Category       string `json:"category,omitempty"`
+}
+
+// SnapshotMessage 是分享中的消息快照
+type SnapshotMessage struct {
+	ID              string    `json:"id"`
+	Role            string    `json:"role"`
+	Content         string    `json:"content"`
+	ContentType     string    `json:"content_type"`
+	ParentMessageID string    `json:"parent_message_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// Share 是会话的只读分享
+type Share struct {
+	ID             string             `json:"id"`
+	ConversationID string             `json:"conversation_id"`
+	Title          string             `json:"title,omitempty"`
+	Summary        string             `json:"summary,omitempty"`
+	Messages       []*SnapshotMessage `json:"messages,omitempty"`
+	RevokedAt      *time.Time         `json:"revoked_at,omitempty"`
+	CreatedAt      time.Time          `json:"created_at"`
+}
+
+// Report 是消息或主题的举报
+type Report struct {
+	ID         int        `json:"id"`
+	TargetType string     `json:"target_type"`
+	TargetID   string     `json:"target_id"`
+	Reason     string     `json:"reason"`
+	Detail     string     `json:"detail,omitempty"`
+	Status     string     `json:"status,omitempty"`
+	HandlerID  string     `json:"handler_id,omitempty"`
+	HandledAt  *time.Time `json:"handled_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// ReportRequest 是举报的请求
+type ReportRequest struct {
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	Reason     string `json:"reason"`
+	Detail     string `json:"detail,omitempty"`
+}
+
+// APIKey 是个人 API key，Key 只在创建和轮换时返回
+type APIKey struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Key        string     `json:"key,omitempty"`
+}
+
+// APIKeyRequest 是创建 API key 的请求，ExpiresIn 是有效期的天数，为 0 时永不过期
+type APIKeyRequest struct {
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes,omitempty"`
+	ExpiresIn int      `json:"expires_in,omitempty"`
+}
+
+// Moderation 是一条审核记录
+type Moderation struct {
+	ID             int        `json:"id"`
+	Stage          string     `json:"stage"`
+	Content        string     `json:"content"`
+	Decision       string     `json:"decision"`
+	Reason         string     `json:"reason,omitempty"`
+	Provider       string     `json:"provider,omitempty"`
+	Review         string     `json:"review"`
+	MessageID      string     `json:"message_id,omitempty"`
+	ConversationID string     `json:"conversation_id,omitempty"`
+	ReviewerID     string     `json:"reviewer_id,omitempty"`
+	ReviewedAt     *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// AuditEvent 是一条审计记录
+type AuditEvent struct {
+	ID         int                    `json:"id"`
+	Action     string                 `json:"action"`
+	TargetType string                 `json:"target_type,omitempty"`
+	TargetID   string                 `json:"target_id,omitempty"`
+	IP         string                 `json:"ip,omitempty"`
+	UserAgent  string                 `json:"user_agent,omitempty"`
+	Payload    map[string]interface{} `json:"payload,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// AuditFilter 是查询审计记录的过滤条件，Action 以 . 结尾时按前缀匹配
+type AuditFilter struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	IP         string
+	Since      time.Time
+	Until      time.Time
+}
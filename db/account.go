@@ -0,0 +1,208 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/apikey"
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/credential"
+	"community.threetenth.chatgpt/ent/dataexport"
+	"community.threetenth.chatgpt/ent/message"
+	entmoderation "community.threetenth.chatgpt/ent/moderation"
+	"community.threetenth.chatgpt/ent/report"
+	"community.threetenth.chatgpt/ent/share"
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/ent/user"
+	"github.com/google/uuid"
+)
+
+// CreateLocalUser 创建一个使用邮箱和密码登录的本地账号，邮箱在验证之前不能登录
+func (s *SQLStore) CreateLocalUser(ctx context.Context, name, email, passwordHash string) (*ent.User, error) {
+	return s.client.User.Create().
+		SetID(uuid.NewString()).
+		SetName(name).
+		SetEmail(email).
+		SetPasswordHash(passwordHash).
+		Save(ctx)
+}
+
+// GetUserByEmail 获取指定邮箱最早注册的用户
+func (s *SQLStore) GetUserByEmail(ctx context.Context, email string) (*ent.User, error) {
+	return s.client.User.Query().
+		Where(user.Email(email)).
+		Order(ent.Asc(user.FieldCreatedAt)).
+		First(ctx)
+}
+
+// GetUserByOpenAIID 获取关联了指定 ChatGPT 账号的用户
+func (s *SQLStore) GetUserByOpenAIID(ctx context.Context, openaiID string) (*ent.User, error) {
+	return s.client.User.Query().
+		Where(user.OpenaiID(openaiID)).
+		Only(ctx)
+}
+
+// VerifyUserEmail 标记用户的邮箱已经验证
+func (s *SQLStore) VerifyUserEmail(ctx context.Context, id string) error {
+	return s.client.User.UpdateOneID(id).
+		SetEmailVerifiedAt(time.Now()).
+		Exec(ctx)
+}
+
+// ResetUserPassword 修改用户的密码，之前的会话全部失效
+//
+// 能够收到重置密码邮件说明用户拥有该邮箱，因此同时标记邮箱已经验证。
+func (s *SQLStore) ResetUserPassword(ctx context.Context, id, passwordHash string) error {
+	return s.client.User.UpdateOneID(id).
+		SetPasswordHash(passwordHash).
+		SetSessionStamp(uuid.NewString()).
+		SetEmailVerifiedAt(time.Now()).
+		Exec(ctx)
+}
+
+// RotateSessionStamp 更换用户的会话 stamp，之前签发的会话全部失效
+func (s *SQLStore) RotateSessionStamp(ctx context.Context, id string) error {
+	return s.client.User.UpdateOneID(id).
+		SetSessionStamp(uuid.NewString()).
+		Exec(ctx)
+}
+
+// LinkOpenAIUser 将 ChatGPT 账号关联到本地账号
+func (s *SQLStore) LinkOpenAIUser(ctx context.Context, id, openaiID string) error {
+	return s.client.User.UpdateOneID(id).
+		SetOpenaiID(openaiID).
+		Exec(ctx)
+}
+
+// GetUserByOIDCID 获取通过 OpenID Connect 登录的用户
+func (s *SQLStore) GetUserByOIDCID(ctx context.Context, oidcID string) (*ent.User, error) {
+	return s.client.User.Query().
+		Where(user.OidcID(oidcID)).
+		Only(ctx)
+}
+
+// SaveOIDCUser 保存通过 OpenID Connect 登录的用户
+//
+// id 为空时创建一个新用户，否则关联到已有的用户；name、image 和 groups 每次登录时使用身份提供方的值更新。
+func (s *SQLStore) SaveOIDCUser(ctx context.Context, id, oidcID, name, email, image string, groups []string) (*ent.User, error) {
+	if id == "" {
+		return s.client.User.Create().
+			SetID(uuid.NewString()).
+			SetOidcID(oidcID).
+			SetName(name).
+			SetEmail(email).
+			SetImage(image).
+			SetGroups(groups).
+			SetEmailVerifiedAt(time.Now()).
+			Save(ctx)
+	}
+	return s.client.User.UpdateOneID(id).
+		SetOidcID(oidcID).
+		SetName(name).
+		SetImage(image).
+		SetGroups(groups).
+		Save(ctx)
+}
+
+// deletedUserName 是注销后匿名化的用户显示的名称
+const deletedUserName = "[deleted]"
+
+// DeleteAccount 注销用户，返回删除的导出任务 ID，由调用方删除归档文件
+//
+// 凭据、API key、分享和导出任务总是删除。purge 为 true 时删除用户的全部内容和用户本身；
+// 否则删除没有发布的会话，发布的主题和会话保留，用户的个人信息被清除，显示为已注销。
+func (s *SQLStore) DeleteAccount(ctx context.Context, id string, purge bool) ([]string, error) {
+	var exports []string
+	err := WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		u, err := tx.User.Query().Where(user.ID(id), user.DeletedAtIsNil()).Only(ctx)
+		if err != nil {
+			return err
+		}
+		owner := user.ID(u.ID)
+		exports, err = tx.DataExport.Query().Where(dataexport.HasUserWith(owner)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.DataExport.Delete().Where(dataexport.HasUserWith(owner)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err = tx.Credential.Delete().Where(credential.HasUserWith(owner)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err = tx.APIKey.Delete().Where(apikey.HasUserWith(owner)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err = tx.Share.Delete().Where(share.HasUserWith(owner)).Exec(ctx); err != nil {
+			return err
+		}
+
+		if purge {
+			if _, err = tx.Message.Delete().Where(message.HasUserWith(owner)).Exec(ctx); err != nil {
+				return err
+			}
+			if _, err = tx.Topic.Delete().Where(topic.HasUserWith(owner)).Exec(ctx); err != nil {
+				return err
+			}
+			if _, err = tx.Conversation.Delete().Where(conversation.HasUserWith(owner)).Exec(ctx); err != nil {
+				return err
+			}
+			if _, err = tx.Moderation.Delete().Where(entmoderation.HasUserWith(owner)).Exec(ctx); err != nil {
+				return err
+			}
+			if _, err = tx.Report.Delete().Where(report.HasUserWith(owner)).Exec(ctx); err != nil {
+				return err
+			}
+			return tx.User.DeleteOne(u).Exec(ctx)
+		}
+
+		// 只保留仍然发布着的主题的会话
+		if _, err = tx.Topic.Delete().Where(topic.HasUserWith(owner), topic.DeletedAtNotNil()).Exec(ctx); err != nil {
+			return err
+		}
+		published, err := tx.Topic.Query().
+			Where(topic.HasUserWith(owner)).
+			Select(topic.FieldConversationID).
+			Strings(ctx)
+		if err != nil {
+			return err
+		}
+		query := tx.Conversation.Query().Where(conversation.HasUserWith(owner))
+		if len(published) > 0 {
+			query.Where(conversation.IDNotIn(published...))
+		}
+		private, err := query.IDs(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Message.Delete().
+			Where(
+				message.HasUserWith(owner),
+				message.Or(message.ConversationIDIsNil(), message.ConversationIDIn(private...)),
+			).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.Moderation.Delete().Where(entmoderation.ConversationIDIn(private...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err = tx.Conversation.Delete().Where(conversation.IDIn(private...)).Exec(ctx); err != nil {
+			return err
+		}
+		return tx.User.UpdateOne(u).
+			SetName(deletedUserName).
+			SetEmail(u.ID + "@deleted.invalid").
+			ClearImage().
+			ClearPasswordHash().
+			ClearEmailVerifiedAt().
+			ClearOpenaiID().
+			ClearOidcID().
+			ClearGroups().
+			ClearFeatures().
+			ClearRole().
+			SetDeletedAt(time.Now()).
+			Exec(ctx)
+	})
+	return exports, err
+}
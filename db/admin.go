@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/ent/user"
+	"github.com/google/uuid"
+)
+
+// SetMessageHidden 隐藏或公开一条消息
+func (s *SQLStore) SetMessageHidden(ctx context.Context, id string, hidden bool) error {
+	return s.client.Message.UpdateOneID(id).SetHidden(hidden).Exec(ctx)
+}
+
+// GetTopic 获取指定的主题
+func (s *SQLStore) GetTopic(ctx context.Context, id string) (*ent.Topic, error) {
+	return s.client.Topic.Get(ctx, id)
+}
+
+// SetTopicHidden 隐藏或公开一个主题
+func (s *SQLStore) SetTopicHidden(ctx context.Context, id string, hidden bool) error {
+	return s.client.Topic.UpdateOneID(id).SetHidden(hidden).Exec(ctx)
+}
+
+// SetTopicLocked 锁定或解锁一个主题
+func (s *SQLStore) SetTopicLocked(ctx context.Context, id string, locked bool) error {
+	return s.client.Topic.UpdateOneID(id).SetLocked(locked).Exec(ctx)
+}
+
+// IsConversationLocked 判断会话对应的主题是否被锁定
+func (s *SQLStore) IsConversationLocked(ctx context.Context, conversationID string) (bool, error) {
+	return s.client.Topic.Query().
+		Where(topic.ConversationID(conversationID), topic.Locked(true)).
+		Exist(ctx)
+}
+
+// BanUser 封禁用户并使用户的会话全部失效，reason 会展示给被封禁的用户
+func (s *SQLStore) BanUser(ctx context.Context, id, reason string) error {
+	return s.client.User.UpdateOneID(id).
+		SetBannedAt(time.Now()).
+		SetBanReason(reason).
+		SetSessionStamp(uuid.NewString()).
+		Exec(ctx)
+}
+
+// UnbanUser 解除用户的封禁
+func (s *SQLStore) UnbanUser(ctx context.Context, id string) error {
+	return s.client.User.UpdateOneID(id).
+		ClearBannedAt().
+		ClearBanReason().
+		Exec(ctx)
+}
+
+// SetUserRole 授予用户论坛角色，role 为空时清除授予的角色
+//
+// 用户需要重新登录，之前的会话全部失效。
+func (s *SQLStore) SetUserRole(ctx context.Context, id, role string) error {
+	update := s.client.User.UpdateOneID(id).SetSessionStamp(uuid.NewString())
+	if role == "" {
+		update.ClearRole()
+	} else {
+		update.SetRole(user.Role(role))
+	}
+	return update.Exec(ctx)
+}
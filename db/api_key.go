@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/apikey"
+	"community.threetenth.chatgpt/ent/user"
+)
+
+// apiKeyTouchInterval 是更新 API key 最后使用时间的最小间隔，避免每个请求都写数据库
+const apiKeyTouchInterval = time.Minute
+
+// CreateAPIKey 为用户保存一个 API key，expiresAt 为 nil 时永不过期
+func (s *SQLStore) CreateAPIKey(ctx context.Context, userID, name, prefix, hash string, scopes []string, expiresAt *time.Time) (*ent.APIKey, error) {
+	return s.client.APIKey.Create().
+		SetName(name).
+		SetPrefix(prefix).
+		SetHash(hash).
+		SetScopes(scopes).
+		SetNillableExpiresAt(expiresAt).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+// ListAPIKeys 获取用户所有未撤销的 API key
+func (s *SQLStore) ListAPIKeys(ctx context.Context, userID string) ([]*ent.APIKey, error) {
+	return s.client.APIKey.Query().
+		Where(apikey.HasUserWith(user.ID(userID)), apikey.RevokedAtIsNil()).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+}
+
+// GetAPIKeyByHash 获取哈希对应的有效 API key 及其用户
+func (s *SQLStore) GetAPIKeyByHash(ctx context.Context, hash string) (*ent.APIKey, error) {
+	return s.client.APIKey.Query().
+		Where(
+			apikey.Hash(hash),
+			apikey.RevokedAtIsNil(),
+			apikey.Or(apikey.ExpiresAtIsNil(), apikey.ExpiresAtGT(time.Now())),
+		).
+		WithUser().
+		Only(ctx)
+}
+
+// TouchAPIKey 更新 API key 的最后使用时间
+func (s *SQLStore) TouchAPIKey(ctx context.Context, k *ent.APIKey) error {
+	if k.LastUsedAt != nil && time.Since(*k.LastUsedAt) < apiKeyTouchInterval {
+		return nil
+	}
+	return s.client.APIKey.UpdateOneID(k.ID).SetLastUsedAt(time.Now()).Exec(ctx)
+}
+
+// RevokeAPIKey 撤销用户自己的 API key
+func (s *SQLStore) RevokeAPIKey(ctx context.Context, id int, userID string) error {
+	n, err := s.client.APIKey.Update().
+		Where(
+			apikey.ID(id),
+			apikey.RevokedAtIsNil(),
+			apikey.HasUserWith(user.ID(userID)),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// RotateAPIKey 使用新的 key 替换用户自己的 API key
+//
+// 新的 key 继承名称、权限和过期时间，旧的 key 在同一个事务中撤销。
+func (s *SQLStore) RotateAPIKey(ctx context.Context, id int, userID, prefix, hash string) (*ent.APIKey, error) {
+	var k *ent.APIKey
+	err := WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		old, err := tx.APIKey.Query().
+			Where(
+				apikey.ID(id),
+				apikey.RevokedAtIsNil(),
+				apikey.HasUserWith(user.ID(userID)),
+			).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if err = tx.APIKey.UpdateOne(old).SetRevokedAt(time.Now()).Exec(ctx); err != nil {
+			return err
+		}
+		k, err = tx.APIKey.Create().
+			SetName(old.Name).
+			SetPrefix(prefix).
+			SetHash(hash).
+			SetScopes(old.Scopes).
+			SetNillableExpiresAt(old.ExpiresAt).
+			SetUserID(userID).
+			Save(ctx)
+		return err
+	})
+	return k, err
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/auditevent"
+	"community.threetenth.chatgpt/ent/user"
+)
+
+// SaveAuditEvent 追加一条审计记录，actorID 为空表示匿名操作
+func (s *SQLStore) SaveAuditEvent(ctx context.Context, actorID, action, targetType, targetID, ip, userAgent string, payload map[string]interface{}) (*ent.AuditEvent, error) {
+	create := s.client.AuditEvent.Create().
+		SetAction(action).
+		SetTargetType(targetType).
+		SetTargetID(targetID).
+		SetIP(ip).
+		SetUserAgent(userAgent).
+		SetPayload(payload)
+	if actorID != "" {
+		create.SetActorID(actorID)
+	}
+	return create.Save(ctx)
+}
+
+// AuditFilter 是查询审计记录的条件，空值表示不过滤
+type AuditFilter struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	IP         string
+	Since      time.Time
+	Until      time.Time
+}
+
+// ListAuditEvents 按时间倒序查询审计记录
+//
+// Action 以 . 结尾时按前缀匹配，例如 "user." 匹配所有用户相关的操作。
+func (s *SQLStore) ListAuditEvents(ctx context.Context, filter *AuditFilter, offset, limit int) ([]*ent.AuditEvent, error) {
+	q := s.client.AuditEvent.Query()
+	if filter.ActorID != "" {
+		q.Where(auditevent.HasActorWith(user.ID(filter.ActorID)))
+	}
+	if filter.Action != "" {
+		if filter.Action[len(filter.Action)-1] == '.' {
+			q.Where(auditevent.ActionHasPrefix(filter.Action))
+		} else {
+			q.Where(auditevent.Action(filter.Action))
+		}
+	}
+	if filter.TargetType != "" {
+		q.Where(auditevent.TargetType(filter.TargetType))
+	}
+	if filter.TargetID != "" {
+		q.Where(auditevent.TargetID(filter.TargetID))
+	}
+	if filter.IP != "" {
+		q.Where(auditevent.IP(filter.IP))
+	}
+	if !filter.Since.IsZero() {
+		q.Where(auditevent.CreatedAtGTE(filter.Since))
+	}
+	if !filter.Until.IsZero() {
+		q.Where(auditevent.CreatedAtLT(filter.Until))
+	}
+	return q.
+		WithActor().
+		Order(ent.Desc(auditevent.FieldCreatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+// PurgeAuditEvents 删除指定时间之前的审计记录，这是唯一删除审计记录的方式
+func (s *SQLStore) PurgeAuditEvents(ctx context.Context, before time.Time) (int, error) {
+	return s.client.AuditEvent.Delete().
+		Where(auditevent.CreatedAtLT(before)).
+		Exec(ctx)
+}
@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/ent/user"
+)
+
+// conversationTitleLength 是根据第一个提问生成的会话标题的最大字符数
+const conversationTitleLength = 64
+
+// GetConversation 获取指定的会话，被删除的会话返回 ent.NotFoundError
+func (s *SQLStore) GetConversation(ctx context.Context, id string) (*ent.Conversation, error) {
+	return s.client.Conversation.Query().
+		Where(conversation.ID(id), conversation.DeletedAtIsNil()).
+		Only(ctx)
+}
+
+// GetConversationMessages 获取会话中的消息，includeHidden 为 false 时不包含被隐藏的消息
+//
+// 被删除的消息也会返回，由调用方显示为占位，保持会话树的结构。
+func (s *SQLStore) GetConversationMessages(ctx context.Context, id string, includeHidden bool) ([]*ent.Message, error) {
+	query := s.client.Message.Query().
+		Where(message.ConversationID(id))
+	if !includeHidden {
+		query = query.Where(message.Hidden(false))
+	}
+	return query.
+		Order(ent.Asc(message.FieldCreatedAt)).
+		All(ctx)
+}
+
+// ListUserConversations 分页获取用户自己的会话，按最后活动时间倒序
+//
+// deleted 为 true 时获取回收站中被删除的会话，按删除时间倒序。
+func (s *SQLStore) ListUserConversations(ctx context.Context, userID string, deleted bool, offset, limit int) ([]*ent.Conversation, error) {
+	query := s.client.Conversation.Query().
+		Where(conversation.HasUserWith(user.ID(userID)))
+	if deleted {
+		query = query.
+			Where(conversation.DeletedAtNotNil()).
+			Order(ent.Desc(conversation.FieldDeletedAt))
+	} else {
+		query = query.
+			Where(conversation.DeletedAtIsNil()).
+			Order(ent.Desc(conversation.FieldLastActivityAt))
+	}
+	return query.
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+// UpdateConversation 修改用户自己的会话的标题和系统提示，为 nil 的值不修改
+//
+// 用户修改过标题的会话不再自动生成标题。会话不存在、已经删除或者不属于该用户时返回 ent.NotFoundError。
+func (s *SQLStore) UpdateConversation(ctx context.Context, id, userID string, title, systemPrompt *string) (*ent.Conversation, error) {
+	update := s.client.Conversation.Update().
+		Where(
+			conversation.ID(id),
+			conversation.DeletedAtIsNil(),
+			conversation.HasUserWith(user.ID(userID)),
+		).
+		SetNillableSystemPrompt(systemPrompt)
+	if title != nil {
+		update.SetTitle(*title).SetCustomTitle(true)
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, &ent.NotFoundError{}
+	}
+	return s.client.Conversation.Get(ctx, id)
+}
+
+// SaveConversationSummary 保存自动生成的标题和摘要，并将摘要同步到会话的主题
+//
+// 用户修改过标题的会话只保存摘要。messageCount 是生成时会话的消息数量，用于判断何时重新生成。
+func (s *SQLStore) SaveConversationSummary(ctx context.Context, id, title, summary string, messageCount int) error {
+	return WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		c, err := tx.Conversation.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		update := tx.Conversation.UpdateOne(c).
+			SetSummary(summary).
+			SetSummaryMessageCount(messageCount)
+		if !c.CustomTitle && title != "" {
+			update.SetTitle(title)
+		}
+		if err = update.Exec(ctx); err != nil {
+			return err
+		}
+
+		t, err := tx.Topic.Query().Where(topic.ConversationID(id)).Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// 主题列表按更新时间排序，摘要的变化不算作主题的更新
+		return tx.Topic.UpdateOne(t).
+			SetSummary(summary).
+			SetUpdatedAt(t.UpdatedAt).
+			Exec(ctx)
+	})
+}
+
+// IsConversationOwner 判断指定的会话是否属于该用户，被删除的会话不属于任何用户
+func (s *SQLStore) IsConversationOwner(ctx context.Context, conversationID, userID string) (bool, error) {
+	return s.client.Conversation.Query().
+		Where(
+			conversation.ID(conversationID),
+			conversation.DeletedAtIsNil(),
+			conversation.HasUserWith(user.ID(userID)),
+		).
+		Exist(ctx)
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/credential"
+	"community.threetenth.chatgpt/ent/predicate"
+	"community.threetenth.chatgpt/ent/user"
+)
+
+// credentialOwner 按用户过滤凭据，userID 为空时是全站共用的凭据
+func credentialOwner(userID string) predicate.Credential {
+	if userID == "" {
+		return credential.Not(credential.HasUser())
+	}
+	return credential.HasUserWith(user.ID(userID))
+}
+
+// GetCredential 获取用户指定类型的凭据，userID 为空时获取全站共用的凭据
+func (s *SQLStore) GetCredential(ctx context.Context, userID string, kind credential.Kind) (*ent.Credential, error) {
+	return s.client.Credential.Query().
+		Where(credential.KindEQ(kind), credentialOwner(userID)).
+		Only(ctx)
+}
+
+// SaveCredential 保存用户指定类型的凭据，已经存在时更新
+//
+// value 必须是加密后的密文，keyID 是加密使用的密钥 ID。
+func (s *SQLStore) SaveCredential(ctx context.Context, userID string, kind credential.Kind, value, keyID, userAgent string, expiresAt *time.Time) error {
+	return WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		exist, err := tx.Credential.Query().
+			Where(credential.KindEQ(kind), credentialOwner(userID)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if exist != nil {
+			return tx.Credential.UpdateOne(exist).
+				SetValue(value).
+				SetKeyID(keyID).
+				SetUserAgent(userAgent).
+				SetNillableExpiresAt(expiresAt).
+				Exec(ctx)
+		}
+
+		create := tx.Credential.Create().
+			SetKind(kind).
+			SetValue(value).
+			SetKeyID(keyID).
+			SetUserAgent(userAgent).
+			SetNillableExpiresAt(expiresAt)
+		if userID != "" {
+			create.SetUserID(userID)
+		}
+		return create.Exec(ctx)
+	})
+}
+
+// DeleteUserCredentials 删除用户所有的上游凭据，用户需要重新更新 ChatGPT 会话
+func (s *SQLStore) DeleteUserCredentials(ctx context.Context, userID string) error {
+	_, err := s.client.Credential.Delete().
+		Where(credential.HasUserWith(user.ID(userID))).
+		Exec(ctx)
+	return err
+}
+
+// ListCredentialsNotEncryptedWith 获取不是使用指定密钥加密的凭据，用于轮换密钥
+func (s *SQLStore) ListCredentialsNotEncryptedWith(ctx context.Context, keyID string) ([]*ent.Credential, error) {
+	return s.client.Credential.Query().
+		Where(credential.KeyIDNEQ(keyID)).
+		All(ctx)
+}
+
+// UpdateCredentialValue 更新重新加密后的凭据
+func (s *SQLStore) UpdateCredentialValue(ctx context.Context, id int, value, keyID string) error {
+	return s.client.Credential.UpdateOneID(id).
+		SetValue(value).
+		SetKeyID(keyID).
+		Exec(ctx)
+}
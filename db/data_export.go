@@ -0,0 +1,151 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/apikey"
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/dataexport"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/report"
+	"community.threetenth.chatgpt/ent/share"
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/ent/user"
+	"github.com/google/uuid"
+)
+
+// UserData 是导出的用户全部数据，凭据和密码哈希不会导出
+type UserData struct {
+	User *ent.User `json:"user"`
+	// Conversations 包含回收站中的会话，会话的消息在 edges.messages 中
+	Conversations []*ent.Conversation `json:"conversations"`
+	// Messages 是不属于任何会话的早期消息
+	Messages []*ent.Message `json:"messages"`
+	Topics   []*ent.Topic   `json:"topics"`
+	Shares   []*ent.Share   `json:"shares"`
+	Reports  []*ent.Report  `json:"reports"`
+	APIKeys  []*ent.APIKey  `json:"api_keys"`
+}
+
+// GetUserData 获取用户的全部数据，用于导出
+func (s *SQLStore) GetUserData(ctx context.Context, userID string) (*UserData, error) {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	data := &UserData{User: u}
+	owner := user.ID(userID)
+
+	data.Conversations, err = s.client.Conversation.Query().
+		Where(conversation.HasUserWith(owner)).
+		WithMessages(func(q *ent.MessageQuery) {
+			q.Order(ent.Asc(message.FieldCreatedAt))
+		}).
+		Order(ent.Asc(conversation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.Messages, err = s.client.Message.Query().
+		Where(message.HasUserWith(owner), message.ConversationIDIsNil()).
+		Order(ent.Asc(message.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.Topics, err = s.client.Topic.Query().
+		Where(topic.HasUserWith(owner)).
+		Order(ent.Asc(topic.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.Shares, err = s.client.Share.Query().
+		Where(share.HasUserWith(owner)).
+		Order(ent.Asc(share.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.Reports, err = s.client.Report.Query().
+		Where(report.HasUserWith(owner)).
+		Order(ent.Asc(report.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.APIKeys, err = s.client.APIKey.Query().
+		Where(apikey.HasUserWith(owner)).
+		Order(ent.Asc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// CreateDataExport 创建一个等待生成归档的导出任务
+func (s *SQLStore) CreateDataExport(ctx context.Context, userID string, expiresAt time.Time) (*ent.DataExport, error) {
+	return s.client.DataExport.Create().
+		SetID(uuid.NewString()).
+		SetExpiresAt(expiresAt).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+// GetDataExport 获取用户自己的一个未过期的导出任务
+func (s *SQLStore) GetDataExport(ctx context.Context, id, userID string) (*ent.DataExport, error) {
+	return s.client.DataExport.Query().
+		Where(
+			dataexport.ID(id),
+			dataexport.ExpiresAtGT(time.Now()),
+			dataexport.HasUserWith(user.ID(userID)),
+		).
+		Only(ctx)
+}
+
+// ListDataExports 获取用户未过期的导出任务，按创建时间倒序
+func (s *SQLStore) ListDataExports(ctx context.Context, userID string) ([]*ent.DataExport, error) {
+	return s.client.DataExport.Query().
+		Where(
+			dataexport.ExpiresAtGT(time.Now()),
+			dataexport.HasUserWith(user.ID(userID)),
+		).
+		Order(ent.Desc(dataexport.FieldCreatedAt)).
+		All(ctx)
+}
+
+// SetDataExportReady 标记导出任务的归档已经生成
+func (s *SQLStore) SetDataExportReady(ctx context.Context, id string, size int64) error {
+	return s.client.DataExport.UpdateOneID(id).
+		SetStatus(dataexport.StatusReady).
+		SetSize(size).
+		Exec(ctx)
+}
+
+// SetDataExportFailed 标记导出任务生成失败
+func (s *SQLStore) SetDataExportFailed(ctx context.Context, id, reason string) error {
+	return s.client.DataExport.UpdateOneID(id).
+		SetStatus(dataexport.StatusFailed).
+		SetError(reason).
+		Exec(ctx)
+}
+
+// PurgeDataExports 删除 before 之前过期的导出任务，返回删除的任务 ID，由调用方删除归档文件
+func (s *SQLStore) PurgeDataExports(ctx context.Context, before time.Time) ([]string, error) {
+	var ids []string
+	err := WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		ids, err = tx.DataExport.Query().
+			Where(dataexport.ExpiresAtLT(before)).
+			IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		_, err = tx.DataExport.Delete().Where(dataexport.IDIn(ids...)).Exec(ctx)
+		return err
+	})
+	return ids, err
+}
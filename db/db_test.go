@@ -0,0 +1,585 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/topic"
+	"entgo.io/ent/dialect"
+)
+
+// openTestStore 打开一个执行过所有迁移的 SQLite 内存数据库，测试结束后关闭
+func openTestStore(t *testing.T) *SQLStore {
+	t.Helper()
+	ctx := context.Background()
+	s, err := Open(ctx, "sqlite://:memory:", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	migrator, err := s.Migrator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = migrator.Migrate(ctx); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestDialectMigrations(t *testing.T) {
+	postgres, err := loadMigrations(embeddedMigrations(dialect.Postgres))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlite, err := loadMigrations(embeddedMigrations(dialect.SQLite))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(postgres) != len(sqlite) {
+		t.Fatalf("postgres has %d migrations, sqlite has %d", len(postgres), len(sqlite))
+	}
+	for i := range postgres {
+		if postgres[i].String() != sqlite[i].String() {
+			t.Errorf("migration %d: postgres %s, sqlite %s", i, postgres[i], sqlite[i])
+		}
+	}
+}
+
+func TestMigrateSQLite(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+	migrator, err := s.Migrator()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var diff strings.Builder
+	if err = s.SchemaDiff(ctx, &diff, true); err != nil {
+		t.Fatal(err)
+	}
+	if diff.Len() > 0 {
+		t.Errorf("ent schema differs from the sqlite migrations:\n%s", diff.String())
+	}
+
+	if _, err = migrator.Down(ctx, migrator.Latest()); err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range statuses {
+		if status.AppliedAt != nil {
+			t.Errorf("migration %04d_%s should be reverted", status.Version, status.Name)
+		}
+	}
+	if _, err = migrator.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// baselineSQLite 是之前由 ent 自动迁移创建的数据库结构
+const baselineSQLite = "CREATE TABLE `users` (`id` text NOT NULL, `name` text NULL, `email` text NOT NULL, `image` text NULL, `groups` json NULL, `features` json NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));" +
+	"CREATE TABLE `messages` (`id` text NOT NULL, `content` text NOT NULL, `content_type` text NOT NULL DEFAULT 'text', `role` text NOT NULL, `conversation_id` text NULL, `parent_message_id` text NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `user_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `messages_users_messages` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION);"
+
+func TestMigrateBaselineSQLite(t *testing.T) {
+	ctx := context.Background()
+	s, err := Open(ctx, "sqlite://:memory:", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	now := time.Now()
+	for _, query := range []string{
+		baselineSQLite,
+		`INSERT INTO "users" ("id", "email", "groups", "features", "created_at", "updated_at") VALUES ('user-1', 'u1@example.com', '["admin"]', '[]', $1, $1)`,
+		`INSERT INTO "messages" ("id", "content", "role", "conversation_id", "parent_message_id", "created_at", "updated_at", "user_id") VALUES ('m1', 'hello', 'user', 'c1', 'p1', $1, $1, 'user-1')`,
+		`INSERT INTO "messages" ("id", "content", "role", "conversation_id", "parent_message_id", "created_at", "updated_at", "user_id") VALUES ('m2', 'hi', 'assistant', 'c1', 'm1', $1, $1, 'user-1')`,
+	} {
+		if _, err = s.db.ExecContext(ctx, query, now); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	migrator, err := s.Migrator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 已有数据的数据库不会自动执行破坏性的迁移
+	if _, err = migrator.Migrate(ctx); !errors.Is(err, ErrDestructivePending) {
+		t.Fatalf("Migrate() error = %v, want ErrDestructivePending", err)
+	}
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != migrator.Latest()-1 || applied[0].Version != 2 {
+		t.Errorf("Up() applied %v, want every migration after the baseline", applied)
+	}
+
+	var diff strings.Builder
+	if err = s.SchemaDiff(ctx, &diff, true); err != nil {
+		t.Fatal(err)
+	}
+	if diff.Len() > 0 {
+		t.Errorf("ent schema differs from the upgraded baseline:\n%s", diff.String())
+	}
+
+	u, err := s.client.User.Get(ctx, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Role != nil || u.PasswordHash != "" {
+		t.Errorf("user role = %v, password hash = %q, want empty", u.Role, u.PasswordHash)
+	}
+	if len(u.SessionStamp) != 32 {
+		t.Errorf("user session stamp = %q, want a random value", u.SessionStamp)
+	}
+	c, err := s.client.Conversation.Get(ctx, "c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.MessageCount != 2 || c.Visibility != conversation.VisibilityPrivate {
+		t.Errorf("conversation message count = %d, visibility = %s", c.MessageCount, c.Visibility)
+	}
+	messages, err := s.GetConversationMessages(ctx, "c1", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 2 || messages[1].ParentMessageID != "m1" || messages[1].Status != message.StatusComplete {
+		t.Errorf("messages after upgrade = %v", messages)
+	}
+}
+
+func TestSessionStamp(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+	if err := s.SaveUser(ctx, "alice", "alice", "alice@example.com", "", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	stamp := func() string {
+		u, err := s.GetUser(ctx, "alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return u.SessionStamp
+	}
+
+	last := stamp()
+	if last == "" {
+		t.Fatal("new user has an empty session stamp")
+	}
+	for _, tc := range []struct {
+		name    string
+		update  func() error
+		changed bool
+	}{
+		// 每次通过 ChatGPT 登录都会保存用户，不能让其他会话失效
+		{"login", func() error { return s.SaveUser(ctx, "alice", "Alice", "alice@example.com", "", nil, nil) }, false},
+		{"verify email", func() error { return s.VerifyUserEmail(ctx, "alice") }, false},
+		{"logout all", func() error { return s.RotateSessionStamp(ctx, "alice") }, true},
+		{"password reset", func() error { return s.ResetUserPassword(ctx, "alice", "hash") }, true},
+		{"ban", func() error { return s.BanUser(ctx, "alice", "spam") }, true},
+		{"role", func() error { return s.SetUserRole(ctx, "alice", "moderator") }, true},
+		{"clear role", func() error { return s.SetUserRole(ctx, "alice", "") }, true},
+	} {
+		if err := tc.update(); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		current := stamp()
+		if (current != last) != tc.changed {
+			t.Errorf("%s: session stamp changed = %v, want %v", tc.name, current != last, tc.changed)
+		}
+		last = current
+	}
+}
+
+func TestConversationVisibility(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+
+	for _, id := range []string{"alice", "bob"} {
+		if err := s.SaveUser(ctx, id, id, id+"@example.com", "", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m1, err := s.StartTurn(ctx, &Question{ID: "m1", Prompt: "hello", UserID: "alice", Status: message.StatusCancelled})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c1 := m1.ConversationID
+	if _, err := s.SaveMessage(ctx, "m2", "pending", "text", "assistant", c1, "m1", "alice", true); err != nil {
+		t.Fatal(err)
+	}
+
+	if owner, err := s.IsConversationOwner(ctx, c1, "bob"); err != nil || owner {
+		t.Errorf("IsConversationOwner(c1, bob) = %v, %v", owner, err)
+	}
+	if readable, err := s.IsConversationReadable(ctx, c1); err != nil || readable {
+		t.Errorf("IsConversationReadable(c1) before publishing = %v, %v", readable, err)
+	}
+	if _, err := s.SaveTopic(ctx, c1, "hello", "general", topic.VisibilityUnlisted, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if readable, err := s.IsConversationReadable(ctx, c1); err != nil || !readable {
+		t.Errorf("IsConversationReadable(c1) after publishing = %v, %v", readable, err)
+	}
+	if c, err := s.GetConversation(ctx, c1); err != nil || c.Visibility != conversation.VisibilityUnlisted {
+		t.Errorf("conversation = %+v, %v", c, err)
+	}
+
+	messages, err := s.GetConversationMessages(ctx, c1, false)
+	if err != nil || len(messages) != 1 {
+		t.Errorf("GetConversationMessages(c1, false) = %d messages, %v", len(messages), err)
+	}
+	messages, err = s.GetConversationMessages(ctx, c1, true)
+	if err != nil || len(messages) != 2 {
+		t.Errorf("GetConversationMessages(c1, true) = %d messages, %v", len(messages), err)
+	}
+}
+
+func TestTurn(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+	for _, id := range []string{"alice", "bob"} {
+		if err := s.SaveUser(ctx, id, id, id+"@example.com", "", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	q1, err := s.StartTurn(ctx, &Question{ID: "q1", Prompt: "hello", Model: "gpt", UserID: "alice", Status: message.StatusPending})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c1 := q1.ConversationID
+	if c, err := s.GetConversation(ctx, c1); err != nil || c.Title != "hello" || c.UpstreamID != nil || c.MessageCount != 1 {
+		t.Errorf("new conversation = %+v, %v", c, err)
+	}
+	if err = s.SetTurnStatus(ctx, "q1", message.StatusStreaming); err != nil {
+		t.Fatal(err)
+	}
+	answer, err := s.CompleteTurn(ctx, "q1", "upstream1", "alice", &Answer{ID: "a1", Content: "hi", ContentType: "text", Role: "assistant"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if answer.ParentMessageID != "q1" || answer.ConversationID != c1 {
+		t.Errorf("answer = %+v", answer)
+	}
+	if q, err := s.GetMessage(ctx, "q1"); err != nil || q.Status != message.StatusComplete {
+		t.Errorf("question = %+v, %v", q, err)
+	}
+	if c, err := s.GetConversation(ctx, c1); err != nil || c.UpstreamID == nil || *c.UpstreamID != "upstream1" || c.MessageCount != 2 {
+		t.Errorf("conversation = %+v, %v", c, err)
+	}
+	if err = s.SetTurnStatus(ctx, "q1", message.StatusFailed); !errors.Is(err, ErrTurnFinished) {
+		t.Errorf("SetTurnStatus on a complete turn = %v", err)
+	}
+
+	// 只能继续自己的会话
+	if _, err = s.StartTurn(ctx, &Question{ID: "q3", Prompt: "mine", ConversationID: c1, ParentMessageID: "a1", UserID: "bob", Status: message.StatusPending}); !ent.IsNotFound(err) {
+		t.Errorf("StartTurn in another user's conversation = %v", err)
+	}
+
+	// 提问已经结束时，回复和提问的状态都不会保存
+	if _, err = s.StartTurn(ctx, &Question{ID: "q2", Prompt: "again", ConversationID: c1, ParentMessageID: "a1", UserID: "alice", Status: message.StatusPending}); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.SweepTurns(ctx, time.Now().Add(time.Minute)); err != nil || n != 1 {
+		t.Errorf("SweepTurns = %d, %v", n, err)
+	}
+	if _, err = s.CompleteTurn(ctx, "q2", "upstream1", "alice", &Answer{ID: "a2", Content: "late", ContentType: "text", Role: "assistant"}); !errors.Is(err, ErrTurnFinished) {
+		t.Errorf("CompleteTurn on a failed turn = %v", err)
+	}
+	if _, err = s.GetMessage(ctx, "a2"); err == nil {
+		t.Error("answer of a failed turn should not be saved")
+	}
+
+	title := "renamed"
+	if c, err := s.UpdateConversation(ctx, c1, "alice", &title, nil); err != nil || c.Title != title || c.MessageCount != 3 {
+		t.Errorf("UpdateConversation = %+v, %v", c, err)
+	}
+	if _, err = s.UpdateConversation(ctx, c1, "bob", &title, nil); !ent.IsNotFound(err) {
+		t.Errorf("UpdateConversation by another user = %v", err)
+	}
+}
+
+func TestSaveConversationSummary(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+	if err := s.SaveUser(ctx, "alice", "alice", "alice@example.com", "", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	q, err := s.StartTurn(ctx, &Question{ID: "q1", Prompt: "how do goroutines work", UserID: "alice", Status: message.StatusCancelled})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c1 := q.ConversationID
+	published, err := s.SaveTopic(ctx, c1, "Goroutines", "general", topic.VisibilityPublic, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.SaveConversationSummary(ctx, c1, "Go 并发", "讨论了 goroutine。", 2); err != nil {
+		t.Fatal(err)
+	}
+	c, err := s.GetConversation(ctx, c1)
+	if err != nil || c.Title != "Go 并发" || c.Summary != "讨论了 goroutine。" || c.SummaryMessageCount != 2 {
+		t.Errorf("conversation = %+v, %v", c, err)
+	}
+	tp, err := s.GetTopic(ctx, published.ID)
+	if err != nil || tp.Summary != c.Summary || tp.Title != "Goroutines" || !tp.UpdatedAt.Equal(published.UpdatedAt) {
+		t.Errorf("topic = %+v, %v", tp, err)
+	}
+
+	// 用户修改过的标题不会被覆盖
+	title := "mine"
+	if _, err = s.UpdateConversation(ctx, c1, "alice", &title, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.SaveConversationSummary(ctx, c1, "generated", "again", 4); err != nil {
+		t.Fatal(err)
+	}
+	if c, err = s.GetConversation(ctx, c1); err != nil || c.Title != title || c.Summary != "again" {
+		t.Errorf("conversation with a custom title = %+v, %v", c, err)
+	}
+}
+
+func TestSoftDelete(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+	for _, id := range []string{"alice", "bob"} {
+		if err := s.SaveUser(ctx, id, id, id+"@example.com", "", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	q1, err := s.StartTurn(ctx, &Question{ID: "q1", Prompt: "hello", UserID: "alice", Status: message.StatusPending})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c1 := q1.ConversationID
+	if _, err = s.CompleteTurn(ctx, "q1", "upstream1", "alice", &Answer{ID: "a1", Content: "hi", ContentType: "text", Role: "assistant"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.StartTurn(ctx, &Question{ID: "q2", Prompt: "again", ConversationID: c1, ParentMessageID: "a1", UserID: "alice", Status: message.StatusPending}); err != nil {
+		t.Fatal(err)
+	}
+	published, err := s.SaveTopic(ctx, c1, "Hello", "general", topic.VisibilityPublic, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 作者只能删除和恢复自己的消息，被管理员删除的消息不能恢复
+	if err = s.DeleteMessage(ctx, "a1", "bob", false); !ent.IsNotFound(err) {
+		t.Errorf("DeleteMessage by another user = %v", err)
+	}
+	if err = s.DeleteMessage(ctx, "a1", "alice", false); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.DeleteMessage(ctx, "q2", "bob", true); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.RestoreMessage(ctx, "q2", "alice", false); !errors.Is(err, ErrRemoved) {
+		t.Errorf("RestoreMessage removed by a moderator = %v", err)
+	}
+	if err = s.RestoreMessage(ctx, "q2", "bob", true); err != nil {
+		t.Fatal(err)
+	}
+	messages, err := s.GetConversationMessages(ctx, c1, false)
+	if err != nil || len(messages) != 3 || messages[1].DeletedAt == nil || messages[2].DeletedAt != nil {
+		t.Errorf("messages with a tombstone = %+v, %v", messages, err)
+	}
+
+	// 管理员删除的主题作者不能重新发布
+	if err = s.DeleteTopic(ctx, published.ID, "bob", true); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := s.IsConversationReadable(ctx, c1); err != nil || ok {
+		t.Errorf("IsConversationReadable after DeleteTopic = %v, %v", ok, err)
+	}
+	if _, err = s.SaveTopic(ctx, c1, "Hello", "general", topic.VisibilityPublic, "alice"); !errors.Is(err, ErrRemoved) {
+		t.Errorf("SaveTopic removed by a moderator = %v", err)
+	}
+	if err = s.RestoreTopic(ctx, published.ID, "bob", true); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := s.GetConversation(ctx, c1); err != nil || c.Visibility != conversation.VisibilityPublic {
+		t.Errorf("conversation after RestoreTopic = %+v, %v", c, err)
+	}
+
+	// 会话和主题一起进入回收站，一起恢复
+	if err = s.DeleteConversation(ctx, c1, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.GetConversation(ctx, c1); !ent.IsNotFound(err) {
+		t.Errorf("GetConversation after DeleteConversation = %v", err)
+	}
+	if cs, err := s.ListUserConversations(ctx, "alice", true, 0, 10); err != nil || len(cs) != 1 {
+		t.Errorf("deleted conversations = %+v, %v", cs, err)
+	}
+	if ok, err := s.IsConversationReadable(ctx, c1); err != nil || ok {
+		t.Errorf("IsConversationReadable after DeleteConversation = %v, %v", ok, err)
+	}
+	if err = s.RestoreConversation(ctx, c1, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := s.IsConversationReadable(ctx, c1); err != nil || !ok {
+		t.Errorf("IsConversationReadable after RestoreConversation = %v, %v", ok, err)
+	}
+
+	// 彻底删除的消息的回复改为回复它最近的未被删除的祖先，连续删除的消息不会留下悬空的父消息
+	if _, err = s.CompleteTurn(ctx, "q2", "upstream1", "alice", &Answer{ID: "a2", Content: "hi again", ContentType: "text", Role: "assistant"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.StartTurn(ctx, &Question{ID: "q3", Prompt: "third", ConversationID: c1, ParentMessageID: "a2", UserID: "alice", Status: message.StatusPending}); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"q2", "a2"} {
+		if err = s.DeleteMessage(ctx, id, "alice", false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n, err := s.PurgeDeleted(ctx, time.Now().Add(time.Minute)); err != nil || n != 3 {
+		t.Errorf("PurgeDeleted = %d, %v", n, err)
+	}
+	if q3, err := s.GetMessage(ctx, "q3"); err != nil || q3.ParentMessageID != "q1" {
+		t.Errorf("reply of purged messages = %+v, %v", q3, err)
+	}
+	if c, err := s.GetConversation(ctx, c1); err != nil || c.MessageCount != 2 {
+		t.Errorf("conversation after PurgeDeleted = %+v, %v", c, err)
+	}
+
+	if err = s.DeleteConversation(ctx, c1, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.PurgeDeleted(ctx, time.Now().Add(time.Minute)); err != nil || n != 1 {
+		t.Errorf("PurgeDeleted conversation = %d, %v", n, err)
+	}
+	if messages, err = s.GetConversationMessages(ctx, c1, true); err != nil || len(messages) != 0 {
+		t.Errorf("messages of a purged conversation = %+v, %v", messages, err)
+	}
+}
+
+func TestDeleteAccount(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+	for _, id := range []string{"alice", "bob"} {
+		if err := s.SaveUser(ctx, id, id, id+"@example.com", "", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ask := func(id, userID string) string {
+		q, err := s.StartTurn(ctx, &Question{ID: id, Prompt: "hello", UserID: userID, Status: message.StatusPending})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = s.CompleteTurn(ctx, id, "upstream-"+id, userID, &Answer{ID: id + "-answer", Content: "hi", ContentType: "text", Role: "assistant"}); err != nil {
+			t.Fatal(err)
+		}
+		return q.ConversationID
+	}
+	public, private := ask("q1", "alice"), ask("q2", "alice")
+	if _, err := s.SaveTopic(ctx, public, "Hello", "general", topic.VisibilityPublic, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	ask("q3", "bob")
+	e, err := s.CreateDataExport(ctx, "alice", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := s.GetUserData(ctx, "alice")
+	if err != nil || len(data.Conversations) != 2 || len(data.Conversations[0].Edges.Messages) != 2 || len(data.Topics) != 1 {
+		t.Errorf("GetUserData = %+v, %v", data, err)
+	}
+
+	// 匿名化保留发布的会话，删除没有发布的会话
+	exports, err := s.DeleteAccount(ctx, "alice", false)
+	if err != nil || len(exports) != 1 || exports[0] != e.ID {
+		t.Fatalf("DeleteAccount = %v, %v", exports, err)
+	}
+	u, err := s.GetUser(ctx, "alice")
+	if err != nil || u.DeletedAt == nil || u.Name != deletedUserName || u.Email == "alice@example.com" {
+		t.Errorf("anonymized user = %+v, %v", u, err)
+	}
+	if ok, err := s.IsConversationReadable(ctx, public); err != nil || !ok {
+		t.Errorf("published conversation after DeleteAccount = %v, %v", ok, err)
+	}
+	if _, err = s.GetConversation(ctx, private); !ent.IsNotFound(err) {
+		t.Errorf("private conversation after DeleteAccount = %v", err)
+	}
+	if _, err = s.DeleteAccount(ctx, "alice", false); !ent.IsNotFound(err) {
+		t.Errorf("DeleteAccount twice = %v", err)
+	}
+
+	// 彻底删除不影响其他用户
+	if _, err = s.DeleteAccount(ctx, "bob", true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.GetUser(ctx, "bob"); !ent.IsNotFound(err) {
+		t.Errorf("purged user = %v", err)
+	}
+	if ok, err := s.IsConversationReadable(ctx, public); err != nil || !ok {
+		t.Errorf("conversation of another user after purge = %v, %v", ok, err)
+	}
+}
+
+func TestImportConversation(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+	for _, id := range []string{"alice", "bob"} {
+		if err := s.SaveUser(ctx, id, id, id+"@example.com", "", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Now().Truncate(time.Second)
+	imported := &ImportedConversation{
+		UpstreamID: "upstream1",
+		Title:      "植物与气候",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Messages: []*ImportedMessage{
+			{ID: "q1", Role: "user", Content: "植物对气候有什么贡献？", CreatedAt: now},
+			{ID: "a1", Role: "assistant", Content: "吸收二氧化碳。", ParentMessageID: "q1", CreatedAt: now},
+		},
+	}
+
+	preview, err := s.ImportConversation(ctx, "alice", imported, true)
+	if err != nil || preview.Status != ImportCreated || preview.Added != 2 || preview.ConversationID != "" {
+		t.Fatalf("preview = %+v, %v", preview, err)
+	}
+	created, err := s.ImportConversation(ctx, "alice", imported, false)
+	if err != nil || created.Status != ImportCreated || created.Added != 2 {
+		t.Fatalf("ImportConversation = %+v, %v", created, err)
+	}
+
+	// 重复导入只增加新的消息，其他用户不能导入同一个会话
+	if r, err := s.ImportConversation(ctx, "alice", imported, false); err != nil || r.Status != ImportUnchanged {
+		t.Errorf("import twice = %+v, %v", r, err)
+	}
+	imported.Messages = append(imported.Messages,
+		&ImportedMessage{ID: "q2", Role: "user", Content: "还有呢？", ParentMessageID: "a1", CreatedAt: now})
+	r, err := s.ImportConversation(ctx, "alice", imported, false)
+	if err != nil || r.Status != ImportUpdated || r.Added != 1 || r.ConversationID != created.ConversationID {
+		t.Errorf("import a continued conversation = %+v, %v", r, err)
+	}
+	if r, err := s.ImportConversation(ctx, "bob", imported, false); err != nil || r.Status != ImportSkipped {
+		t.Errorf("import by another user = %+v, %v", r, err)
+	}
+
+	c, err := s.GetConversation(ctx, created.ConversationID)
+	if err != nil || c.MessageCount != 3 || c.UpstreamID == nil || *c.UpstreamID != "upstream1" {
+		t.Errorf("imported conversation = %+v, %v", c, err)
+	}
+	if q2, err := s.GetMessage(ctx, "q2"); err != nil || q2.ParentMessageID != "a1" || q2.ConversationID != c.ID {
+		t.Errorf("imported reply = %+v, %v", q2, err)
+	}
+}
@@ -0,0 +1,215 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/message"
+	"github.com/google/uuid"
+)
+
+// importBatchSize 是批量插入导入的消息时每批的数量
+const importBatchSize = 100
+
+// 导入会话的结果
+const (
+	// ImportCreated 是创建了新的会话
+	ImportCreated = "created"
+	// ImportUpdated 是在之前导入或者在论坛中提问的会话中增加了新的消息
+	ImportUpdated = "updated"
+	// ImportUnchanged 是会话的消息都已经存在
+	ImportUnchanged = "unchanged"
+	// ImportSkipped 是会话属于其他用户、在回收站中或者没有可以导入的消息
+	ImportSkipped = "skipped"
+)
+
+// errImportDryRun 用于回滚预览导入的事务
+var errImportDryRun = errors.New("dry run")
+
+// ImportedConversation 是从 ChatGPT 导出数据中导入的一个会话
+type ImportedConversation struct {
+	UpstreamID string
+	Title      string
+	Model      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	// Messages 按父消息在前的顺序排列
+	Messages []*ImportedMessage
+	// Hidden 为 true 时新导入的消息等待审核，不会公开显示
+	Hidden bool
+}
+
+// ImportedMessage 是导入的会话中的一条消息，ID 是 ChatGPT 中的消息 ID
+type ImportedMessage struct {
+	ID              string
+	Role            string
+	Content         string
+	ParentMessageID string
+	CreatedAt       time.Time
+}
+
+// ImportResult 是导入一个会话的结果
+type ImportResult struct {
+	ConversationID string `json:"conversation_id,omitempty"`
+	UpstreamID     string `json:"upstream_id"`
+	Title          string `json:"title"`
+	Status         string `json:"status"`
+	// Added 是新导入的消息数量
+	Added int `json:"added"`
+	// MessageIDs 是新导入的消息 ID
+	MessageIDs []string `json:"-"`
+}
+
+// ImportConversation 导入一个 ChatGPT 导出的会话，dryRun 为 true 时只返回导入的结果，不保存
+//
+// 会话按 upstream_id 即 ChatGPT 中的会话 ID 去重，消息按消息 ID 去重，重复导入只增加新的消息。
+// 在论坛中提问的会话 ID 由论坛生成，第一次回复后保存 upstream_id，同样按 upstream_id 匹配，不会重复导入。
+// 其他会话中已经存在的消息不导入，它的子消息改为回复它的父消息。
+func (s *SQLStore) ImportConversation(ctx context.Context, userID string, c *ImportedConversation, dryRun bool) (*ImportResult, error) {
+	result := &ImportResult{UpstreamID: c.UpstreamID, Title: importTitle(c)}
+	if c.UpstreamID == "" {
+		result.Status = ImportSkipped
+		return result, nil
+	}
+
+	err := WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		existing, err := tx.Conversation.Query().
+			Where(conversation.UpstreamID(c.UpstreamID)).
+			WithUser().
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if existing != nil {
+			if existing.Edges.User == nil || existing.Edges.User.ID != userID || existing.DeletedAt != nil {
+				result.Status = ImportSkipped
+				return nil
+			}
+			result.ConversationID = existing.ID
+			result.Title = existing.Title
+		}
+
+		ids := make([]string, len(c.Messages))
+		for i, m := range c.Messages {
+			ids[i] = m.ID
+		}
+		stored, err := tx.Message.Query().Where(message.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return err
+		}
+		conversations := make(map[string]string, len(stored))
+		for _, m := range stored {
+			conversations[m.ID] = m.ConversationID
+		}
+
+		// 没有导入的消息的子消息回复它的父消息
+		parents := map[string]string{}
+		var added []*ImportedMessage
+		for _, m := range c.Messages {
+			parentID := m.ParentMessageID
+			if p, ok := parents[parentID]; ok {
+				parentID = p
+			}
+			if conversationID, ok := conversations[m.ID]; ok {
+				if existing == nil || conversationID != existing.ID {
+					parents[m.ID] = parentID
+				}
+				continue
+			}
+			added = append(added, &ImportedMessage{
+				ID:              m.ID,
+				Role:            m.Role,
+				Content:         m.Content,
+				ParentMessageID: parentID,
+				CreatedAt:       m.CreatedAt,
+			})
+		}
+
+		switch {
+		case len(added) == 0 && existing == nil:
+			result.Status = ImportSkipped
+			return nil
+		case len(added) == 0:
+			result.Status = ImportUnchanged
+			return nil
+		case existing == nil:
+			created, err := tx.Conversation.Create().
+				SetID(uuid.NewString()).
+				SetUpstreamID(c.UpstreamID).
+				SetTitle(result.Title).
+				SetModel(c.Model).
+				SetMessageCount(len(added)).
+				SetLastActivityAt(c.UpdatedAt).
+				SetCreatedAt(c.CreatedAt).
+				SetUserID(userID).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			result.ConversationID = created.ID
+			result.Status = ImportCreated
+		default:
+			update := tx.Conversation.UpdateOne(existing).AddMessageCount(len(added))
+			if c.UpdatedAt.After(existing.LastActivityAt) {
+				update.SetLastActivityAt(c.UpdatedAt)
+			}
+			if err = update.Exec(ctx); err != nil {
+				return err
+			}
+			result.Status = ImportUpdated
+		}
+
+		for i := 0; i < len(added); i += importBatchSize {
+			end := i + importBatchSize
+			if end > len(added) {
+				end = len(added)
+			}
+			builders := make([]*ent.MessageCreate, 0, end-i)
+			for _, m := range added[i:end] {
+				builders = append(builders, tx.Message.Create().
+					SetID(m.ID).
+					SetContent(m.Content).
+					SetRole(m.Role).
+					SetConversationID(result.ConversationID).
+					SetParentMessageID(m.ParentMessageID).
+					SetHidden(c.Hidden).
+					SetCreatedAt(m.CreatedAt).
+					SetUpdatedAt(m.CreatedAt).
+					SetUserID(userID))
+			}
+			if _, err = tx.Message.CreateBulk(builders...).Save(ctx); err != nil {
+				return err
+			}
+		}
+		result.Added = len(added)
+		for _, m := range added {
+			result.MessageIDs = append(result.MessageIDs, m.ID)
+		}
+		if dryRun {
+			return errImportDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errImportDryRun) {
+		return nil, err
+	}
+	if dryRun && result.Status == ImportCreated {
+		// 预览时会话没有保存
+		result.ConversationID = ""
+	}
+	return result, nil
+}
+
+// importTitle 返回导入的会话的标题，没有标题时和提问一样使用第一条消息的开头
+func importTitle(c *ImportedConversation) string {
+	if c.Title != "" {
+		return truncate(c.Title, conversationTitleLength)
+	}
+	if len(c.Messages) > 0 {
+		return truncate(c.Messages[0].Content, conversationTitleLength)
+	}
+	return ""
+}
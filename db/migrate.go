@@ -0,0 +1,398 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	log "github.com/sirupsen/logrus"
+)
+
+// migrationFiles 是编译进程序的迁移文件，每种数据库一个目录，目录名为 ent 的 dialect
+//
+//go:embed migrations/*/*.sql
+var migrationFiles embed.FS
+
+// MigrationsDir 是迁移文件在源码中的目录，migrate diff 生成的文件保存在对应数据库的子目录中
+const MigrationsDir = "db/migrations"
+
+var (
+	// ErrSchemaTooNew 是数据库执行过当前程序中没有的迁移，需要使用更新的程序
+	ErrSchemaTooNew = errors.New("database schema is newer than this binary")
+	// ErrDestructivePending 是未执行的迁移包含删除表、列或数据的修改，需要备份后手动执行
+	ErrDestructivePending = errors.New("pending migrations contain destructive changes, back up the database and run `migrate up`")
+)
+
+// migrationFilename 是迁移文件的名称格式，例如 0002_add_user_locale.up.sql
+var migrationFilename = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// destructiveStatement 匹配会删除表、列、索引或数据的语句
+var destructiveStatement = regexp.MustCompile(`(?i)\b(DROP\s+(TABLE|COLUMN|INDEX|CONSTRAINT|TYPE)|TRUNCATE|DELETE\s+FROM|ALTER\s+COLUMN\s+\S+\s+(SET\s+DATA\s+)?TYPE)\b`)
+
+// Migration 是一个版本的数据库迁移，由 NNNN_name.up.sql 和 NNNN_name.down.sql 两个文件组成
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Destructive 判断执行迁移是否会删除表、列、索引或数据
+func (m *Migration) Destructive() bool {
+	return destructiveStatement.MatchString(stripComments(m.Up))
+}
+
+func (m *Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// stripComments 删除 SQL 中 -- 开头的注释行
+func stripComments(query string) string {
+	lines := strings.Split(query, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "--") {
+			lines[i] = ""
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// loadMigrations 读取目录中的迁移文件，按版本号排序
+func loadMigrations(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	versions := map[int]*Migration{}
+	for _, entry := range entries {
+		match := migrationFilename.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, _ := strconv.Atoi(match[1])
+		m, ok := versions[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			versions[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %04d has two names: %s and %s", version, m.Name, match[2])
+		}
+
+		bs, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if match[3] == "up" {
+			m.Up = string(bs)
+		} else {
+			m.Down = string(bs)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(versions))
+	for _, m := range versions {
+		if strings.TrimSpace(stripComments(m.Up)) == "" {
+			return nil, fmt.Errorf("migration %s has no up statements", m)
+		}
+		if strings.TrimSpace(stripComments(m.Down)) == "" {
+			return nil, fmt.Errorf("migration %s has no down statements", m)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// embeddedMigrations 返回编译进程序的指定数据库的迁移文件目录
+func embeddedMigrations(dialect string) fs.FS {
+	sub, err := fs.Sub(migrationFiles, "migrations/"+dialect)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
+// MigrationStatus 是一个版本的迁移状态
+type MigrationStatus struct {
+	Version     int
+	Name        string
+	Destructive bool
+	// AppliedAt 是执行迁移的时间，为空时还没有执行
+	AppliedAt *time.Time
+	// Unknown 表示数据库执行过、但当前程序中没有的迁移
+	Unknown bool
+}
+
+// Migrator 执行版本化的数据库迁移，已执行的版本记录在 schema_migrations 表中
+type Migrator struct {
+	db         *sql.DB
+	dialect    string
+	migrations []*Migration
+}
+
+// NewMigrator 使用目录中的迁移文件创建 Migrator，dialect 是 ent 的 dialect.Postgres 或 dialect.SQLite
+func NewMigrator(db *sql.DB, dialect string, fsys fs.FS) (*Migrator, error) {
+	migrations, err := loadMigrations(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("load %s migrations failed: %w", dialect, err)
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: migrations}, nil
+}
+
+// Latest 返回当前程序中最新的迁移版本
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// appliedMigration 是 schema_migrations 表中的一条记录
+type appliedMigration struct {
+	name      string
+	appliedAt time.Time
+}
+
+// prepare 创建 schema_migrations 表，并返回已执行的迁移
+//
+// 之前由 ent 自动迁移创建的数据库没有版本记录，已有 users 表时视为已执行第一个迁移。
+func (m *Migrator) prepare(ctx context.Context) (map[int]*appliedMigration, error) {
+	timeType, tableExists := "timestamp with time zone",
+		`SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = 'users')`
+	if m.dialect == dialect.SQLite {
+		timeType, tableExists = "datetime",
+			`SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'users')`
+	}
+	_, err := m.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS "schema_migrations" (
+    "version" bigint NOT NULL,
+    "name" varchar NOT NULL,
+    "applied_at" `+timeType+` NOT NULL,
+    PRIMARY KEY ("version")
+)`)
+	if err != nil {
+		return nil, fmt.Errorf("create schema_migrations failed: %w", err)
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil || len(applied) > 0 || len(m.migrations) == 0 {
+		return applied, err
+	}
+
+	var exists bool
+	err = m.db.QueryRowContext(ctx, tableExists).Scan(&exists)
+	if err != nil || !exists {
+		return applied, err
+	}
+	baseline := m.migrations[0]
+	log.WithFields(log.Fields{
+		"method":    "db.Migrator.prepare",
+		"migration": baseline.String(),
+	}).Warn("existing schema without version, mark the first migration as applied")
+	if _, err = m.db.ExecContext(ctx, `INSERT INTO "schema_migrations" ("version", "name", "applied_at") VALUES ($1, $2, $3)`,
+		baseline.Version, baseline.Name, time.Now()); err != nil {
+		return nil, err
+	}
+	return m.applied(ctx)
+}
+
+// applied 查询已执行的迁移
+func (m *Migrator) applied(ctx context.Context) (map[int]*appliedMigration, error) {
+	rows, err := m.db.QueryContext(ctx, `SELECT "version", "name", "applied_at" FROM "schema_migrations"`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int]*appliedMigration{}
+	for rows.Next() {
+		var version int
+		a := &appliedMigration{}
+		if err = rows.Scan(&version, &a.name, &a.appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = a
+	}
+	return applied, rows.Err()
+}
+
+// Status 返回每个版本的迁移状态，按版本号排序
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	applied, err := m.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*MigrationStatus, 0, len(m.migrations))
+	known := map[int]bool{}
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+		s := &MigrationStatus{
+			Version:     migration.Version,
+			Name:        migration.Name,
+			Destructive: migration.Destructive(),
+		}
+		if a, ok := applied[migration.Version]; ok {
+			s.AppliedAt = &a.appliedAt
+		}
+		statuses = append(statuses, s)
+	}
+	for version, a := range applied {
+		if !known[version] {
+			appliedAt := a.appliedAt
+			statuses = append(statuses, &MigrationStatus{Version: version, Name: a.name, AppliedAt: &appliedAt, Unknown: true})
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+	return statuses, nil
+}
+
+// Migrate 在启动时检查数据库的版本，并执行未执行的迁移
+//
+// 数据库比程序新时返回 ErrSchemaTooNew；未执行的迁移包含破坏性修改时返回 ErrDestructivePending，
+// 不会自动执行任何迁移。新建的空数据库没有需要备份的数据，会执行所有迁移。
+func (m *Migrator) Migrate(ctx context.Context) ([]*Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fresh := true
+	var destructive []string
+	for _, s := range statuses {
+		if s.Unknown {
+			return nil, fmt.Errorf("%w: version %04d_%s, latest known version %04d", ErrSchemaTooNew, s.Version, s.Name, m.Latest())
+		}
+		if s.AppliedAt != nil {
+			fresh = false
+		}
+		if s.AppliedAt == nil && s.Destructive {
+			destructive = append(destructive, fmt.Sprintf("%04d_%s", s.Version, s.Name))
+		}
+	}
+	if len(destructive) > 0 && !fresh {
+		return nil, fmt.Errorf("%w: %s", ErrDestructivePending, strings.Join(destructive, ", "))
+	}
+	return m.Up(ctx)
+}
+
+// Up 按版本号顺序执行所有未执行的迁移，包括破坏性的迁移，返回执行的迁移
+func (m *Migrator) Up(ctx context.Context) ([]*Migration, error) {
+	applied, err := m.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for version := range applied {
+		if version > m.Latest() {
+			return nil, fmt.Errorf("%w: version %04d", ErrSchemaTooNew, version)
+		}
+	}
+
+	var done []*Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		if err = m.apply(ctx, migration, true); err != nil {
+			return done, err
+		}
+		done = append(done, migration)
+	}
+	return done, nil
+}
+
+// Down 按版本号倒序回滚最近执行的 steps 个迁移，返回回滚的迁移
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*Migration, error) {
+	applied, err := m.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]int, 0, len(applied))
+	for version := range applied {
+		versions = append(versions, version)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+
+	byVersion := map[int]*Migration{}
+	for _, migration := range m.migrations {
+		byVersion[migration.Version] = migration
+	}
+
+	var done []*Migration
+	for i := 0; i < steps && i < len(versions); i++ {
+		migration, ok := byVersion[versions[i]]
+		if !ok {
+			return done, fmt.Errorf("%w: version %04d has no down migration", ErrSchemaTooNew, versions[i])
+		}
+		if err = m.apply(ctx, migration, false); err != nil {
+			return done, err
+		}
+		done = append(done, migration)
+	}
+	return done, nil
+}
+
+// apply 在一个事务中执行迁移并更新版本记录
+func (m *Migrator) apply(ctx context.Context, migration *Migration, up bool) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	query := migration.Down
+	if up {
+		query = migration.Up
+	}
+	if _, err = tx.ExecContext(ctx, query); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("migration %s failed: %w", migration, err)
+	}
+	if up {
+		_, err = tx.ExecContext(ctx, `INSERT INTO "schema_migrations" ("version", "name", "applied_at") VALUES ($1, $2, $3)`,
+			migration.Version, migration.Name, time.Now())
+	} else {
+		_, err = tx.ExecContext(ctx, `DELETE FROM "schema_migrations" WHERE "version" = $1`, migration.Version)
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// Create 在源码目录 dir 中对应数据库的子目录创建下一个版本的迁移文件，返回创建的文件
+//
+// down 文件只包含提示，补充回滚的语句之前程序无法加载迁移文件，避免提交没有回滚语句的迁移。
+func (m *Migrator) Create(dir, name, up string) ([]string, error) {
+	filename := fmt.Sprintf("%04d_%s", m.Latest()+1, name)
+	if !migrationFilename.MatchString(filename + ".up.sql") {
+		return nil, fmt.Errorf("invalid migration name %q, use lowercase letters, digits and _", name)
+	}
+	files := []string{
+		filepath.Join(dir, m.dialect, filename+".up.sql"),
+		filepath.Join(dir, m.dialect, filename+".down.sql"),
+	}
+	contents := []string{
+		up,
+		fmt.Sprintf("-- TODO: revert %s before committing\n", filename),
+	}
+	for i, file := range files {
+		if err := os.WriteFile(file, []byte(contents[i]), 0644); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"entgo.io/ent/dialect"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := loadMigrations(embeddedMigrations(dialect.Postgres))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			t.Errorf("migration %s: version = %d, want %d", m, m.Version, i+1)
+		}
+	}
+	if migrations[0].Destructive() {
+		t.Errorf("migration %s should not be destructive", migrations[0])
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_drop_image.up.sql":   {Data: []byte(`ALTER TABLE "users" DROP COLUMN "image";`)},
+		"0002_drop_image.down.sql": {Data: []byte(`ALTER TABLE "users" ADD COLUMN "image" varchar NULL;`)},
+		"0001_init.up.sql":         {Data: []byte("-- DROP TABLE in a comment\nCREATE TABLE \"users\" (\"id\" varchar NOT NULL);")},
+		"0001_init.down.sql":       {Data: []byte(`DROP TABLE "users";`)},
+		"README.md":                {Data: []byte("ignored")},
+	}
+	migrations, err := loadMigrations(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) != 2 || migrations[0].String() != "0001_init" || migrations[1].String() != "0002_drop_image" {
+		t.Fatalf("migrations = %v", migrations)
+	}
+	if migrations[0].Destructive() || !migrations[1].Destructive() {
+		t.Errorf("destructive = %v, %v", migrations[0].Destructive(), migrations[1].Destructive())
+	}
+
+	fsys["0003_todo.up.sql"] = &fstest.MapFile{Data: []byte(`ALTER TABLE "users" ADD COLUMN "locale" varchar NULL;`)}
+	fsys["0003_todo.down.sql"] = &fstest.MapFile{Data: []byte("-- TODO: revert 0003_todo before committing\n")}
+	if _, err = loadMigrations(fsys); err == nil {
+		t.Error("migration without down statements should fail to load")
+	}
+}
@@ -0,0 +1,26 @@
+-- 初始的数据库结构，与之前 ent 自动迁移创建的表一致
+CREATE TABLE IF NOT EXISTS "users" (
+    "id" varchar NOT NULL,
+    "name" varchar NULL,
+    "email" varchar NOT NULL,
+    "image" varchar NULL,
+    "groups" jsonb NULL,
+    "features" jsonb NULL,
+    "created_at" timestamp with time zone NOT NULL,
+    "updated_at" timestamp with time zone NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "messages" (
+    "id" varchar NOT NULL,
+    "content" varchar NOT NULL,
+    "content_type" varchar NOT NULL DEFAULT 'text',
+    "role" varchar NOT NULL,
+    "conversation_id" varchar NULL,
+    "parent_message_id" varchar NULL,
+    "created_at" timestamp with time zone NOT NULL,
+    "updated_at" timestamp with time zone NOT NULL,
+    "user_id" varchar NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "messages_users_messages" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS "audit_events";
+DROP TABLE IF EXISTS "credentials";
+DROP TABLE IF EXISTS "api_keys";
+DROP TABLE IF EXISTS "reports";
+DROP TABLE IF EXISTS "moderations";
+DROP TABLE IF EXISTS "shares";
+DROP TABLE IF EXISTS "topics";
+ALTER TABLE "messages" DROP COLUMN "hidden";
+ALTER TABLE "users" DROP COLUMN "ban_reason";
+ALTER TABLE "users" DROP COLUMN "banned_at";
+ALTER TABLE "users" DROP COLUMN "role";
+ALTER TABLE "users" DROP COLUMN "oidc_id";
+ALTER TABLE "users" DROP COLUMN "openai_id";
+ALTER TABLE "users" DROP COLUMN "email_verified_at";
+ALTER TABLE "users" DROP COLUMN "password_hash";
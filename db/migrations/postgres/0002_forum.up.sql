@@ -0,0 +1,122 @@
+-- 论坛的账号、角色、主题、分享、审核、举报、API key、凭据和审计记录
+ALTER TABLE "users" ADD COLUMN "password_hash" varchar NULL;
+ALTER TABLE "users" ADD COLUMN "email_verified_at" timestamp with time zone NULL;
+ALTER TABLE "users" ADD COLUMN "openai_id" varchar UNIQUE NULL;
+ALTER TABLE "users" ADD COLUMN "oidc_id" varchar UNIQUE NULL;
+ALTER TABLE "users" ADD COLUMN "role" varchar NULL;
+ALTER TABLE "users" ADD COLUMN "banned_at" timestamp with time zone NULL;
+ALTER TABLE "users" ADD COLUMN "ban_reason" varchar NULL;
+ALTER TABLE "messages" ADD COLUMN "hidden" boolean NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS "topics" (
+    "id" varchar NOT NULL,
+    "conversation_id" varchar UNIQUE NOT NULL,
+    "title" varchar NOT NULL,
+    "category" varchar NOT NULL DEFAULT 'general',
+    "visibility" varchar NOT NULL DEFAULT 'private',
+    "hidden" boolean NOT NULL DEFAULT false,
+    "locked" boolean NOT NULL DEFAULT false,
+    "created_at" timestamp with time zone NOT NULL,
+    "updated_at" timestamp with time zone NOT NULL,
+    "user_id" varchar NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "topics_users_topics" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+CREATE INDEX IF NOT EXISTS "topic_visibility_category_updated_at" ON "topics" ("visibility", "category", "updated_at");
+
+CREATE TABLE IF NOT EXISTS "shares" (
+    "id" varchar NOT NULL,
+    "conversation_id" varchar NOT NULL,
+    "title" varchar NOT NULL,
+    "messages" jsonb NOT NULL,
+    "revoked_at" timestamp with time zone NULL,
+    "created_at" timestamp with time zone NOT NULL,
+    "user_id" varchar NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "shares_users_shares" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+
+CREATE TABLE IF NOT EXISTS "moderations" (
+    "id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    "stage" varchar NOT NULL,
+    "content" text NOT NULL,
+    "decision" varchar NOT NULL,
+    "reason" varchar NULL,
+    "provider" varchar NULL,
+    "review" varchar NOT NULL DEFAULT 'none',
+    "message_id" varchar NULL,
+    "conversation_id" varchar NULL,
+    "reviewer_id" varchar NULL,
+    "reviewed_at" timestamp with time zone NULL,
+    "created_at" timestamp with time zone NOT NULL,
+    "user_id" varchar NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "moderations_users_moderations" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+CREATE INDEX IF NOT EXISTS "moderation_review_created_at" ON "moderations" ("review", "created_at");
+
+CREATE TABLE IF NOT EXISTS "reports" (
+    "id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    "target_type" varchar NOT NULL,
+    "target_id" varchar NOT NULL,
+    "reason" varchar NOT NULL,
+    "detail" varchar NULL,
+    "status" varchar NOT NULL DEFAULT 'open',
+    "handler_id" varchar NULL,
+    "handled_at" timestamp with time zone NULL,
+    "created_at" timestamp with time zone NOT NULL,
+    "user_id" varchar NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "reports_users_reports" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+CREATE INDEX IF NOT EXISTS "report_status_created_at" ON "reports" ("status", "created_at");
+CREATE INDEX IF NOT EXISTS "report_target_type_target_id" ON "reports" ("target_type", "target_id");
+
+CREATE TABLE IF NOT EXISTS "api_keys" (
+    "id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    "name" varchar NOT NULL,
+    "prefix" varchar NOT NULL,
+    "hash" varchar UNIQUE NOT NULL,
+    "scopes" jsonb NOT NULL,
+    "last_used_at" timestamp with time zone NULL,
+    "expires_at" timestamp with time zone NULL,
+    "revoked_at" timestamp with time zone NULL,
+    "created_at" timestamp with time zone NOT NULL,
+    "user_id" varchar NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "api_keys_users_api_keys" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+
+CREATE TABLE IF NOT EXISTS "credentials" (
+    "id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    "kind" varchar NOT NULL,
+    "value" text NOT NULL,
+    "key_id" varchar NOT NULL,
+    "user_agent" varchar NULL,
+    "expires_at" timestamp with time zone NULL,
+    "created_at" timestamp with time zone NOT NULL,
+    "updated_at" timestamp with time zone NOT NULL,
+    "user_id" varchar NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "credentials_users_credentials" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE SET NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "credential_kind_user_id" ON "credentials" ("kind", "user_id");
+CREATE INDEX IF NOT EXISTS "credential_key_id" ON "credentials" ("key_id");
+
+CREATE TABLE IF NOT EXISTS "audit_events" (
+    "id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    "action" varchar NOT NULL,
+    "target_type" varchar NULL,
+    "target_id" varchar NULL,
+    "ip" varchar NULL,
+    "user_agent" varchar NULL,
+    "payload" jsonb NULL,
+    "created_at" timestamp with time zone NOT NULL,
+    "actor_id" varchar NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "audit_events_users_audit_events" FOREIGN KEY ("actor_id") REFERENCES "users" ("id") ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS "auditevent_action_created_at" ON "audit_events" ("action", "created_at");
+CREATE INDEX IF NOT EXISTS "auditevent_target_type_target_id" ON "audit_events" ("target_type", "target_id");
+CREATE INDEX IF NOT EXISTS "auditevent_created_at_actor_id" ON "audit_events" ("created_at", "actor_id");
+CREATE INDEX IF NOT EXISTS "auditevent_created_at" ON "audit_events" ("created_at");
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "message_status_updated_at";
+ALTER TABLE "messages" DROP COLUMN "status";
@@ -0,0 +1,2 @@
+ALTER TABLE "messages" DROP CONSTRAINT IF EXISTS "messages_conversations_messages";
+DROP TABLE IF EXISTS "conversations";
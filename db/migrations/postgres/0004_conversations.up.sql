@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS "conversations" (
+    "id" varchar NOT NULL,
+    "upstream_id" varchar UNIQUE NULL,
+    "title" varchar NOT NULL DEFAULT '',
+    "model" varchar NULL,
+    "system_prompt" text NULL,
+    "visibility" varchar NOT NULL DEFAULT 'private',
+    "message_count" bigint NOT NULL DEFAULT 0,
+    "last_activity_at" timestamp with time zone NOT NULL,
+    "created_at" timestamp with time zone NOT NULL,
+    "updated_at" timestamp with time zone NOT NULL,
+    "user_id" varchar NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "conversations_users_conversations" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+CREATE INDEX IF NOT EXISTS "conversation_last_activity_at_user_id" ON "conversations" ("last_activity_at", "user_id");
+
+-- 之前的会话只保存在消息的 conversation_id 中，所有者是会话中第一条消息的用户，标题和可见性来自发布的主题
+UPDATE "messages" SET "conversation_id" = NULL WHERE "conversation_id" = '';
+INSERT INTO "conversations" ("id", "upstream_id", "title", "visibility", "message_count", "last_activity_at", "created_at", "updated_at", "user_id")
+SELECT m."conversation_id", m."conversation_id", COALESCE(t."title", ''), COALESCE(t."visibility", 'private'),
+    COUNT(*), MAX(m."created_at"), MIN(m."created_at"), MAX(m."updated_at"),
+    (SELECT f."user_id" FROM "messages" f WHERE f."conversation_id" = m."conversation_id" ORDER BY f."created_at" LIMIT 1)
+FROM "messages" m
+LEFT JOIN "topics" t ON t."conversation_id" = m."conversation_id"
+WHERE m."conversation_id" IS NOT NULL
+GROUP BY m."conversation_id", t."title", t."visibility";
+
+ALTER TABLE "messages" ADD CONSTRAINT "messages_conversations_messages" FOREIGN KEY ("conversation_id") REFERENCES "conversations" ("id") ON DELETE SET NULL;
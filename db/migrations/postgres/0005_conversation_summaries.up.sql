@@ -0,0 +1,8 @@
+ALTER TABLE "conversations" ADD COLUMN "custom_title" boolean NOT NULL DEFAULT false;
+ALTER TABLE "conversations" ADD COLUMN "summary" text NULL;
+ALTER TABLE "conversations" ADD COLUMN "summary_message_count" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "topics" ADD COLUMN "summary" text NULL;
+ALTER TABLE "shares" ADD COLUMN "summary" text NULL;
+
+-- 0004 从主题复制的标题是用户填写的，不自动生成
+UPDATE "conversations" SET "custom_title" = true WHERE "title" <> '' AND "upstream_id" = "id";
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS "topic_deleted_at";
+DROP INDEX IF EXISTS "conversation_deleted_at";
+DROP INDEX IF EXISTS "message_deleted_at";
+ALTER TABLE "topics" DROP COLUMN "deleted_by";
+ALTER TABLE "topics" DROP COLUMN "deleted_at";
+ALTER TABLE "conversations" DROP COLUMN "deleted_by";
+ALTER TABLE "conversations" DROP COLUMN "deleted_at";
+ALTER TABLE "messages" DROP COLUMN "deleted_by";
+ALTER TABLE "messages" DROP COLUMN "deleted_at";
@@ -0,0 +1,9 @@
+ALTER TABLE "messages" ADD COLUMN "deleted_at" timestamp with time zone NULL;
+ALTER TABLE "messages" ADD COLUMN "deleted_by" varchar NULL;
+ALTER TABLE "conversations" ADD COLUMN "deleted_at" timestamp with time zone NULL;
+ALTER TABLE "conversations" ADD COLUMN "deleted_by" varchar NULL;
+ALTER TABLE "topics" ADD COLUMN "deleted_at" timestamp with time zone NULL;
+ALTER TABLE "topics" ADD COLUMN "deleted_by" varchar NULL;
+CREATE INDEX IF NOT EXISTS "message_deleted_at" ON "messages" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "conversation_deleted_at" ON "conversations" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "topic_deleted_at" ON "topics" ("deleted_at");
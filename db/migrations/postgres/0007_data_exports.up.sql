@@ -0,0 +1,14 @@
+ALTER TABLE "users" ADD COLUMN "deleted_at" timestamp with time zone NULL;
+CREATE TABLE IF NOT EXISTS "data_exports" (
+    "id" varchar NOT NULL,
+    "status" varchar NOT NULL DEFAULT 'pending',
+    "size" bigint NOT NULL DEFAULT 0,
+    "error" varchar NULL,
+    "expires_at" timestamp with time zone NOT NULL,
+    "created_at" timestamp with time zone NOT NULL,
+    "updated_at" timestamp with time zone NOT NULL,
+    "user_id" varchar NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "data_exports_users_data_exports" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+CREATE INDEX IF NOT EXISTS "dataexport_expires_at" ON "data_exports" ("expires_at");
@@ -0,0 +1,4 @@
+-- 会话令牌使用每个用户随机的 stamp 签名，升级后已有的会话需要重新登录
+ALTER TABLE "users" ADD COLUMN "session_stamp" varchar NOT NULL DEFAULT '';
+UPDATE "users" SET "session_stamp" = md5(random()::text || clock_timestamp()::text || "id");
+ALTER TABLE "users" ALTER COLUMN "session_stamp" DROP DEFAULT;
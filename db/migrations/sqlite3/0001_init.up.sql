@@ -0,0 +1,26 @@
+-- 初始的数据库结构，与 postgres/0001_init.up.sql 一致
+CREATE TABLE IF NOT EXISTS "users" (
+    "id" text NOT NULL,
+    "name" text NULL,
+    "email" text NOT NULL,
+    "image" text NULL,
+    "groups" json NULL,
+    "features" json NULL,
+    "created_at" datetime NOT NULL,
+    "updated_at" datetime NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "messages" (
+    "id" text NOT NULL,
+    "content" text NOT NULL,
+    "content_type" text NOT NULL DEFAULT 'text',
+    "role" text NOT NULL,
+    "conversation_id" text NULL,
+    "parent_message_id" text NULL,
+    "created_at" datetime NOT NULL,
+    "updated_at" datetime NOT NULL,
+    "user_id" text NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "messages_users_messages" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
@@ -0,0 +1,120 @@
+-- 论坛的账号、角色、主题、分享、审核、举报、API key、凭据和审计记录
+-- SQLite 不能添加 UNIQUE 的列，唯一约束使用唯一索引
+ALTER TABLE "users" ADD COLUMN "password_hash" text NULL;
+ALTER TABLE "users" ADD COLUMN "email_verified_at" datetime NULL;
+ALTER TABLE "users" ADD COLUMN "openai_id" text NULL;
+ALTER TABLE "users" ADD COLUMN "oidc_id" text NULL;
+ALTER TABLE "users" ADD COLUMN "role" text NULL;
+ALTER TABLE "users" ADD COLUMN "banned_at" datetime NULL;
+ALTER TABLE "users" ADD COLUMN "ban_reason" text NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS "users_openai_id_key" ON "users" ("openai_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "users_oidc_id_key" ON "users" ("oidc_id");
+ALTER TABLE "messages" ADD COLUMN "hidden" bool NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS "topics" (
+    "id" text NOT NULL,
+    "conversation_id" text UNIQUE NOT NULL,
+    "title" text NOT NULL,
+    "category" text NOT NULL DEFAULT 'general',
+    "visibility" text NOT NULL DEFAULT 'private',
+    "hidden" bool NOT NULL DEFAULT false,
+    "locked" bool NOT NULL DEFAULT false,
+    "created_at" datetime NOT NULL,
+    "updated_at" datetime NOT NULL,
+    "user_id" text NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "topics_users_topics" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+CREATE INDEX IF NOT EXISTS "topic_visibility_category_updated_at" ON "topics" ("visibility", "category", "updated_at");
+
+CREATE TABLE IF NOT EXISTS "shares" (
+    "id" text NOT NULL,
+    "conversation_id" text NOT NULL,
+    "title" text NOT NULL,
+    "messages" json NOT NULL,
+    "revoked_at" datetime NULL,
+    "created_at" datetime NOT NULL,
+    "user_id" text NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "shares_users_shares" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+
+CREATE TABLE IF NOT EXISTS "moderations" (
+    "id" integer PRIMARY KEY AUTOINCREMENT NOT NULL,
+    "stage" text NOT NULL,
+    "content" text NOT NULL,
+    "decision" text NOT NULL,
+    "reason" text NULL,
+    "provider" text NULL,
+    "review" text NOT NULL DEFAULT 'none',
+    "message_id" text NULL,
+    "conversation_id" text NULL,
+    "reviewer_id" text NULL,
+    "reviewed_at" datetime NULL,
+    "created_at" datetime NOT NULL,
+    "user_id" text NOT NULL,
+    CONSTRAINT "moderations_users_moderations" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+CREATE INDEX IF NOT EXISTS "moderation_review_created_at" ON "moderations" ("review", "created_at");
+
+CREATE TABLE IF NOT EXISTS "reports" (
+    "id" integer PRIMARY KEY AUTOINCREMENT NOT NULL,
+    "target_type" text NOT NULL,
+    "target_id" text NOT NULL,
+    "reason" text NOT NULL,
+    "detail" text NULL,
+    "status" text NOT NULL DEFAULT 'open',
+    "handler_id" text NULL,
+    "handled_at" datetime NULL,
+    "created_at" datetime NOT NULL,
+    "user_id" text NOT NULL,
+    CONSTRAINT "reports_users_reports" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+CREATE INDEX IF NOT EXISTS "report_status_created_at" ON "reports" ("status", "created_at");
+CREATE INDEX IF NOT EXISTS "report_target_type_target_id" ON "reports" ("target_type", "target_id");
+
+CREATE TABLE IF NOT EXISTS "api_keys" (
+    "id" integer PRIMARY KEY AUTOINCREMENT NOT NULL,
+    "name" text NOT NULL,
+    "prefix" text NOT NULL,
+    "hash" text UNIQUE NOT NULL,
+    "scopes" json NOT NULL,
+    "last_used_at" datetime NULL,
+    "expires_at" datetime NULL,
+    "revoked_at" datetime NULL,
+    "created_at" datetime NOT NULL,
+    "user_id" text NOT NULL,
+    CONSTRAINT "api_keys_users_api_keys" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+
+CREATE TABLE IF NOT EXISTS "credentials" (
+    "id" integer PRIMARY KEY AUTOINCREMENT NOT NULL,
+    "kind" text NOT NULL,
+    "value" text NOT NULL,
+    "key_id" text NOT NULL,
+    "user_agent" text NULL,
+    "expires_at" datetime NULL,
+    "created_at" datetime NOT NULL,
+    "updated_at" datetime NOT NULL,
+    "user_id" text NULL,
+    CONSTRAINT "credentials_users_credentials" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE SET NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "credential_kind_user_id" ON "credentials" ("kind", "user_id");
+CREATE INDEX IF NOT EXISTS "credential_key_id" ON "credentials" ("key_id");
+
+CREATE TABLE IF NOT EXISTS "audit_events" (
+    "id" integer PRIMARY KEY AUTOINCREMENT NOT NULL,
+    "action" text NOT NULL,
+    "target_type" text NULL,
+    "target_id" text NULL,
+    "ip" text NULL,
+    "user_agent" text NULL,
+    "payload" json NULL,
+    "created_at" datetime NOT NULL,
+    "actor_id" text NULL,
+    CONSTRAINT "audit_events_users_audit_events" FOREIGN KEY ("actor_id") REFERENCES "users" ("id") ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS "auditevent_action_created_at" ON "audit_events" ("action", "created_at");
+CREATE INDEX IF NOT EXISTS "auditevent_target_type_target_id" ON "audit_events" ("target_type", "target_id");
+CREATE INDEX IF NOT EXISTS "auditevent_created_at_actor_id" ON "audit_events" ("created_at", "actor_id");
+CREATE INDEX IF NOT EXISTS "auditevent_created_at" ON "audit_events" ("created_at");
@@ -0,0 +1,2 @@
+ALTER TABLE "messages" ADD COLUMN "status" text NOT NULL DEFAULT 'complete';
+CREATE INDEX IF NOT EXISTS "message_status_updated_at" ON "messages" ("status", "updated_at");
@@ -0,0 +1,5 @@
+ALTER TABLE "messages" RENAME COLUMN "conversation_id" TO "conversation_id_new";
+ALTER TABLE "messages" ADD COLUMN "conversation_id" text NULL;
+UPDATE "messages" SET "conversation_id" = "conversation_id_new";
+ALTER TABLE "messages" DROP COLUMN "conversation_id_new";
+DROP TABLE IF EXISTS "conversations";
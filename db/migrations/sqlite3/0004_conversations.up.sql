@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS "conversations" (
+    "id" text NOT NULL,
+    "upstream_id" text UNIQUE NULL,
+    "title" text NOT NULL DEFAULT '',
+    "model" text NULL,
+    "system_prompt" text NULL,
+    "visibility" text NOT NULL DEFAULT 'private',
+    "message_count" integer NOT NULL DEFAULT 0,
+    "last_activity_at" datetime NOT NULL,
+    "created_at" datetime NOT NULL,
+    "updated_at" datetime NOT NULL,
+    "user_id" text NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "conversations_users_conversations" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+CREATE INDEX IF NOT EXISTS "conversation_last_activity_at_user_id" ON "conversations" ("last_activity_at", "user_id");
+
+-- 之前的会话只保存在消息的 conversation_id 中，所有者是会话中第一条消息的用户，标题和可见性来自发布的主题
+UPDATE "messages" SET "conversation_id" = NULL WHERE "conversation_id" = '';
+INSERT INTO "conversations" ("id", "upstream_id", "title", "visibility", "message_count", "last_activity_at", "created_at", "updated_at", "user_id")
+SELECT m."conversation_id", m."conversation_id", COALESCE(t."title", ''), COALESCE(t."visibility", 'private'),
+    COUNT(*), MAX(m."created_at"), MIN(m."created_at"), MAX(m."updated_at"),
+    (SELECT f."user_id" FROM "messages" f WHERE f."conversation_id" = m."conversation_id" ORDER BY f."created_at" LIMIT 1)
+FROM "messages" m
+LEFT JOIN "topics" t ON t."conversation_id" = m."conversation_id"
+WHERE m."conversation_id" IS NOT NULL
+GROUP BY m."conversation_id", t."title", t."visibility";
+
+-- SQLite 不能给已有的列添加外键，新建带外键的列并复制数据后删除原来的列
+ALTER TABLE "messages" RENAME COLUMN "conversation_id" TO "conversation_id_old";
+ALTER TABLE "messages" ADD COLUMN "conversation_id" text NULL REFERENCES "conversations" ("id") ON DELETE SET NULL;
+UPDATE "messages" SET "conversation_id" = "conversation_id_old";
+ALTER TABLE "messages" DROP COLUMN "conversation_id_old";
@@ -0,0 +1,5 @@
+ALTER TABLE "shares" DROP COLUMN "summary";
+ALTER TABLE "topics" DROP COLUMN "summary";
+ALTER TABLE "conversations" DROP COLUMN "summary_message_count";
+ALTER TABLE "conversations" DROP COLUMN "summary";
+ALTER TABLE "conversations" DROP COLUMN "custom_title";
@@ -0,0 +1,9 @@
+ALTER TABLE "messages" ADD COLUMN "deleted_at" datetime NULL;
+ALTER TABLE "messages" ADD COLUMN "deleted_by" text NULL;
+ALTER TABLE "conversations" ADD COLUMN "deleted_at" datetime NULL;
+ALTER TABLE "conversations" ADD COLUMN "deleted_by" text NULL;
+ALTER TABLE "topics" ADD COLUMN "deleted_at" datetime NULL;
+ALTER TABLE "topics" ADD COLUMN "deleted_by" text NULL;
+CREATE INDEX IF NOT EXISTS "message_deleted_at" ON "messages" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "conversation_deleted_at" ON "conversations" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "topic_deleted_at" ON "topics" ("deleted_at");
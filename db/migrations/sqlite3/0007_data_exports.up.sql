@@ -0,0 +1,14 @@
+ALTER TABLE "users" ADD COLUMN "deleted_at" datetime NULL;
+CREATE TABLE IF NOT EXISTS "data_exports" (
+    "id" text NOT NULL,
+    "status" text NOT NULL DEFAULT 'pending',
+    "size" integer NOT NULL DEFAULT 0,
+    "error" text NULL,
+    "expires_at" datetime NOT NULL,
+    "created_at" datetime NOT NULL,
+    "updated_at" datetime NOT NULL,
+    "user_id" text NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "data_exports_users_data_exports" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+CREATE INDEX IF NOT EXISTS "dataexport_expires_at" ON "data_exports" ("expires_at");
@@ -0,0 +1,3 @@
+-- 会话令牌使用每个用户随机的 stamp 签名，升级后已有的会话需要重新登录
+ALTER TABLE "users" ADD COLUMN "session_stamp" text NOT NULL DEFAULT '';
+UPDATE "users" SET "session_stamp" = lower(hex(randomblob(16)));
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/message"
+	entmoderation "community.threetenth.chatgpt/ent/moderation"
+)
+
+// SaveModeration 记录一次审核结果，hold 的结果会进入审核队列
+func (s *SQLStore) SaveModeration(ctx context.Context, stage, content, decision, reason, provider, messageID, conversationID, userID string) (*ent.Moderation, error) {
+	review := entmoderation.ReviewNone
+	if entmoderation.Decision(decision) == entmoderation.DecisionHold {
+		review = entmoderation.ReviewPending
+	}
+	return s.client.Moderation.Create().
+		SetStage(entmoderation.Stage(stage)).
+		SetContent(content).
+		SetDecision(entmoderation.Decision(decision)).
+		SetReason(reason).
+		SetProvider(provider).
+		SetReview(review).
+		SetMessageID(messageID).
+		SetConversationID(conversationID).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+// ListModerations 分页获取指定审核状态的记录，按时间倒序
+func (s *SQLStore) ListModerations(ctx context.Context, review entmoderation.Review, offset, limit int) ([]*ent.Moderation, error) {
+	return s.client.Moderation.Query().
+		Where(entmoderation.ReviewEQ(review)).
+		WithUser().
+		Order(ent.Desc(entmoderation.FieldCreatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+// ReviewModeration 管理员处理一条等待审核的记录
+//
+// 通过时，关联的消息会被公开；拒绝时，关联的消息保持隐藏。
+func (s *SQLStore) ReviewModeration(ctx context.Context, id int, approve bool, reviewerID string) (*ent.Moderation, error) {
+	var m *ent.Moderation
+	err := WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		m, err = tx.Moderation.Query().
+			Where(
+				entmoderation.ID(id),
+				entmoderation.ReviewEQ(entmoderation.ReviewPending),
+			).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		review := entmoderation.ReviewRejected
+		if approve {
+			review = entmoderation.ReviewApproved
+		}
+		m, err = m.Update().
+			SetReview(review).
+			SetReviewerID(reviewerID).
+			SetReviewedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		if approve && m.MessageID != "" {
+			return tx.Message.Update().
+				Where(message.ID(m.MessageID)).
+				SetHidden(false).
+				Exec(ctx)
+		}
+		return nil
+	})
+	return m, err
+}
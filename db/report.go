@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/report"
+)
+
+// SaveReport 保存用户的举报
+func (s *SQLStore) SaveReport(ctx context.Context, targetType report.TargetType, targetID string, reason report.Reason, detail, userID string) (*ent.Report, error) {
+	return s.client.Report.Create().
+		SetTargetType(targetType).
+		SetTargetID(targetID).
+		SetReason(reason).
+		SetDetail(detail).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+// ListReports 分页获取指定状态的举报，按时间倒序
+func (s *SQLStore) ListReports(ctx context.Context, status report.Status, offset, limit int) ([]*ent.Report, error) {
+	return s.client.Report.Query().
+		Where(report.StatusEQ(status)).
+		WithUser().
+		Order(ent.Desc(report.FieldCreatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+// HandleReport 处理一个未处理的举报
+func (s *SQLStore) HandleReport(ctx context.Context, id int, status report.Status, handlerID string) (*ent.Report, error) {
+	r, err := s.client.Report.Query().
+		Where(report.ID(id), report.StatusEQ(report.StatusOpen)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.Update().
+		SetStatus(status).
+		SetHandlerID(handlerID).
+		SetHandledAt(time.Now()).
+		Save(ctx)
+}
@@ -1,31 +1,38 @@
 package db
 
 import (
+	"context"
+
 	"community.threetenth.chatgpt/ent"
-	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/user"
 )
 
-// GetConversation 获取指定的会话
-func GetConversation(id string) ([]*ent.Message, error) {
-	return client.Message.Query().Where(message.ConversationID(id)).All(ctx)
+// GetMessage 获取指定的消息
+func (s *SQLStore) GetMessage(ctx context.Context, id string) (*ent.Message, error) {
+	return s.client.Message.Get(ctx, id)
 }
 
-// GetMessage 获取指定的消息
-func GetMessage(id string) (*ent.Message, error) {
-	return client.Message.Get(ctx, id)
+// GetUser 获取指定的用户
+func (s *SQLStore) GetUser(ctx context.Context, id string) (*ent.User, error) {
+	return s.client.User.Get(ctx, id)
 }
 
-// SaveMessage 保存消息
-func SaveMessage(id, content, contentType, role, conversationID, parentMessageID, userID string) (*ent.Message, error) {
-	return client.Message.Create().
+// SaveMessage 保存消息，hidden 的消息在审核通过前不会公开显示
+//
+// conversationID 不为空时会话必须已经存在，消息数量由调用方维护。
+func (s *SQLStore) SaveMessage(ctx context.Context, id, content, contentType, role, conversationID, parentMessageID, userID string, hidden bool) (*ent.Message, error) {
+	create := s.client.Message.Create().
 		SetID(id).
 		SetContent(content).
 		SetContentType(contentType).
 		SetRole(role).
-		SetConversationID(conversationID).
 		SetParentMessageID(parentMessageID).
 		SetUserID(userID).
-		Save(ctx)
+		SetHidden(hidden)
+	if conversationID != "" {
+		create.SetConversationID(conversationID)
+	}
+	return create.Save(ctx)
 }
 
 // SaveUser 保存用户信息
@@ -33,9 +40,9 @@ func SaveMessage(id, content, contentType, role, conversationID, parentMessageID
 //使用 upsert，如果没有则保存，如果有，则更新。
 // https://entgo.io/docs/feature-flags/#usage
 // https://entgo.io/docs/feature-flags/#upsert
-func SaveUser(id, name, email, image string, groups, features []string) error {
-	// client.User.upse
-	return client.User.Create().
+func (s *SQLStore) SaveUser(ctx context.Context, id, name, email, image string, groups, features []string) error {
+	// s.client.User.upse
+	return s.client.User.Create().
 		SetID(id).
 		SetName(name).
 		SetEmail(email).
@@ -44,5 +51,9 @@ func SaveUser(id, name, email, image string, groups, features []string) error {
 		SetFeatures(features).
 		OnConflict().
 		UpdateNewValues().
+		Update(func(u *ent.UserUpsert) {
+			// 每次登录不会更换会话 stamp
+			u.SetIgnore(user.FieldSessionStamp)
+		}).
 		Exec(ctx)
 }
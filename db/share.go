@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/schema"
+	"community.threetenth.chatgpt/ent/share"
+	"community.threetenth.chatgpt/ent/user"
+)
+
+const shareSlugAlphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+const shareSlugLength = 8
+
+const shareTitleLength = 64
+
+// truncate 按字符截断文本，超出部分以省略号代替
+func truncate(s string, n int) string {
+	rs := []rune(s)
+	if len(rs) <= n {
+		return s
+	}
+	return string(rs[:n]) + "…"
+}
+
+func newShareSlug() (string, error) {
+	bs := make([]byte, shareSlugLength)
+	max := big.NewInt(int64(len(shareSlugAlphabet)))
+	for i := range bs {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		bs[i] = shareSlugAlphabet[n.Int64()]
+	}
+	return string(bs), nil
+}
+
+// CreateShare 为用户的会话创建一个只读快照
+//
+// 快照保存创建时会话中未删除的消息和摘要，之后会话的变化不会影响已创建的分享。
+// title 为空时使用会话的标题。
+func (s *SQLStore) CreateShare(ctx context.Context, conversationID, title, userID string) (*ent.Share, error) {
+	messages, err := s.client.Message.Query().
+		Where(
+			message.ConversationID(conversationID),
+			message.HasUserWith(user.ID(userID)),
+			message.Hidden(false),
+			message.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(message.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, &ent.NotFoundError{}
+	}
+
+	snapshot := make([]*schema.SnapshotMessage, 0, len(messages))
+	for _, m := range messages {
+		snapshot = append(snapshot, &schema.SnapshotMessage{
+			ID:              m.ID,
+			Role:            m.Role,
+			Content:         m.Content,
+			ContentType:     m.ContentType,
+			ParentMessageID: m.ParentMessageID,
+			CreatedAt:       m.CreatedAt,
+		})
+	}
+	c, err := s.GetConversation(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if title == "" {
+		title = c.Title
+	}
+	if title == "" {
+		title = truncate(snapshot[0].Content, shareTitleLength)
+	}
+
+	slug, err := newShareSlug()
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Share.Create().
+		SetID(slug).
+		SetConversationID(conversationID).
+		SetTitle(title).
+		SetSummary(c.Summary).
+		SetMessages(snapshot).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+// GetShare 获取一个未撤销的分享
+func (s *SQLStore) GetShare(ctx context.Context, slug string) (*ent.Share, error) {
+	return s.client.Share.Query().
+		Where(share.ID(slug), share.RevokedAtIsNil()).
+		Only(ctx)
+}
+
+// RevokeShare 撤销用户自己的分享，撤销后链接不再可以访问
+func (s *SQLStore) RevokeShare(ctx context.Context, slug, userID string) error {
+	n, err := s.client.Share.Update().
+		Where(
+			share.ID(slug),
+			share.RevokedAtIsNil(),
+			share.HasUserWith(user.ID(userID)),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
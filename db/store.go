@@ -0,0 +1,163 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/credential"
+	"community.threetenth.chatgpt/ent/message"
+	entmoderation "community.threetenth.chatgpt/ent/moderation"
+	"community.threetenth.chatgpt/ent/report"
+	"community.threetenth.chatgpt/ent/topic"
+)
+
+// UserStore 保存用户和本地账号
+type UserStore interface {
+	GetUser(ctx context.Context, id string) (*ent.User, error)
+	SaveUser(ctx context.Context, id, name, email, image string, groups, features []string) error
+	CreateLocalUser(ctx context.Context, name, email, passwordHash string) (*ent.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*ent.User, error)
+	GetUserByOpenAIID(ctx context.Context, openaiID string) (*ent.User, error)
+	GetUserByOIDCID(ctx context.Context, oidcID string) (*ent.User, error)
+	VerifyUserEmail(ctx context.Context, id string) error
+	ResetUserPassword(ctx context.Context, id, passwordHash string) error
+	RotateSessionStamp(ctx context.Context, id string) error
+	LinkOpenAIUser(ctx context.Context, id, openaiID string) error
+	SaveOIDCUser(ctx context.Context, id, oidcID, name, email, image string, groups []string) (*ent.User, error)
+	BanUser(ctx context.Context, id, reason string) error
+	UnbanUser(ctx context.Context, id string) error
+	SetUserRole(ctx context.Context, id, role string) error
+	DeleteAccount(ctx context.Context, id string, purge bool) ([]string, error)
+}
+
+// MessageStore 保存会话中的消息
+type MessageStore interface {
+	GetMessage(ctx context.Context, id string) (*ent.Message, error)
+	SaveMessage(ctx context.Context, id, content, contentType, role, conversationID, parentMessageID, userID string, hidden bool) (*ent.Message, error)
+	SetMessageHidden(ctx context.Context, id string, hidden bool) error
+	IsMessageOwner(ctx context.Context, id, userID string) (bool, error)
+}
+
+// TurnStore 保存一轮提问和回复，提问的状态记录这一轮的进度
+type TurnStore interface {
+	StartTurn(ctx context.Context, q *Question) (*ent.Message, error)
+	SetTurnStatus(ctx context.Context, id string, status message.Status) error
+	CompleteTurn(ctx context.Context, questionID, upstreamConversationID, userID string, answer *Answer) (*ent.Message, error)
+	SweepTurns(ctx context.Context, before time.Time) (int, error)
+}
+
+// ConversationStore 保存会话，查询会话的消息和访问权限
+type ConversationStore interface {
+	GetConversation(ctx context.Context, id string) (*ent.Conversation, error)
+	GetConversationMessages(ctx context.Context, id string, includeHidden bool) ([]*ent.Message, error)
+	ListUserConversations(ctx context.Context, userID string, deleted bool, offset, limit int) ([]*ent.Conversation, error)
+	UpdateConversation(ctx context.Context, id, userID string, title, systemPrompt *string) (*ent.Conversation, error)
+	SaveConversationSummary(ctx context.Context, id, title, summary string, messageCount int) error
+	IsConversationOwner(ctx context.Context, conversationID, userID string) (bool, error)
+	IsConversationReadable(ctx context.Context, conversationID string) (bool, error)
+	IsConversationLocked(ctx context.Context, conversationID string) (bool, error)
+	ImportConversation(ctx context.Context, userID string, c *ImportedConversation, dryRun bool) (*ImportResult, error)
+}
+
+// TopicStore 保存会话发布的主题
+type TopicStore interface {
+	GetTopic(ctx context.Context, id string) (*ent.Topic, error)
+	GetTopicByConversation(ctx context.Context, conversationID string) (*ent.Topic, error)
+	GetReadableTopic(ctx context.Context, id string) (*ent.Topic, error)
+	SaveTopic(ctx context.Context, conversationID, title, category string, visibility topic.Visibility, userID string) (*ent.Topic, error)
+	ListPublicTopics(ctx context.Context, category string, offset, limit int) ([]*ent.Topic, error)
+	ListUserPublicTopics(ctx context.Context, userID string, offset, limit int) ([]*ent.Topic, error)
+	ListPublicCategories(ctx context.Context) ([]string, error)
+	SetTopicHidden(ctx context.Context, id string, hidden bool) error
+	SetTopicLocked(ctx context.Context, id string, locked bool) error
+}
+
+// TrashStore 软删除和恢复会话、主题和消息，超过保留期限后彻底删除
+type TrashStore interface {
+	DeleteMessage(ctx context.Context, id, actorID string, moderator bool) error
+	RestoreMessage(ctx context.Context, id, actorID string, moderator bool) error
+	DeleteConversation(ctx context.Context, id, userID string) error
+	RestoreConversation(ctx context.Context, id, userID string) error
+	DeleteTopic(ctx context.Context, id, actorID string, moderator bool) error
+	RestoreTopic(ctx context.Context, id, actorID string, moderator bool) error
+	PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+}
+
+// DataExportStore 保存用户数据的导出任务
+type DataExportStore interface {
+	GetUserData(ctx context.Context, userID string) (*UserData, error)
+	CreateDataExport(ctx context.Context, userID string, expiresAt time.Time) (*ent.DataExport, error)
+	GetDataExport(ctx context.Context, id, userID string) (*ent.DataExport, error)
+	ListDataExports(ctx context.Context, userID string) ([]*ent.DataExport, error)
+	SetDataExportReady(ctx context.Context, id string, size int64) error
+	SetDataExportFailed(ctx context.Context, id, reason string) error
+	PurgeDataExports(ctx context.Context, before time.Time) ([]string, error)
+}
+
+// ShareStore 保存会话的只读分享
+type ShareStore interface {
+	CreateShare(ctx context.Context, conversationID, title, userID string) (*ent.Share, error)
+	GetShare(ctx context.Context, slug string) (*ent.Share, error)
+	RevokeShare(ctx context.Context, slug, userID string) error
+}
+
+// ModerationStore 保存审核记录
+type ModerationStore interface {
+	SaveModeration(ctx context.Context, stage, content, decision, reason, provider, messageID, conversationID, userID string) (*ent.Moderation, error)
+	ListModerations(ctx context.Context, review entmoderation.Review, offset, limit int) ([]*ent.Moderation, error)
+	ReviewModeration(ctx context.Context, id int, approve bool, reviewerID string) (*ent.Moderation, error)
+}
+
+// ReportStore 保存举报
+type ReportStore interface {
+	SaveReport(ctx context.Context, targetType report.TargetType, targetID string, reason report.Reason, detail, userID string) (*ent.Report, error)
+	ListReports(ctx context.Context, status report.Status, offset, limit int) ([]*ent.Report, error)
+	HandleReport(ctx context.Context, id int, status report.Status, handlerID string) (*ent.Report, error)
+}
+
+// APIKeyStore 保存个人 API key
+type APIKeyStore interface {
+	CreateAPIKey(ctx context.Context, userID, name, prefix, hash string, scopes []string, expiresAt *time.Time) (*ent.APIKey, error)
+	ListAPIKeys(ctx context.Context, userID string) ([]*ent.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, hash string) (*ent.APIKey, error)
+	TouchAPIKey(ctx context.Context, k *ent.APIKey) error
+	RevokeAPIKey(ctx context.Context, id int, userID string) error
+	RotateAPIKey(ctx context.Context, id int, userID, prefix, hash string) (*ent.APIKey, error)
+}
+
+// CredentialStore 保存加密的上游凭据
+type CredentialStore interface {
+	GetCredential(ctx context.Context, userID string, kind credential.Kind) (*ent.Credential, error)
+	SaveCredential(ctx context.Context, userID string, kind credential.Kind, value, keyID, userAgent string, expiresAt *time.Time) error
+	DeleteUserCredentials(ctx context.Context, userID string) error
+	ListCredentialsNotEncryptedWith(ctx context.Context, keyID string) ([]*ent.Credential, error)
+	UpdateCredentialValue(ctx context.Context, id int, value, keyID string) error
+}
+
+// AuditStore 保存审计记录
+type AuditStore interface {
+	SaveAuditEvent(ctx context.Context, actorID, action, targetType, targetID, ip, userAgent string, payload map[string]interface{}) (*ent.AuditEvent, error)
+	ListAuditEvents(ctx context.Context, filter *AuditFilter, offset, limit int) ([]*ent.AuditEvent, error)
+	PurgeAuditEvents(ctx context.Context, before time.Time) (int, error)
+}
+
+// Store 是论坛的数据存储，所有方法都使用调用方的 context，请求取消时数据库操作也会取消
+type Store interface {
+	UserStore
+	MessageStore
+	TurnStore
+	ConversationStore
+	TopicStore
+	TrashStore
+	DataExportStore
+	ShareStore
+	ModerationStore
+	ReportStore
+	APIKeyStore
+	CredentialStore
+	AuditStore
+
+	// Close 关闭数据库连接
+	Close() error
+}
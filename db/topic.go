@@ -0,0 +1,161 @@
+package db
+
+import (
+	"context"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/ent/user"
+	"github.com/google/uuid"
+)
+
+// IsMessageOwner 判断指定的消息是否属于该用户
+func (s *SQLStore) IsMessageOwner(ctx context.Context, id, userID string) (bool, error) {
+	return s.client.Message.Query().
+		Where(
+			message.ID(id),
+			message.HasUserWith(user.ID(userID)),
+		).
+		Exist(ctx)
+}
+
+// IsConversationReadable 判断会话是否可以通过链接访问，即会话对应 public 或 unlisted 的主题
+func (s *SQLStore) IsConversationReadable(ctx context.Context, conversationID string) (bool, error) {
+	return s.client.Topic.Query().
+		Where(
+			topic.ConversationID(conversationID),
+			topic.VisibilityIn(topic.VisibilityPublic, topic.VisibilityUnlisted),
+			topic.Hidden(false),
+			topic.DeletedAtIsNil(),
+		).
+		Exist(ctx)
+}
+
+// GetTopicByConversation 获取会话对应的主题，包括被删除的主题
+func (s *SQLStore) GetTopicByConversation(ctx context.Context, conversationID string) (*ent.Topic, error) {
+	return s.client.Topic.Query().
+		Where(topic.ConversationID(conversationID)).
+		WithUser().
+		Only(ctx)
+}
+
+// SaveTopic 保存会话对应的主题，标题和可见性可以反复修改，会话的可见性与主题保持一致
+//
+// 如果该会话的主题已经存在，则只有作者本人可以修改，否则返回 ent.NotFoundError。
+// 作者自己删除的主题会重新发布，被管理员删除的主题返回 ErrRemoved。
+func (s *SQLStore) SaveTopic(ctx context.Context, conversationID, title, category string, visibility topic.Visibility, userID string) (*ent.Topic, error) {
+	t, err := s.GetTopicByConversation(ctx, conversationID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil && (t.Edges.User == nil || t.Edges.User.ID != userID) {
+		return nil, &ent.NotFoundError{}
+	}
+	if err == nil && t.DeletedAt != nil && t.DeletedBy != userID {
+		return nil, ErrRemoved
+	}
+
+	err = WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		if t == nil {
+			// 新的主题使用会话已经生成的摘要
+			var c *ent.Conversation
+			c, err = tx.Conversation.Get(ctx, conversationID)
+			if err != nil {
+				return err
+			}
+			t, err = tx.Topic.Create().
+				SetID(uuid.NewString()).
+				SetConversationID(conversationID).
+				SetTitle(title).
+				SetSummary(c.Summary).
+				SetCategory(category).
+				SetVisibility(visibility).
+				SetUserID(userID).
+				Save(ctx)
+		} else {
+			t, err = tx.Topic.UpdateOne(t).
+				SetTitle(title).
+				SetCategory(category).
+				SetVisibility(visibility).
+				ClearDeletedAt().
+				ClearDeletedBy().
+				Save(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		return tx.Conversation.Update().
+			Where(conversation.ID(conversationID)).
+			SetVisibility(conversation.Visibility(visibility)).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ListPublicTopics 分页获取公开的主题，按最后更新时间倒序
+//
+// category 为空时返回所有分类的主题。
+func (s *SQLStore) ListPublicTopics(ctx context.Context, category string, offset, limit int) ([]*ent.Topic, error) {
+	query := s.client.Topic.Query().
+		Where(
+			topic.VisibilityEQ(topic.VisibilityPublic),
+			topic.Hidden(false),
+			topic.DeletedAtIsNil(),
+		)
+	if category != "" {
+		query = query.Where(topic.Category(category))
+	}
+	return query.
+		WithUser().
+		Order(ent.Desc(topic.FieldUpdatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+// ListUserPublicTopics 分页获取指定用户公开的主题
+func (s *SQLStore) ListUserPublicTopics(ctx context.Context, userID string, offset, limit int) ([]*ent.Topic, error) {
+	return s.client.Topic.Query().
+		Where(
+			topic.VisibilityEQ(topic.VisibilityPublic),
+			topic.Hidden(false),
+			topic.DeletedAtIsNil(),
+			topic.HasUserWith(user.ID(userID)),
+		).
+		Order(ent.Desc(topic.FieldUpdatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+// ListPublicCategories 获取所有包含公开主题的分类
+func (s *SQLStore) ListPublicCategories(ctx context.Context) ([]string, error) {
+	return s.client.Topic.Query().
+		Where(
+			topic.VisibilityEQ(topic.VisibilityPublic),
+			topic.Hidden(false),
+			topic.DeletedAtIsNil(),
+		).
+		Unique(true).
+		Select(topic.FieldCategory).
+		Strings(ctx)
+}
+
+// GetReadableTopic 获取一个可以通过链接访问的主题，即 public 或 unlisted 的主题
+func (s *SQLStore) GetReadableTopic(ctx context.Context, id string) (*ent.Topic, error) {
+	return s.client.Topic.Query().
+		Where(
+			topic.ID(id),
+			topic.VisibilityIn(topic.VisibilityPublic, topic.VisibilityUnlisted),
+			topic.Hidden(false),
+			topic.DeletedAtIsNil(),
+		).
+		WithUser().
+		Only(ctx)
+}
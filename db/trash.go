@@ -0,0 +1,261 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/share"
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/ent/user"
+)
+
+// ErrRemoved 是内容被管理员删除，作者不能恢复
+var ErrRemoved = errors.New("removed by a moderator")
+
+// deletedNow 返回软删除使用的时间
+//
+// PostgreSQL 的时间精度是微秒，截断后恢复会话时可以按删除时间找到一起删除的主题。
+func deletedNow() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
+// DeleteMessage 软删除一条消息，被删除的消息在会话中显示为占位，回复关系保持不变
+//
+// moderator 为 false 时只能删除自己的消息。消息不存在、已经删除或者不属于该用户时返回 ent.NotFoundError。
+func (s *SQLStore) DeleteMessage(ctx context.Context, id, actorID string, moderator bool) error {
+	update := s.client.Message.Update().
+		Where(message.ID(id), message.DeletedAtIsNil())
+	if !moderator {
+		update.Where(message.HasUserWith(user.ID(actorID)))
+	}
+	n, err := update.
+		SetDeletedAt(deletedNow()).
+		SetDeletedBy(actorID).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// RestoreMessage 恢复一条软删除的消息
+//
+// moderator 为 false 时只能恢复自己删除的消息，被管理员删除的消息返回 ErrRemoved。
+func (s *SQLStore) RestoreMessage(ctx context.Context, id, actorID string, moderator bool) error {
+	return WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		query := tx.Message.Query().
+			Where(message.ID(id), message.DeletedAtNotNil())
+		if !moderator {
+			query.Where(message.HasUserWith(user.ID(actorID)))
+		}
+		m, err := query.Only(ctx)
+		if err != nil {
+			return err
+		}
+		if !moderator && m.DeletedBy != actorID {
+			return ErrRemoved
+		}
+		return tx.Message.UpdateOne(m).
+			ClearDeletedAt().
+			ClearDeletedBy().
+			Exec(ctx)
+	})
+}
+
+// DeleteConversation 软删除用户自己的会话，会话的主题同时删除，分享的快照不受影响
+//
+// 会话不存在、已经删除或者不属于该用户时返回 ent.NotFoundError。
+func (s *SQLStore) DeleteConversation(ctx context.Context, id, userID string) error {
+	return WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		now := deletedNow()
+		n, err := tx.Conversation.Update().
+			Where(
+				conversation.ID(id),
+				conversation.DeletedAtIsNil(),
+				conversation.HasUserWith(user.ID(userID)),
+			).
+			SetDeletedAt(now).
+			SetDeletedBy(userID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return &ent.NotFoundError{}
+		}
+		return tx.Topic.Update().
+			Where(topic.ConversationID(id), topic.DeletedAtIsNil()).
+			SetDeletedAt(now).
+			SetDeletedBy(userID).
+			Exec(ctx)
+	})
+}
+
+// RestoreConversation 恢复用户自己删除的会话，和会话一起删除的主题同时恢复
+//
+// 会话不存在、没有被删除或者不属于该用户时返回 ent.NotFoundError。
+func (s *SQLStore) RestoreConversation(ctx context.Context, id, userID string) error {
+	return WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		c, err := tx.Conversation.Query().
+			Where(
+				conversation.ID(id),
+				conversation.DeletedAtNotNil(),
+				conversation.HasUserWith(user.ID(userID)),
+			).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if err = tx.Conversation.UpdateOne(c).ClearDeletedAt().ClearDeletedBy().Exec(ctx); err != nil {
+			return err
+		}
+		// 删除会话之前已经被单独删除的主题保持删除
+		return tx.Topic.Update().
+			Where(topic.ConversationID(id), topic.DeletedAt(*c.DeletedAt)).
+			ClearDeletedAt().
+			ClearDeletedBy().
+			Exec(ctx)
+	})
+}
+
+// DeleteTopic 软删除一个主题，会话中的消息不受影响，会话恢复为 private
+//
+// moderator 为 false 时只能删除自己的主题。主题不存在、已经删除或者不属于该用户时返回 ent.NotFoundError。
+func (s *SQLStore) DeleteTopic(ctx context.Context, id, actorID string, moderator bool) error {
+	return WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		query := tx.Topic.Query().
+			Where(topic.ID(id), topic.DeletedAtIsNil())
+		if !moderator {
+			query.Where(topic.HasUserWith(user.ID(actorID)))
+		}
+		t, err := query.Only(ctx)
+		if err != nil {
+			return err
+		}
+		err = tx.Topic.UpdateOne(t).
+			SetDeletedAt(deletedNow()).
+			SetDeletedBy(actorID).
+			SetUpdatedAt(t.UpdatedAt).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return tx.Conversation.Update().
+			Where(conversation.ID(t.ConversationID)).
+			SetVisibility(conversation.VisibilityPrivate).
+			Exec(ctx)
+	})
+}
+
+// RestoreTopic 恢复一个软删除的主题，会话的可见性恢复为主题的可见性
+//
+// moderator 为 false 时只能恢复自己删除的主题，被管理员删除的主题返回 ErrRemoved。
+// 会话已经删除时返回 ent.NotFoundError，需要恢复会话。
+func (s *SQLStore) RestoreTopic(ctx context.Context, id, actorID string, moderator bool) error {
+	return WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		query := tx.Topic.Query().
+			Where(topic.ID(id), topic.DeletedAtNotNil())
+		if !moderator {
+			query.Where(topic.HasUserWith(user.ID(actorID)))
+		}
+		t, err := query.Only(ctx)
+		if err != nil {
+			return err
+		}
+		if !moderator && t.DeletedBy != actorID {
+			return ErrRemoved
+		}
+		n, err := tx.Conversation.Update().
+			Where(conversation.ID(t.ConversationID), conversation.DeletedAtIsNil()).
+			SetVisibility(conversation.Visibility(t.Visibility)).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return &ent.NotFoundError{}
+		}
+		return tx.Topic.UpdateOne(t).
+			ClearDeletedAt().
+			ClearDeletedBy().
+			SetUpdatedAt(t.UpdatedAt).
+			Exec(ctx)
+	})
+}
+
+// PurgeDeleted 彻底删除 before 之前软删除的会话、主题和消息，返回删除的数量
+//
+// 会话的消息、主题和分享随会话一起删除。单独删除的消息的回复改为回复它最近的未被删除的祖先，
+// 会话树保持连通。
+func (s *SQLStore) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	total := 0
+	err := WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		ids, err := tx.Conversation.Query().
+			Where(conversation.DeletedAtLT(before)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			if _, err = tx.Message.Delete().Where(message.ConversationIDIn(ids...)).Exec(ctx); err != nil {
+				return err
+			}
+			if _, err = tx.Topic.Delete().Where(topic.ConversationIDIn(ids...)).Exec(ctx); err != nil {
+				return err
+			}
+			if _, err = tx.Share.Delete().Where(share.ConversationIDIn(ids...)).Exec(ctx); err != nil {
+				return err
+			}
+			n, err := tx.Conversation.Delete().Where(conversation.IDIn(ids...)).Exec(ctx)
+			if err != nil {
+				return err
+			}
+			total += n
+		}
+
+		n, err := tx.Topic.Delete().Where(topic.DeletedAtLT(before)).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		total += n
+
+		messages, err := tx.Message.Query().
+			Where(message.DeletedAtLT(before)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, m := range messages {
+			// 父消息可能已经在这次循环中被删除，重新读取当前的父消息，回复改为回复最近的未被删除的祖先
+			if m, err = tx.Message.Get(ctx, m.ID); err != nil {
+				return err
+			}
+			err = tx.Message.Update().
+				Where(message.ParentMessageID(m.ID)).
+				SetParentMessageID(m.ParentMessageID).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			if err = tx.Message.DeleteOne(m).Exec(ctx); err != nil {
+				return err
+			}
+			if m.ConversationID != "" {
+				err = tx.Conversation.UpdateOneID(m.ConversationID).AddMessageCount(-1).Exec(ctx)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		total += len(messages)
+		return nil
+	})
+	return total, err
+}
@@ -0,0 +1,178 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/user"
+	"github.com/google/uuid"
+)
+
+// ErrTurnFinished 是这一轮提问已经结束，不能再修改状态或保存回复
+var ErrTurnFinished = errors.New("turn is already finished")
+
+// Answer 是一轮提问中 ChatGPT 的回复
+type Answer struct {
+	ID          string
+	Content     string
+	ContentType string
+	Role        string
+	// Hidden 为 true 时回复等待审核，不会公开显示
+	Hidden bool
+}
+
+// Question 是一轮提问中用户的提问
+type Question struct {
+	ID     string
+	Prompt string
+	// ConversationID 为空时创建一个新的会话
+	ConversationID  string
+	ParentMessageID string
+	Model           string
+	UserID          string
+	// Status 为 pending 时提问会发送给 ChatGPT，等待审核、不会发送的提问为 cancelled
+	Status message.Status
+	// Hidden 为 true 时提问等待审核，不会公开显示
+	Hidden bool
+}
+
+// StartTurn 在一个事务中保存一轮提问中用户的提问，并更新会话的消息数量和最后活动时间
+//
+// 新会话以提问的开头作为标题，ChatGPT 中的会话 ID 在保存回复时设置。
+// 继续的会话不存在、已经删除或者不属于该用户时返回 ent.NotFoundError。
+func (s *SQLStore) StartTurn(ctx context.Context, q *Question) (*ent.Message, error) {
+	var m *ent.Message
+	err := WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		conversationID := q.ConversationID
+		if conversationID == "" {
+			c, err := tx.Conversation.Create().
+				SetID(uuid.NewString()).
+				SetTitle(truncate(q.Prompt, conversationTitleLength)).
+				SetModel(q.Model).
+				SetMessageCount(1).
+				SetUserID(q.UserID).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			conversationID = c.ID
+		} else {
+			n, err := tx.Conversation.Update().
+				Where(
+					conversation.ID(conversationID),
+					conversation.DeletedAtIsNil(),
+					conversation.HasUserWith(user.ID(q.UserID)),
+				).
+				AddMessageCount(1).
+				SetLastActivityAt(time.Now()).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return &ent.NotFoundError{}
+			}
+		}
+
+		var err error
+		m, err = tx.Message.Create().
+			SetID(q.ID).
+			SetContent(q.Prompt).
+			SetContentType("text").
+			SetRole("user").
+			SetConversationID(conversationID).
+			SetParentMessageID(q.ParentMessageID).
+			SetUserID(q.UserID).
+			SetHidden(q.Hidden).
+			SetStatus(q.Status).
+			Save(ctx)
+		return err
+	})
+	return m, err
+}
+
+// SetTurnStatus 修改一轮提问的状态，只有 pending 和 streaming 的提问可以修改，否则返回 ErrTurnFinished
+func (s *SQLStore) SetTurnStatus(ctx context.Context, id string, status message.Status) error {
+	n, err := s.client.Message.Update().
+		Where(
+			message.ID(id),
+			message.StatusIn(message.StatusPending, message.StatusStreaming),
+		).
+		SetStatus(status).
+		Save(ctx)
+	if err == nil && n == 0 {
+		err = ErrTurnFinished
+	}
+	return err
+}
+
+// CompleteTurn 在一个事务中保存回复，并将提问标记为 complete
+//
+// 新会话在 ChatGPT 中的 ID 由 ChatGPT 生成，第一次保存回复时记录到会话的 upstream_id。
+// 提问已经结束时返回 ErrTurnFinished，不会保存回复。
+func (s *SQLStore) CompleteTurn(ctx context.Context, questionID, upstreamConversationID, userID string, answer *Answer) (*ent.Message, error) {
+	var m *ent.Message
+	err := WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		n, err := tx.Message.Update().
+			Where(
+				message.ID(questionID),
+				message.StatusIn(message.StatusPending, message.StatusStreaming),
+			).
+			SetStatus(message.StatusComplete).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrTurnFinished
+		}
+
+		c, err := tx.Message.Query().
+			Where(message.ID(questionID)).
+			QueryConversation().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		update := c.Update().
+			AddMessageCount(1).
+			SetLastActivityAt(time.Now())
+		if c.UpstreamID == nil {
+			update.SetUpstreamID(upstreamConversationID)
+		}
+		if err = update.Exec(ctx); err != nil {
+			return err
+		}
+
+		m, err = tx.Message.Create().
+			SetID(answer.ID).
+			SetContent(answer.Content).
+			SetContentType(answer.ContentType).
+			SetRole(answer.Role).
+			SetConversationID(c.ID).
+			SetParentMessageID(questionID).
+			SetUserID(userID).
+			SetHidden(answer.Hidden).
+			SetStatus(message.StatusComplete).
+			Save(ctx)
+		return err
+	})
+	return m, err
+}
+
+// SweepTurns 将 before 之后没有更新的 pending 和 streaming 提问标记为 failed，返回标记的数量
+//
+// 服务重启或者保存回复失败时，提问会停留在 pending 或 streaming。
+func (s *SQLStore) SweepTurns(ctx context.Context, before time.Time) (int, error) {
+	return s.client.Message.Update().
+		Where(
+			message.StatusIn(message.StatusPending, message.StatusStreaming),
+			message.UpdatedAtLT(before),
+		).
+		SetStatus(message.StatusFailed).
+		Save(ctx)
+}
@@ -0,0 +1,307 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Conversation is the model entity for the Conversation schema.
+type Conversation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id"`
+	// UpstreamID holds the value of the "upstream_id" field.
+	// ChatGPT 中的会话 ID，第一次回复后才知道，为空的会话不能继续提问
+	UpstreamID *string `json:"upstream_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// CustomTitle holds the value of the "custom_title" field.
+	// 标题由用户修改过，不再自动生成
+	CustomTitle bool `json:"custom_title,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// SystemPrompt holds the value of the "system_prompt" field.
+	// 会话的系统提示，只保存在论坛中
+	SystemPrompt string `json:"system_prompt,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	// 与会话发布的主题的可见性保持一致，没有发布时为 private
+	Visibility conversation.Visibility `json:"visibility,omitempty"`
+	// Summary holds the value of the "summary" field.
+	// 自动生成的一段会话摘要，使用会话的语言
+	Summary string `json:"summary,omitempty"`
+	// MessageCount holds the value of the "message_count" field.
+	MessageCount int `json:"message_count,omitempty"`
+	// SummaryMessageCount holds the value of the "summary_message_count" field.
+	// 生成标题和摘要时会话的消息数量，消息数量增长后重新生成
+	SummaryMessageCount int `json:"summary_message_count,omitempty"`
+	// LastActivityAt holds the value of the "last_activity_at" field.
+	// 最后一次提问或回复的时间
+	LastActivityAt time.Time `json:"last_activity_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	// 软删除的时间，被删除的会话只出现在回收站中，超过保留期限后彻底删除
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	// 删除者的用户 ID，作者只能恢复自己删除的内容
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConversationQuery when eager-loading is set.
+	Edges   ConversationEdges `json:"edges"`
+	user_id *string
+}
+
+// ConversationEdges holds the relations/edges for other nodes in the graph.
+type ConversationEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Messages holds the value of the messages edge.
+	Messages []*Message `json:"messages,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConversationEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// MessagesOrErr returns the Messages value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConversationEdges) MessagesOrErr() ([]*Message, error) {
+	if e.loadedTypes[1] {
+		return e.Messages, nil
+	}
+	return nil, &NotLoadedError{edge: "messages"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Conversation) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case conversation.FieldCustomTitle:
+			values[i] = new(sql.NullBool)
+		case conversation.FieldMessageCount, conversation.FieldSummaryMessageCount:
+			values[i] = new(sql.NullInt64)
+		case conversation.FieldID, conversation.FieldUpstreamID, conversation.FieldTitle, conversation.FieldModel, conversation.FieldSystemPrompt, conversation.FieldVisibility, conversation.FieldSummary, conversation.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case conversation.FieldLastActivityAt, conversation.FieldCreatedAt, conversation.FieldUpdatedAt, conversation.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case conversation.ForeignKeys[0]: // user_id
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Conversation", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Conversation fields.
+func (c *Conversation) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case conversation.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				c.ID = value.String
+			}
+		case conversation.FieldUpstreamID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upstream_id", values[i])
+			} else if value.Valid {
+				c.UpstreamID = new(string)
+				*c.UpstreamID = value.String
+			}
+		case conversation.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				c.Title = value.String
+			}
+		case conversation.FieldCustomTitle:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field custom_title", values[i])
+			} else if value.Valid {
+				c.CustomTitle = value.Bool
+			}
+		case conversation.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				c.Model = value.String
+			}
+		case conversation.FieldSystemPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field system_prompt", values[i])
+			} else if value.Valid {
+				c.SystemPrompt = value.String
+			}
+		case conversation.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				c.Visibility = conversation.Visibility(value.String)
+			}
+		case conversation.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				c.Summary = value.String
+			}
+		case conversation.FieldMessageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_count", values[i])
+			} else if value.Valid {
+				c.MessageCount = int(value.Int64)
+			}
+		case conversation.FieldSummaryMessageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field summary_message_count", values[i])
+			} else if value.Valid {
+				c.SummaryMessageCount = int(value.Int64)
+			}
+		case conversation.FieldLastActivityAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_activity_at", values[i])
+			} else if value.Valid {
+				c.LastActivityAt = value.Time
+			}
+		case conversation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case conversation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case conversation.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				c.DeletedAt = new(time.Time)
+				*c.DeletedAt = value.Time
+			}
+		case conversation.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				c.DeletedBy = value.String
+			}
+		case conversation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				c.user_id = new(string)
+				*c.user_id = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the Conversation entity.
+func (c *Conversation) QueryUser() *UserQuery {
+	return (&ConversationClient{config: c.config}).QueryUser(c)
+}
+
+// QueryMessages queries the "messages" edge of the Conversation entity.
+func (c *Conversation) QueryMessages() *MessageQuery {
+	return (&ConversationClient{config: c.config}).QueryMessages(c)
+}
+
+// Update returns a builder for updating this Conversation.
+// Note that you need to call Conversation.Unwrap() before calling this method if this Conversation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Conversation) Update() *ConversationUpdateOne {
+	return (&ConversationClient{config: c.config}).UpdateOne(c)
+}
+
+// Unwrap unwraps the Conversation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Conversation) Unwrap() *Conversation {
+	tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Conversation is not a transactional entity")
+	}
+	c.config.driver = tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Conversation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Conversation(")
+	builder.WriteString(fmt.Sprintf("id=%v", c.ID))
+	if v := c.UpstreamID; v != nil {
+		builder.WriteString(", upstream_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", title=")
+	builder.WriteString(c.Title)
+	builder.WriteString(", custom_title=")
+	builder.WriteString(fmt.Sprintf("%v", c.CustomTitle))
+	builder.WriteString(", model=")
+	builder.WriteString(c.Model)
+	builder.WriteString(", system_prompt=")
+	builder.WriteString(c.SystemPrompt)
+	builder.WriteString(", visibility=")
+	builder.WriteString(fmt.Sprintf("%v", c.Visibility))
+	builder.WriteString(", summary=")
+	builder.WriteString(c.Summary)
+	builder.WriteString(", message_count=")
+	builder.WriteString(fmt.Sprintf("%v", c.MessageCount))
+	builder.WriteString(", summary_message_count=")
+	builder.WriteString(fmt.Sprintf("%v", c.SummaryMessageCount))
+	builder.WriteString(", last_activity_at=")
+	builder.WriteString(c.LastActivityAt.Format(time.ANSIC))
+	builder.WriteString(", created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	if v := c.DeletedAt; v != nil {
+		builder.WriteString(", deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", deleted_by=")
+	builder.WriteString(c.DeletedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Conversations is a parsable slice of Conversation.
+type Conversations []*Conversation
+
+func (c Conversations) config(cfg config) {
+	for _i := range c {
+		c[_i].config = cfg
+	}
+}
@@ -0,0 +1,1659 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationCreate is the builder for creating a Conversation entity.
+type ConversationCreate struct {
+	config
+	mutation *ConversationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (cc *ConversationCreate) SetUpstreamID(s string) *ConversationCreate {
+	cc.mutation.SetUpstreamID(s)
+	return cc
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableUpstreamID(s *string) *ConversationCreate {
+	if s != nil {
+		cc.SetUpstreamID(*s)
+	}
+	return cc
+}
+
+// SetTitle sets the "title" field.
+func (cc *ConversationCreate) SetTitle(s string) *ConversationCreate {
+	cc.mutation.SetTitle(s)
+	return cc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableTitle(s *string) *ConversationCreate {
+	if s != nil {
+		cc.SetTitle(*s)
+	}
+	return cc
+}
+
+// SetCustomTitle sets the "custom_title" field.
+func (cc *ConversationCreate) SetCustomTitle(b bool) *ConversationCreate {
+	cc.mutation.SetCustomTitle(b)
+	return cc
+}
+
+// SetNillableCustomTitle sets the "custom_title" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableCustomTitle(b *bool) *ConversationCreate {
+	if b != nil {
+		cc.SetCustomTitle(*b)
+	}
+	return cc
+}
+
+// SetModel sets the "model" field.
+func (cc *ConversationCreate) SetModel(s string) *ConversationCreate {
+	cc.mutation.SetModel(s)
+	return cc
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableModel(s *string) *ConversationCreate {
+	if s != nil {
+		cc.SetModel(*s)
+	}
+	return cc
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (cc *ConversationCreate) SetSystemPrompt(s string) *ConversationCreate {
+	cc.mutation.SetSystemPrompt(s)
+	return cc
+}
+
+// SetNillableSystemPrompt sets the "system_prompt" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableSystemPrompt(s *string) *ConversationCreate {
+	if s != nil {
+		cc.SetSystemPrompt(*s)
+	}
+	return cc
+}
+
+// SetVisibility sets the "visibility" field.
+func (cc *ConversationCreate) SetVisibility(c conversation.Visibility) *ConversationCreate {
+	cc.mutation.SetVisibility(c)
+	return cc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableVisibility(c *conversation.Visibility) *ConversationCreate {
+	if c != nil {
+		cc.SetVisibility(*c)
+	}
+	return cc
+}
+
+// SetSummary sets the "summary" field.
+func (cc *ConversationCreate) SetSummary(s string) *ConversationCreate {
+	cc.mutation.SetSummary(s)
+	return cc
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableSummary(s *string) *ConversationCreate {
+	if s != nil {
+		cc.SetSummary(*s)
+	}
+	return cc
+}
+
+// SetMessageCount sets the "message_count" field.
+func (cc *ConversationCreate) SetMessageCount(i int) *ConversationCreate {
+	cc.mutation.SetMessageCount(i)
+	return cc
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableMessageCount(i *int) *ConversationCreate {
+	if i != nil {
+		cc.SetMessageCount(*i)
+	}
+	return cc
+}
+
+// SetSummaryMessageCount sets the "summary_message_count" field.
+func (cc *ConversationCreate) SetSummaryMessageCount(i int) *ConversationCreate {
+	cc.mutation.SetSummaryMessageCount(i)
+	return cc
+}
+
+// SetNillableSummaryMessageCount sets the "summary_message_count" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableSummaryMessageCount(i *int) *ConversationCreate {
+	if i != nil {
+		cc.SetSummaryMessageCount(*i)
+	}
+	return cc
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (cc *ConversationCreate) SetLastActivityAt(t time.Time) *ConversationCreate {
+	cc.mutation.SetLastActivityAt(t)
+	return cc
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableLastActivityAt(t *time.Time) *ConversationCreate {
+	if t != nil {
+		cc.SetLastActivityAt(*t)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *ConversationCreate) SetCreatedAt(t time.Time) *ConversationCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableCreatedAt(t *time.Time) *ConversationCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *ConversationCreate) SetUpdatedAt(t time.Time) *ConversationCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableUpdatedAt(t *time.Time) *ConversationCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *ConversationCreate) SetDeletedAt(t time.Time) *ConversationCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableDeletedAt(t *time.Time) *ConversationCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (cc *ConversationCreate) SetDeletedBy(s string) *ConversationCreate {
+	cc.mutation.SetDeletedBy(s)
+	return cc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableDeletedBy(s *string) *ConversationCreate {
+	if s != nil {
+		cc.SetDeletedBy(*s)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *ConversationCreate) SetID(s string) *ConversationCreate {
+	cc.mutation.SetID(s)
+	return cc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (cc *ConversationCreate) SetUserID(id string) *ConversationCreate {
+	cc.mutation.SetUserID(id)
+	return cc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cc *ConversationCreate) SetUser(u *User) *ConversationCreate {
+	return cc.SetUserID(u.ID)
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (cc *ConversationCreate) AddMessageIDs(ids ...string) *ConversationCreate {
+	cc.mutation.AddMessageIDs(ids...)
+	return cc
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (cc *ConversationCreate) AddMessages(m ...*Message) *ConversationCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cc.AddMessageIDs(ids...)
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (cc *ConversationCreate) Mutation() *ConversationMutation {
+	return cc.mutation
+}
+
+// Save creates the Conversation in the database.
+func (cc *ConversationCreate) Save(ctx context.Context) (*Conversation, error) {
+	var (
+		err  error
+		node *Conversation
+	)
+	cc.defaults()
+	if len(cc.hooks) == 0 {
+		if err = cc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ConversationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cc.check(); err != nil {
+				return nil, err
+			}
+			cc.mutation = mutation
+			if node, err = cc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cc.hooks) - 1; i >= 0; i-- {
+			if cc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *ConversationCreate) SaveX(ctx context.Context) *Conversation {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *ConversationCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *ConversationCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *ConversationCreate) defaults() {
+	if _, ok := cc.mutation.Title(); !ok {
+		v := conversation.DefaultTitle
+		cc.mutation.SetTitle(v)
+	}
+	if _, ok := cc.mutation.CustomTitle(); !ok {
+		v := conversation.DefaultCustomTitle
+		cc.mutation.SetCustomTitle(v)
+	}
+	if _, ok := cc.mutation.Visibility(); !ok {
+		v := conversation.DefaultVisibility
+		cc.mutation.SetVisibility(v)
+	}
+	if _, ok := cc.mutation.MessageCount(); !ok {
+		v := conversation.DefaultMessageCount
+		cc.mutation.SetMessageCount(v)
+	}
+	if _, ok := cc.mutation.SummaryMessageCount(); !ok {
+		v := conversation.DefaultSummaryMessageCount
+		cc.mutation.SetSummaryMessageCount(v)
+	}
+	if _, ok := cc.mutation.LastActivityAt(); !ok {
+		v := conversation.DefaultLastActivityAt()
+		cc.mutation.SetLastActivityAt(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := conversation.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := conversation.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *ConversationCreate) check() error {
+	if _, ok := cc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Conversation.title"`)}
+	}
+	if v, ok := cc.mutation.Title(); ok {
+		if err := conversation.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Conversation.title": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.CustomTitle(); !ok {
+		return &ValidationError{Name: "custom_title", err: errors.New(`ent: missing required field "Conversation.custom_title"`)}
+	}
+	if _, ok := cc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Conversation.visibility"`)}
+	}
+	if v, ok := cc.mutation.Visibility(); ok {
+		if err := conversation.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Conversation.visibility": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.MessageCount(); !ok {
+		return &ValidationError{Name: "message_count", err: errors.New(`ent: missing required field "Conversation.message_count"`)}
+	}
+	if v, ok := cc.mutation.MessageCount(); ok {
+		if err := conversation.MessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "message_count", err: fmt.Errorf(`ent: validator failed for field "Conversation.message_count": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.SummaryMessageCount(); !ok {
+		return &ValidationError{Name: "summary_message_count", err: errors.New(`ent: missing required field "Conversation.summary_message_count"`)}
+	}
+	if v, ok := cc.mutation.SummaryMessageCount(); ok {
+		if err := conversation.SummaryMessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "summary_message_count", err: fmt.Errorf(`ent: validator failed for field "Conversation.summary_message_count": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.LastActivityAt(); !ok {
+		return &ValidationError{Name: "last_activity_at", err: errors.New(`ent: missing required field "Conversation.last_activity_at"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Conversation.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Conversation.updated_at"`)}
+	}
+	if v, ok := cc.mutation.ID(); ok {
+		if err := conversation.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Conversation.id": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Conversation.user"`)}
+	}
+	return nil
+}
+
+func (cc *ConversationCreate) sqlSave(ctx context.Context) (*Conversation, error) {
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Conversation.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (cc *ConversationCreate) createSpec() (*Conversation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Conversation{config: cc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: conversation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: conversation.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = cc.conflict
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cc.mutation.UpstreamID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldUpstreamID,
+		})
+		_node.UpstreamID = &value
+	}
+	if value, ok := cc.mutation.Title(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldTitle,
+		})
+		_node.Title = value
+	}
+	if value, ok := cc.mutation.CustomTitle(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: conversation.FieldCustomTitle,
+		})
+		_node.CustomTitle = value
+	}
+	if value, ok := cc.mutation.Model(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldModel,
+		})
+		_node.Model = value
+	}
+	if value, ok := cc.mutation.SystemPrompt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldSystemPrompt,
+		})
+		_node.SystemPrompt = value
+	}
+	if value, ok := cc.mutation.Visibility(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: conversation.FieldVisibility,
+		})
+		_node.Visibility = value
+	}
+	if value, ok := cc.mutation.Summary(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldSummary,
+		})
+		_node.Summary = value
+	}
+	if value, ok := cc.mutation.MessageCount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: conversation.FieldMessageCount,
+		})
+		_node.MessageCount = value
+	}
+	if value, ok := cc.mutation.SummaryMessageCount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: conversation.FieldSummaryMessageCount,
+		})
+		_node.SummaryMessageCount = value
+	}
+	if value, ok := cc.mutation.LastActivityAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: conversation.FieldLastActivityAt,
+		})
+		_node.LastActivityAt = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: conversation.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: conversation.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: conversation.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
+	if value, ok := cc.mutation.DeletedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldDeletedBy,
+		})
+		_node.DeletedBy = value
+	}
+	if nodes := cc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversation.UserTable,
+			Columns: []string{conversation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: message.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Conversation.Create().
+//		SetUpstreamID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationUpsert) {
+//			SetUpstreamID(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *ConversationCreate) OnConflict(opts ...sql.ConflictOption) *ConversationUpsertOne {
+	cc.conflict = opts
+	return &ConversationUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *ConversationCreate) OnConflictColumns(columns ...string) *ConversationUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &ConversationUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// ConversationUpsertOne is the builder for "upsert"-ing
+	//  one Conversation node.
+	ConversationUpsertOne struct {
+		create *ConversationCreate
+	}
+
+	// ConversationUpsert is the "OnConflict" setter.
+	ConversationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpstreamID sets the "upstream_id" field.
+func (u *ConversationUpsert) SetUpstreamID(v string) *ConversationUpsert {
+	u.Set(conversation.FieldUpstreamID, v)
+	return u
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateUpstreamID() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldUpstreamID)
+	return u
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *ConversationUpsert) ClearUpstreamID() *ConversationUpsert {
+	u.SetNull(conversation.FieldUpstreamID)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ConversationUpsert) SetTitle(v string) *ConversationUpsert {
+	u.Set(conversation.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateTitle() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldTitle)
+	return u
+}
+
+// SetCustomTitle sets the "custom_title" field.
+func (u *ConversationUpsert) SetCustomTitle(v bool) *ConversationUpsert {
+	u.Set(conversation.FieldCustomTitle, v)
+	return u
+}
+
+// UpdateCustomTitle sets the "custom_title" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateCustomTitle() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldCustomTitle)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *ConversationUpsert) SetModel(v string) *ConversationUpsert {
+	u.Set(conversation.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateModel() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldModel)
+	return u
+}
+
+// ClearModel clears the value of the "model" field.
+func (u *ConversationUpsert) ClearModel() *ConversationUpsert {
+	u.SetNull(conversation.FieldModel)
+	return u
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (u *ConversationUpsert) SetSystemPrompt(v string) *ConversationUpsert {
+	u.Set(conversation.FieldSystemPrompt, v)
+	return u
+}
+
+// UpdateSystemPrompt sets the "system_prompt" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateSystemPrompt() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldSystemPrompt)
+	return u
+}
+
+// ClearSystemPrompt clears the value of the "system_prompt" field.
+func (u *ConversationUpsert) ClearSystemPrompt() *ConversationUpsert {
+	u.SetNull(conversation.FieldSystemPrompt)
+	return u
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *ConversationUpsert) SetVisibility(v conversation.Visibility) *ConversationUpsert {
+	u.Set(conversation.FieldVisibility, v)
+	return u
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateVisibility() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldVisibility)
+	return u
+}
+
+// SetSummary sets the "summary" field.
+func (u *ConversationUpsert) SetSummary(v string) *ConversationUpsert {
+	u.Set(conversation.FieldSummary, v)
+	return u
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateSummary() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldSummary)
+	return u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *ConversationUpsert) ClearSummary() *ConversationUpsert {
+	u.SetNull(conversation.FieldSummary)
+	return u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *ConversationUpsert) SetMessageCount(v int) *ConversationUpsert {
+	u.Set(conversation.FieldMessageCount, v)
+	return u
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateMessageCount() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldMessageCount)
+	return u
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *ConversationUpsert) AddMessageCount(v int) *ConversationUpsert {
+	u.Add(conversation.FieldMessageCount, v)
+	return u
+}
+
+// SetSummaryMessageCount sets the "summary_message_count" field.
+func (u *ConversationUpsert) SetSummaryMessageCount(v int) *ConversationUpsert {
+	u.Set(conversation.FieldSummaryMessageCount, v)
+	return u
+}
+
+// UpdateSummaryMessageCount sets the "summary_message_count" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateSummaryMessageCount() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldSummaryMessageCount)
+	return u
+}
+
+// AddSummaryMessageCount adds v to the "summary_message_count" field.
+func (u *ConversationUpsert) AddSummaryMessageCount(v int) *ConversationUpsert {
+	u.Add(conversation.FieldSummaryMessageCount, v)
+	return u
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (u *ConversationUpsert) SetLastActivityAt(v time.Time) *ConversationUpsert {
+	u.Set(conversation.FieldLastActivityAt, v)
+	return u
+}
+
+// UpdateLastActivityAt sets the "last_activity_at" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateLastActivityAt() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldLastActivityAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ConversationUpsert) SetCreatedAt(v time.Time) *ConversationUpsert {
+	u.Set(conversation.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateCreatedAt() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationUpsert) SetUpdatedAt(v time.Time) *ConversationUpsert {
+	u.Set(conversation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateUpdatedAt() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ConversationUpsert) SetDeletedAt(v time.Time) *ConversationUpsert {
+	u.Set(conversation.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateDeletedAt() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ConversationUpsert) ClearDeletedAt() *ConversationUpsert {
+	u.SetNull(conversation.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ConversationUpsert) SetDeletedBy(v string) *ConversationUpsert {
+	u.Set(conversation.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateDeletedBy() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ConversationUpsert) ClearDeletedBy() *ConversationUpsert {
+	u.SetNull(conversation.FieldDeletedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationUpsertOne) UpdateNewValues() *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(conversation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(conversation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ConversationUpsertOne) Ignore() *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationUpsertOne) DoNothing() *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationCreate.OnConflict
+// documentation for more info.
+func (u *ConversationUpsertOne) Update(set func(*ConversationUpsert)) *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (u *ConversationUpsertOne) SetUpstreamID(v string) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetUpstreamID(v)
+	})
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateUpstreamID() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateUpstreamID()
+	})
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *ConversationUpsertOne) ClearUpstreamID() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearUpstreamID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ConversationUpsertOne) SetTitle(v string) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateTitle() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetCustomTitle sets the "custom_title" field.
+func (u *ConversationUpsertOne) SetCustomTitle(v bool) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetCustomTitle(v)
+	})
+}
+
+// UpdateCustomTitle sets the "custom_title" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateCustomTitle() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateCustomTitle()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *ConversationUpsertOne) SetModel(v string) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateModel() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// ClearModel clears the value of the "model" field.
+func (u *ConversationUpsertOne) ClearModel() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearModel()
+	})
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (u *ConversationUpsertOne) SetSystemPrompt(v string) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetSystemPrompt(v)
+	})
+}
+
+// UpdateSystemPrompt sets the "system_prompt" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateSystemPrompt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateSystemPrompt()
+	})
+}
+
+// ClearSystemPrompt clears the value of the "system_prompt" field.
+func (u *ConversationUpsertOne) ClearSystemPrompt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearSystemPrompt()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *ConversationUpsertOne) SetVisibility(v conversation.Visibility) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateVisibility() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *ConversationUpsertOne) SetSummary(v string) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateSummary() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *ConversationUpsertOne) ClearSummary() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearSummary()
+	})
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *ConversationUpsertOne) SetMessageCount(v int) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetMessageCount(v)
+	})
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *ConversationUpsertOne) AddMessageCount(v int) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddMessageCount(v)
+	})
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateMessageCount() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateMessageCount()
+	})
+}
+
+// SetSummaryMessageCount sets the "summary_message_count" field.
+func (u *ConversationUpsertOne) SetSummaryMessageCount(v int) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetSummaryMessageCount(v)
+	})
+}
+
+// AddSummaryMessageCount adds v to the "summary_message_count" field.
+func (u *ConversationUpsertOne) AddSummaryMessageCount(v int) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddSummaryMessageCount(v)
+	})
+}
+
+// UpdateSummaryMessageCount sets the "summary_message_count" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateSummaryMessageCount() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateSummaryMessageCount()
+	})
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (u *ConversationUpsertOne) SetLastActivityAt(v time.Time) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetLastActivityAt(v)
+	})
+}
+
+// UpdateLastActivityAt sets the "last_activity_at" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateLastActivityAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateLastActivityAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ConversationUpsertOne) SetCreatedAt(v time.Time) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateCreatedAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationUpsertOne) SetUpdatedAt(v time.Time) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateUpdatedAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ConversationUpsertOne) SetDeletedAt(v time.Time) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateDeletedAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ConversationUpsertOne) ClearDeletedAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ConversationUpsertOne) SetDeletedBy(v string) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateDeletedBy() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ConversationUpsertOne) ClearDeletedBy() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConversationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ConversationUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ConversationUpsertOne.ID is not supported by MySQL driver. Use ConversationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ConversationUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ConversationCreateBulk is the builder for creating many Conversation entities in bulk.
+type ConversationCreateBulk struct {
+	config
+	builders []*ConversationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Conversation entities in the database.
+func (ccb *ConversationCreateBulk) Save(ctx context.Context) ([]*Conversation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Conversation, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *ConversationCreateBulk) SaveX(ctx context.Context) []*Conversation {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *ConversationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *ConversationCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Conversation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationUpsert) {
+//			SetUpstreamID(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *ConversationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ConversationUpsertBulk {
+	ccb.conflict = opts
+	return &ConversationUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *ConversationCreateBulk) OnConflictColumns(columns ...string) *ConversationUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &ConversationUpsertBulk{
+		create: ccb,
+	}
+}
+
+// ConversationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Conversation nodes.
+type ConversationUpsertBulk struct {
+	create *ConversationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationUpsertBulk) UpdateNewValues() *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(conversation.FieldID)
+				return
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(conversation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ConversationUpsertBulk) Ignore() *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationUpsertBulk) DoNothing() *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ConversationUpsertBulk) Update(set func(*ConversationUpsert)) *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (u *ConversationUpsertBulk) SetUpstreamID(v string) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetUpstreamID(v)
+	})
+}
+
+// UpdateUpstreamID sets the "upstream_id" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateUpstreamID() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateUpstreamID()
+	})
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (u *ConversationUpsertBulk) ClearUpstreamID() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearUpstreamID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ConversationUpsertBulk) SetTitle(v string) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateTitle() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetCustomTitle sets the "custom_title" field.
+func (u *ConversationUpsertBulk) SetCustomTitle(v bool) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetCustomTitle(v)
+	})
+}
+
+// UpdateCustomTitle sets the "custom_title" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateCustomTitle() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateCustomTitle()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *ConversationUpsertBulk) SetModel(v string) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateModel() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// ClearModel clears the value of the "model" field.
+func (u *ConversationUpsertBulk) ClearModel() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearModel()
+	})
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (u *ConversationUpsertBulk) SetSystemPrompt(v string) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetSystemPrompt(v)
+	})
+}
+
+// UpdateSystemPrompt sets the "system_prompt" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateSystemPrompt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateSystemPrompt()
+	})
+}
+
+// ClearSystemPrompt clears the value of the "system_prompt" field.
+func (u *ConversationUpsertBulk) ClearSystemPrompt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearSystemPrompt()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *ConversationUpsertBulk) SetVisibility(v conversation.Visibility) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateVisibility() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *ConversationUpsertBulk) SetSummary(v string) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateSummary() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *ConversationUpsertBulk) ClearSummary() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearSummary()
+	})
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *ConversationUpsertBulk) SetMessageCount(v int) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetMessageCount(v)
+	})
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *ConversationUpsertBulk) AddMessageCount(v int) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddMessageCount(v)
+	})
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateMessageCount() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateMessageCount()
+	})
+}
+
+// SetSummaryMessageCount sets the "summary_message_count" field.
+func (u *ConversationUpsertBulk) SetSummaryMessageCount(v int) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetSummaryMessageCount(v)
+	})
+}
+
+// AddSummaryMessageCount adds v to the "summary_message_count" field.
+func (u *ConversationUpsertBulk) AddSummaryMessageCount(v int) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddSummaryMessageCount(v)
+	})
+}
+
+// UpdateSummaryMessageCount sets the "summary_message_count" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateSummaryMessageCount() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateSummaryMessageCount()
+	})
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (u *ConversationUpsertBulk) SetLastActivityAt(v time.Time) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetLastActivityAt(v)
+	})
+}
+
+// UpdateLastActivityAt sets the "last_activity_at" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateLastActivityAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateLastActivityAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ConversationUpsertBulk) SetCreatedAt(v time.Time) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateCreatedAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationUpsertBulk) SetUpdatedAt(v time.Time) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateUpdatedAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ConversationUpsertBulk) SetDeletedAt(v time.Time) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateDeletedAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ConversationUpsertBulk) ClearDeletedAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ConversationUpsertBulk) SetDeletedBy(v string) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateDeletedBy() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ConversationUpsertBulk) ClearDeletedBy() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ConversationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConversationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
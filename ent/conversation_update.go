@@ -0,0 +1,1342 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/predicate"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationUpdate is the builder for updating Conversation entities.
+type ConversationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConversationMutation
+}
+
+// Where appends a list predicates to the ConversationUpdate builder.
+func (cu *ConversationUpdate) Where(ps ...predicate.Conversation) *ConversationUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (cu *ConversationUpdate) SetUpstreamID(s string) *ConversationUpdate {
+	cu.mutation.SetUpstreamID(s)
+	return cu
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableUpstreamID(s *string) *ConversationUpdate {
+	if s != nil {
+		cu.SetUpstreamID(*s)
+	}
+	return cu
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (cu *ConversationUpdate) ClearUpstreamID() *ConversationUpdate {
+	cu.mutation.ClearUpstreamID()
+	return cu
+}
+
+// SetTitle sets the "title" field.
+func (cu *ConversationUpdate) SetTitle(s string) *ConversationUpdate {
+	cu.mutation.SetTitle(s)
+	return cu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableTitle(s *string) *ConversationUpdate {
+	if s != nil {
+		cu.SetTitle(*s)
+	}
+	return cu
+}
+
+// SetCustomTitle sets the "custom_title" field.
+func (cu *ConversationUpdate) SetCustomTitle(b bool) *ConversationUpdate {
+	cu.mutation.SetCustomTitle(b)
+	return cu
+}
+
+// SetNillableCustomTitle sets the "custom_title" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableCustomTitle(b *bool) *ConversationUpdate {
+	if b != nil {
+		cu.SetCustomTitle(*b)
+	}
+	return cu
+}
+
+// SetModel sets the "model" field.
+func (cu *ConversationUpdate) SetModel(s string) *ConversationUpdate {
+	cu.mutation.SetModel(s)
+	return cu
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableModel(s *string) *ConversationUpdate {
+	if s != nil {
+		cu.SetModel(*s)
+	}
+	return cu
+}
+
+// ClearModel clears the value of the "model" field.
+func (cu *ConversationUpdate) ClearModel() *ConversationUpdate {
+	cu.mutation.ClearModel()
+	return cu
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (cu *ConversationUpdate) SetSystemPrompt(s string) *ConversationUpdate {
+	cu.mutation.SetSystemPrompt(s)
+	return cu
+}
+
+// SetNillableSystemPrompt sets the "system_prompt" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableSystemPrompt(s *string) *ConversationUpdate {
+	if s != nil {
+		cu.SetSystemPrompt(*s)
+	}
+	return cu
+}
+
+// ClearSystemPrompt clears the value of the "system_prompt" field.
+func (cu *ConversationUpdate) ClearSystemPrompt() *ConversationUpdate {
+	cu.mutation.ClearSystemPrompt()
+	return cu
+}
+
+// SetVisibility sets the "visibility" field.
+func (cu *ConversationUpdate) SetVisibility(c conversation.Visibility) *ConversationUpdate {
+	cu.mutation.SetVisibility(c)
+	return cu
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableVisibility(c *conversation.Visibility) *ConversationUpdate {
+	if c != nil {
+		cu.SetVisibility(*c)
+	}
+	return cu
+}
+
+// SetSummary sets the "summary" field.
+func (cu *ConversationUpdate) SetSummary(s string) *ConversationUpdate {
+	cu.mutation.SetSummary(s)
+	return cu
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableSummary(s *string) *ConversationUpdate {
+	if s != nil {
+		cu.SetSummary(*s)
+	}
+	return cu
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (cu *ConversationUpdate) ClearSummary() *ConversationUpdate {
+	cu.mutation.ClearSummary()
+	return cu
+}
+
+// SetMessageCount sets the "message_count" field.
+func (cu *ConversationUpdate) SetMessageCount(i int) *ConversationUpdate {
+	cu.mutation.ResetMessageCount()
+	cu.mutation.SetMessageCount(i)
+	return cu
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableMessageCount(i *int) *ConversationUpdate {
+	if i != nil {
+		cu.SetMessageCount(*i)
+	}
+	return cu
+}
+
+// AddMessageCount adds i to the "message_count" field.
+func (cu *ConversationUpdate) AddMessageCount(i int) *ConversationUpdate {
+	cu.mutation.AddMessageCount(i)
+	return cu
+}
+
+// SetSummaryMessageCount sets the "summary_message_count" field.
+func (cu *ConversationUpdate) SetSummaryMessageCount(i int) *ConversationUpdate {
+	cu.mutation.ResetSummaryMessageCount()
+	cu.mutation.SetSummaryMessageCount(i)
+	return cu
+}
+
+// SetNillableSummaryMessageCount sets the "summary_message_count" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableSummaryMessageCount(i *int) *ConversationUpdate {
+	if i != nil {
+		cu.SetSummaryMessageCount(*i)
+	}
+	return cu
+}
+
+// AddSummaryMessageCount adds i to the "summary_message_count" field.
+func (cu *ConversationUpdate) AddSummaryMessageCount(i int) *ConversationUpdate {
+	cu.mutation.AddSummaryMessageCount(i)
+	return cu
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (cu *ConversationUpdate) SetLastActivityAt(t time.Time) *ConversationUpdate {
+	cu.mutation.SetLastActivityAt(t)
+	return cu
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableLastActivityAt(t *time.Time) *ConversationUpdate {
+	if t != nil {
+		cu.SetLastActivityAt(*t)
+	}
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *ConversationUpdate) SetUpdatedAt(t time.Time) *ConversationUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *ConversationUpdate) SetDeletedAt(t time.Time) *ConversationUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableDeletedAt(t *time.Time) *ConversationUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *ConversationUpdate) ClearDeletedAt() *ConversationUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (cu *ConversationUpdate) SetDeletedBy(s string) *ConversationUpdate {
+	cu.mutation.SetDeletedBy(s)
+	return cu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableDeletedBy(s *string) *ConversationUpdate {
+	if s != nil {
+		cu.SetDeletedBy(*s)
+	}
+	return cu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (cu *ConversationUpdate) ClearDeletedBy() *ConversationUpdate {
+	cu.mutation.ClearDeletedBy()
+	return cu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (cu *ConversationUpdate) SetUserID(id string) *ConversationUpdate {
+	cu.mutation.SetUserID(id)
+	return cu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cu *ConversationUpdate) SetUser(u *User) *ConversationUpdate {
+	return cu.SetUserID(u.ID)
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (cu *ConversationUpdate) AddMessageIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.AddMessageIDs(ids...)
+	return cu
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (cu *ConversationUpdate) AddMessages(m ...*Message) *ConversationUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cu.AddMessageIDs(ids...)
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (cu *ConversationUpdate) Mutation() *ConversationMutation {
+	return cu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cu *ConversationUpdate) ClearUser() *ConversationUpdate {
+	cu.mutation.ClearUser()
+	return cu
+}
+
+// ClearMessages clears all "messages" edges to the Message entity.
+func (cu *ConversationUpdate) ClearMessages() *ConversationUpdate {
+	cu.mutation.ClearMessages()
+	return cu
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (cu *ConversationUpdate) RemoveMessageIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.RemoveMessageIDs(ids...)
+	return cu
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (cu *ConversationUpdate) RemoveMessages(m ...*Message) *ConversationUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cu.RemoveMessageIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *ConversationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	cu.defaults()
+	if len(cu.hooks) == 0 {
+		if err = cu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ConversationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cu.check(); err != nil {
+				return 0, err
+			}
+			cu.mutation = mutation
+			affected, err = cu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cu.hooks) - 1; i >= 0; i-- {
+			if cu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *ConversationUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *ConversationUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *ConversationUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *ConversationUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := conversation.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *ConversationUpdate) check() error {
+	if v, ok := cu.mutation.Title(); ok {
+		if err := conversation.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Conversation.title": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Visibility(); ok {
+		if err := conversation.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Conversation.visibility": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.MessageCount(); ok {
+		if err := conversation.MessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "message_count", err: fmt.Errorf(`ent: validator failed for field "Conversation.message_count": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.SummaryMessageCount(); ok {
+		if err := conversation.SummaryMessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "summary_message_count", err: fmt.Errorf(`ent: validator failed for field "Conversation.summary_message_count": %w`, err)}
+		}
+	}
+	if _, ok := cu.mutation.UserID(); cu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Conversation.user"`)
+	}
+	return nil
+}
+
+func (cu *ConversationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   conversation.Table,
+			Columns: conversation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: conversation.FieldID,
+			},
+		},
+	}
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UpstreamID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldUpstreamID,
+		})
+	}
+	if cu.mutation.UpstreamIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: conversation.FieldUpstreamID,
+		})
+	}
+	if value, ok := cu.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldTitle,
+		})
+	}
+	if value, ok := cu.mutation.CustomTitle(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: conversation.FieldCustomTitle,
+		})
+	}
+	if value, ok := cu.mutation.Model(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldModel,
+		})
+	}
+	if cu.mutation.ModelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: conversation.FieldModel,
+		})
+	}
+	if value, ok := cu.mutation.SystemPrompt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldSystemPrompt,
+		})
+	}
+	if cu.mutation.SystemPromptCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: conversation.FieldSystemPrompt,
+		})
+	}
+	if value, ok := cu.mutation.Visibility(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: conversation.FieldVisibility,
+		})
+	}
+	if value, ok := cu.mutation.Summary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldSummary,
+		})
+	}
+	if cu.mutation.SummaryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: conversation.FieldSummary,
+		})
+	}
+	if value, ok := cu.mutation.MessageCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: conversation.FieldMessageCount,
+		})
+	}
+	if value, ok := cu.mutation.AddedMessageCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: conversation.FieldMessageCount,
+		})
+	}
+	if value, ok := cu.mutation.SummaryMessageCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: conversation.FieldSummaryMessageCount,
+		})
+	}
+	if value, ok := cu.mutation.AddedSummaryMessageCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: conversation.FieldSummaryMessageCount,
+		})
+	}
+	if value, ok := cu.mutation.LastActivityAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: conversation.FieldLastActivityAt,
+		})
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: conversation.FieldUpdatedAt,
+		})
+	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: conversation.FieldDeletedAt,
+		})
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: conversation.FieldDeletedAt,
+		})
+	}
+	if value, ok := cu.mutation.DeletedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldDeletedBy,
+		})
+	}
+	if cu.mutation.DeletedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: conversation.FieldDeletedBy,
+		})
+	}
+	if cu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversation.UserTable,
+			Columns: []string{conversation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversation.UserTable,
+			Columns: []string{conversation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: message.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !cu.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: message.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: message.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ConversationUpdateOne is the builder for updating a single Conversation entity.
+type ConversationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConversationMutation
+}
+
+// SetUpstreamID sets the "upstream_id" field.
+func (cuo *ConversationUpdateOne) SetUpstreamID(s string) *ConversationUpdateOne {
+	cuo.mutation.SetUpstreamID(s)
+	return cuo
+}
+
+// SetNillableUpstreamID sets the "upstream_id" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableUpstreamID(s *string) *ConversationUpdateOne {
+	if s != nil {
+		cuo.SetUpstreamID(*s)
+	}
+	return cuo
+}
+
+// ClearUpstreamID clears the value of the "upstream_id" field.
+func (cuo *ConversationUpdateOne) ClearUpstreamID() *ConversationUpdateOne {
+	cuo.mutation.ClearUpstreamID()
+	return cuo
+}
+
+// SetTitle sets the "title" field.
+func (cuo *ConversationUpdateOne) SetTitle(s string) *ConversationUpdateOne {
+	cuo.mutation.SetTitle(s)
+	return cuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableTitle(s *string) *ConversationUpdateOne {
+	if s != nil {
+		cuo.SetTitle(*s)
+	}
+	return cuo
+}
+
+// SetCustomTitle sets the "custom_title" field.
+func (cuo *ConversationUpdateOne) SetCustomTitle(b bool) *ConversationUpdateOne {
+	cuo.mutation.SetCustomTitle(b)
+	return cuo
+}
+
+// SetNillableCustomTitle sets the "custom_title" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableCustomTitle(b *bool) *ConversationUpdateOne {
+	if b != nil {
+		cuo.SetCustomTitle(*b)
+	}
+	return cuo
+}
+
+// SetModel sets the "model" field.
+func (cuo *ConversationUpdateOne) SetModel(s string) *ConversationUpdateOne {
+	cuo.mutation.SetModel(s)
+	return cuo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableModel(s *string) *ConversationUpdateOne {
+	if s != nil {
+		cuo.SetModel(*s)
+	}
+	return cuo
+}
+
+// ClearModel clears the value of the "model" field.
+func (cuo *ConversationUpdateOne) ClearModel() *ConversationUpdateOne {
+	cuo.mutation.ClearModel()
+	return cuo
+}
+
+// SetSystemPrompt sets the "system_prompt" field.
+func (cuo *ConversationUpdateOne) SetSystemPrompt(s string) *ConversationUpdateOne {
+	cuo.mutation.SetSystemPrompt(s)
+	return cuo
+}
+
+// SetNillableSystemPrompt sets the "system_prompt" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableSystemPrompt(s *string) *ConversationUpdateOne {
+	if s != nil {
+		cuo.SetSystemPrompt(*s)
+	}
+	return cuo
+}
+
+// ClearSystemPrompt clears the value of the "system_prompt" field.
+func (cuo *ConversationUpdateOne) ClearSystemPrompt() *ConversationUpdateOne {
+	cuo.mutation.ClearSystemPrompt()
+	return cuo
+}
+
+// SetVisibility sets the "visibility" field.
+func (cuo *ConversationUpdateOne) SetVisibility(c conversation.Visibility) *ConversationUpdateOne {
+	cuo.mutation.SetVisibility(c)
+	return cuo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableVisibility(c *conversation.Visibility) *ConversationUpdateOne {
+	if c != nil {
+		cuo.SetVisibility(*c)
+	}
+	return cuo
+}
+
+// SetSummary sets the "summary" field.
+func (cuo *ConversationUpdateOne) SetSummary(s string) *ConversationUpdateOne {
+	cuo.mutation.SetSummary(s)
+	return cuo
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableSummary(s *string) *ConversationUpdateOne {
+	if s != nil {
+		cuo.SetSummary(*s)
+	}
+	return cuo
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (cuo *ConversationUpdateOne) ClearSummary() *ConversationUpdateOne {
+	cuo.mutation.ClearSummary()
+	return cuo
+}
+
+// SetMessageCount sets the "message_count" field.
+func (cuo *ConversationUpdateOne) SetMessageCount(i int) *ConversationUpdateOne {
+	cuo.mutation.ResetMessageCount()
+	cuo.mutation.SetMessageCount(i)
+	return cuo
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableMessageCount(i *int) *ConversationUpdateOne {
+	if i != nil {
+		cuo.SetMessageCount(*i)
+	}
+	return cuo
+}
+
+// AddMessageCount adds i to the "message_count" field.
+func (cuo *ConversationUpdateOne) AddMessageCount(i int) *ConversationUpdateOne {
+	cuo.mutation.AddMessageCount(i)
+	return cuo
+}
+
+// SetSummaryMessageCount sets the "summary_message_count" field.
+func (cuo *ConversationUpdateOne) SetSummaryMessageCount(i int) *ConversationUpdateOne {
+	cuo.mutation.ResetSummaryMessageCount()
+	cuo.mutation.SetSummaryMessageCount(i)
+	return cuo
+}
+
+// SetNillableSummaryMessageCount sets the "summary_message_count" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableSummaryMessageCount(i *int) *ConversationUpdateOne {
+	if i != nil {
+		cuo.SetSummaryMessageCount(*i)
+	}
+	return cuo
+}
+
+// AddSummaryMessageCount adds i to the "summary_message_count" field.
+func (cuo *ConversationUpdateOne) AddSummaryMessageCount(i int) *ConversationUpdateOne {
+	cuo.mutation.AddSummaryMessageCount(i)
+	return cuo
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (cuo *ConversationUpdateOne) SetLastActivityAt(t time.Time) *ConversationUpdateOne {
+	cuo.mutation.SetLastActivityAt(t)
+	return cuo
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableLastActivityAt(t *time.Time) *ConversationUpdateOne {
+	if t != nil {
+		cuo.SetLastActivityAt(*t)
+	}
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *ConversationUpdateOne) SetUpdatedAt(t time.Time) *ConversationUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *ConversationUpdateOne) SetDeletedAt(t time.Time) *ConversationUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableDeletedAt(t *time.Time) *ConversationUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *ConversationUpdateOne) ClearDeletedAt() *ConversationUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (cuo *ConversationUpdateOne) SetDeletedBy(s string) *ConversationUpdateOne {
+	cuo.mutation.SetDeletedBy(s)
+	return cuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableDeletedBy(s *string) *ConversationUpdateOne {
+	if s != nil {
+		cuo.SetDeletedBy(*s)
+	}
+	return cuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (cuo *ConversationUpdateOne) ClearDeletedBy() *ConversationUpdateOne {
+	cuo.mutation.ClearDeletedBy()
+	return cuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (cuo *ConversationUpdateOne) SetUserID(id string) *ConversationUpdateOne {
+	cuo.mutation.SetUserID(id)
+	return cuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cuo *ConversationUpdateOne) SetUser(u *User) *ConversationUpdateOne {
+	return cuo.SetUserID(u.ID)
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (cuo *ConversationUpdateOne) AddMessageIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.AddMessageIDs(ids...)
+	return cuo
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (cuo *ConversationUpdateOne) AddMessages(m ...*Message) *ConversationUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cuo.AddMessageIDs(ids...)
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (cuo *ConversationUpdateOne) Mutation() *ConversationMutation {
+	return cuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cuo *ConversationUpdateOne) ClearUser() *ConversationUpdateOne {
+	cuo.mutation.ClearUser()
+	return cuo
+}
+
+// ClearMessages clears all "messages" edges to the Message entity.
+func (cuo *ConversationUpdateOne) ClearMessages() *ConversationUpdateOne {
+	cuo.mutation.ClearMessages()
+	return cuo
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (cuo *ConversationUpdateOne) RemoveMessageIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.RemoveMessageIDs(ids...)
+	return cuo
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (cuo *ConversationUpdateOne) RemoveMessages(m ...*Message) *ConversationUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cuo.RemoveMessageIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *ConversationUpdateOne) Select(field string, fields ...string) *ConversationUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Conversation entity.
+func (cuo *ConversationUpdateOne) Save(ctx context.Context) (*Conversation, error) {
+	var (
+		err  error
+		node *Conversation
+	)
+	cuo.defaults()
+	if len(cuo.hooks) == 0 {
+		if err = cuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ConversationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cuo.check(); err != nil {
+				return nil, err
+			}
+			cuo.mutation = mutation
+			node, err = cuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cuo.hooks) - 1; i >= 0; i-- {
+			if cuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *ConversationUpdateOne) SaveX(ctx context.Context) *Conversation {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *ConversationUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *ConversationUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *ConversationUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := conversation.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *ConversationUpdateOne) check() error {
+	if v, ok := cuo.mutation.Title(); ok {
+		if err := conversation.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Conversation.title": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Visibility(); ok {
+		if err := conversation.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Conversation.visibility": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.MessageCount(); ok {
+		if err := conversation.MessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "message_count", err: fmt.Errorf(`ent: validator failed for field "Conversation.message_count": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.SummaryMessageCount(); ok {
+		if err := conversation.SummaryMessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "summary_message_count", err: fmt.Errorf(`ent: validator failed for field "Conversation.summary_message_count": %w`, err)}
+		}
+	}
+	if _, ok := cuo.mutation.UserID(); cuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Conversation.user"`)
+	}
+	return nil
+}
+
+func (cuo *ConversationUpdateOne) sqlSave(ctx context.Context) (_node *Conversation, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   conversation.Table,
+			Columns: conversation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: conversation.FieldID,
+			},
+		},
+	}
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Conversation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversation.FieldID)
+		for _, f := range fields {
+			if !conversation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != conversation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.UpstreamID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldUpstreamID,
+		})
+	}
+	if cuo.mutation.UpstreamIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: conversation.FieldUpstreamID,
+		})
+	}
+	if value, ok := cuo.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldTitle,
+		})
+	}
+	if value, ok := cuo.mutation.CustomTitle(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: conversation.FieldCustomTitle,
+		})
+	}
+	if value, ok := cuo.mutation.Model(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldModel,
+		})
+	}
+	if cuo.mutation.ModelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: conversation.FieldModel,
+		})
+	}
+	if value, ok := cuo.mutation.SystemPrompt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldSystemPrompt,
+		})
+	}
+	if cuo.mutation.SystemPromptCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: conversation.FieldSystemPrompt,
+		})
+	}
+	if value, ok := cuo.mutation.Visibility(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: conversation.FieldVisibility,
+		})
+	}
+	if value, ok := cuo.mutation.Summary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldSummary,
+		})
+	}
+	if cuo.mutation.SummaryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: conversation.FieldSummary,
+		})
+	}
+	if value, ok := cuo.mutation.MessageCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: conversation.FieldMessageCount,
+		})
+	}
+	if value, ok := cuo.mutation.AddedMessageCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: conversation.FieldMessageCount,
+		})
+	}
+	if value, ok := cuo.mutation.SummaryMessageCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: conversation.FieldSummaryMessageCount,
+		})
+	}
+	if value, ok := cuo.mutation.AddedSummaryMessageCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: conversation.FieldSummaryMessageCount,
+		})
+	}
+	if value, ok := cuo.mutation.LastActivityAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: conversation.FieldLastActivityAt,
+		})
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: conversation.FieldUpdatedAt,
+		})
+	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: conversation.FieldDeletedAt,
+		})
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: conversation.FieldDeletedAt,
+		})
+	}
+	if value, ok := cuo.mutation.DeletedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: conversation.FieldDeletedBy,
+		})
+	}
+	if cuo.mutation.DeletedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: conversation.FieldDeletedBy,
+		})
+	}
+	if cuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversation.UserTable,
+			Columns: []string{conversation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversation.UserTable,
+			Columns: []string{conversation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: message.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !cuo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: message.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: message.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Conversation{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
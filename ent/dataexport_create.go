@@ -0,0 +1,965 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"community.threetenth.chatgpt/ent/dataexport"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DataExportCreate is the builder for creating a DataExport entity.
+type DataExportCreate struct {
+	config
+	mutation *DataExportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetStatus sets the "status" field.
+func (dec *DataExportCreate) SetStatus(d dataexport.Status) *DataExportCreate {
+	dec.mutation.SetStatus(d)
+	return dec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableStatus(d *dataexport.Status) *DataExportCreate {
+	if d != nil {
+		dec.SetStatus(*d)
+	}
+	return dec
+}
+
+// SetSize sets the "size" field.
+func (dec *DataExportCreate) SetSize(i int64) *DataExportCreate {
+	dec.mutation.SetSize(i)
+	return dec
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableSize(i *int64) *DataExportCreate {
+	if i != nil {
+		dec.SetSize(*i)
+	}
+	return dec
+}
+
+// SetError sets the "error" field.
+func (dec *DataExportCreate) SetError(s string) *DataExportCreate {
+	dec.mutation.SetError(s)
+	return dec
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableError(s *string) *DataExportCreate {
+	if s != nil {
+		dec.SetError(*s)
+	}
+	return dec
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (dec *DataExportCreate) SetExpiresAt(t time.Time) *DataExportCreate {
+	dec.mutation.SetExpiresAt(t)
+	return dec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dec *DataExportCreate) SetCreatedAt(t time.Time) *DataExportCreate {
+	dec.mutation.SetCreatedAt(t)
+	return dec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableCreatedAt(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetCreatedAt(*t)
+	}
+	return dec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dec *DataExportCreate) SetUpdatedAt(t time.Time) *DataExportCreate {
+	dec.mutation.SetUpdatedAt(t)
+	return dec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dec *DataExportCreate) SetNillableUpdatedAt(t *time.Time) *DataExportCreate {
+	if t != nil {
+		dec.SetUpdatedAt(*t)
+	}
+	return dec
+}
+
+// SetID sets the "id" field.
+func (dec *DataExportCreate) SetID(s string) *DataExportCreate {
+	dec.mutation.SetID(s)
+	return dec
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (dec *DataExportCreate) SetUserID(id string) *DataExportCreate {
+	dec.mutation.SetUserID(id)
+	return dec
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dec *DataExportCreate) SetUser(u *User) *DataExportCreate {
+	return dec.SetUserID(u.ID)
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (dec *DataExportCreate) Mutation() *DataExportMutation {
+	return dec.mutation
+}
+
+// Save creates the DataExport in the database.
+func (dec *DataExportCreate) Save(ctx context.Context) (*DataExport, error) {
+	var (
+		err  error
+		node *DataExport
+	)
+	dec.defaults()
+	if len(dec.hooks) == 0 {
+		if err = dec.check(); err != nil {
+			return nil, err
+		}
+		node, err = dec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DataExportMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = dec.check(); err != nil {
+				return nil, err
+			}
+			dec.mutation = mutation
+			if node, err = dec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(dec.hooks) - 1; i >= 0; i-- {
+			if dec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = dec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, dec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dec *DataExportCreate) SaveX(ctx context.Context) *DataExport {
+	v, err := dec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dec *DataExportCreate) Exec(ctx context.Context) error {
+	_, err := dec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dec *DataExportCreate) ExecX(ctx context.Context) {
+	if err := dec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dec *DataExportCreate) defaults() {
+	if _, ok := dec.mutation.Status(); !ok {
+		v := dataexport.DefaultStatus
+		dec.mutation.SetStatus(v)
+	}
+	if _, ok := dec.mutation.Size(); !ok {
+		v := dataexport.DefaultSize
+		dec.mutation.SetSize(v)
+	}
+	if _, ok := dec.mutation.CreatedAt(); !ok {
+		v := dataexport.DefaultCreatedAt()
+		dec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dec.mutation.UpdatedAt(); !ok {
+		v := dataexport.DefaultUpdatedAt()
+		dec.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dec *DataExportCreate) check() error {
+	if _, ok := dec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DataExport.status"`)}
+	}
+	if v, ok := dec.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if _, ok := dec.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "DataExport.size"`)}
+	}
+	if v, ok := dec.mutation.Size(); ok {
+		if err := dataexport.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "DataExport.size": %w`, err)}
+		}
+	}
+	if _, ok := dec.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "DataExport.expires_at"`)}
+	}
+	if _, ok := dec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DataExport.created_at"`)}
+	}
+	if _, ok := dec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DataExport.updated_at"`)}
+	}
+	if v, ok := dec.mutation.ID(); ok {
+		if err := dataexport.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DataExport.id": %w`, err)}
+		}
+	}
+	if _, ok := dec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "DataExport.user"`)}
+	}
+	return nil
+}
+
+func (dec *DataExportCreate) sqlSave(ctx context.Context) (*DataExport, error) {
+	_node, _spec := dec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DataExport.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (dec *DataExportCreate) createSpec() (*DataExport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataExport{config: dec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: dataexport.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: dataexport.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = dec.conflict
+	if id, ok := dec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dec.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: dataexport.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := dec.mutation.Size(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: dataexport.FieldSize,
+		})
+		_node.Size = value
+	}
+	if value, ok := dec.mutation.Error(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: dataexport.FieldError,
+		})
+		_node.Error = value
+	}
+	if value, ok := dec.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: dataexport.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := dec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: dataexport.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := dec.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: dataexport.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := dec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dataexport.UserTable,
+			Columns: []string{dataexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataExport.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataExportUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (dec *DataExportCreate) OnConflict(opts ...sql.ConflictOption) *DataExportUpsertOne {
+	dec.conflict = opts
+	return &DataExportUpsertOne{
+		create: dec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dec *DataExportCreate) OnConflictColumns(columns ...string) *DataExportUpsertOne {
+	dec.conflict = append(dec.conflict, sql.ConflictColumns(columns...))
+	return &DataExportUpsertOne{
+		create: dec,
+	}
+}
+
+type (
+	// DataExportUpsertOne is the builder for "upsert"-ing
+	//  one DataExport node.
+	DataExportUpsertOne struct {
+		create *DataExportCreate
+	}
+
+	// DataExportUpsert is the "OnConflict" setter.
+	DataExportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *DataExportUpsert) SetStatus(v dataexport.Status) *DataExportUpsert {
+	u.Set(dataexport.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateStatus() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldStatus)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *DataExportUpsert) SetSize(v int64) *DataExportUpsert {
+	u.Set(dataexport.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateSize() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *DataExportUpsert) AddSize(v int64) *DataExportUpsert {
+	u.Add(dataexport.FieldSize, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *DataExportUpsert) SetError(v string) *DataExportUpsert {
+	u.Set(dataexport.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateError() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *DataExportUpsert) ClearError() *DataExportUpsert {
+	u.SetNull(dataexport.FieldError)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *DataExportUpsert) SetExpiresAt(v time.Time) *DataExportUpsert {
+	u.Set(dataexport.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateExpiresAt() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldExpiresAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DataExportUpsert) SetCreatedAt(v time.Time) *DataExportUpsert {
+	u.Set(dataexport.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateCreatedAt() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DataExportUpsert) SetUpdatedAt(v time.Time) *DataExportUpsert {
+	u.Set(dataexport.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateUpdatedAt() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dataexport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DataExportUpsertOne) UpdateNewValues() *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(dataexport.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(dataexport.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DataExportUpsertOne) Ignore() *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataExportUpsertOne) DoNothing() *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataExportCreate.OnConflict
+// documentation for more info.
+func (u *DataExportUpsertOne) Update(set func(*DataExportUpsert)) *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataExportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DataExportUpsertOne) SetStatus(v dataexport.Status) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateStatus() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *DataExportUpsertOne) SetSize(v int64) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *DataExportUpsertOne) AddSize(v int64) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateSize() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DataExportUpsertOne) SetError(v string) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateError() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *DataExportUpsertOne) ClearError() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *DataExportUpsertOne) SetExpiresAt(v time.Time) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateExpiresAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DataExportUpsertOne) SetCreatedAt(v time.Time) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateCreatedAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DataExportUpsertOne) SetUpdatedAt(v time.Time) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateUpdatedAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DataExportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DataExportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataExportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DataExportUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DataExportUpsertOne.ID is not supported by MySQL driver. Use DataExportUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DataExportUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DataExportCreateBulk is the builder for creating many DataExport entities in bulk.
+type DataExportCreateBulk struct {
+	config
+	builders []*DataExportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DataExport entities in the database.
+func (decb *DataExportCreateBulk) Save(ctx context.Context) ([]*DataExport, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(decb.builders))
+	nodes := make([]*DataExport, len(decb.builders))
+	mutators := make([]Mutator, len(decb.builders))
+	for i := range decb.builders {
+		func(i int, root context.Context) {
+			builder := decb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, decb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = decb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, decb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, decb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (decb *DataExportCreateBulk) SaveX(ctx context.Context) []*DataExport {
+	v, err := decb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (decb *DataExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := decb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (decb *DataExportCreateBulk) ExecX(ctx context.Context) {
+	if err := decb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataExport.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataExportUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (decb *DataExportCreateBulk) OnConflict(opts ...sql.ConflictOption) *DataExportUpsertBulk {
+	decb.conflict = opts
+	return &DataExportUpsertBulk{
+		create: decb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (decb *DataExportCreateBulk) OnConflictColumns(columns ...string) *DataExportUpsertBulk {
+	decb.conflict = append(decb.conflict, sql.ConflictColumns(columns...))
+	return &DataExportUpsertBulk{
+		create: decb,
+	}
+}
+
+// DataExportUpsertBulk is the builder for "upsert"-ing
+// a bulk of DataExport nodes.
+type DataExportUpsertBulk struct {
+	create *DataExportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dataexport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DataExportUpsertBulk) UpdateNewValues() *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(dataexport.FieldID)
+				return
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(dataexport.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DataExportUpsertBulk) Ignore() *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataExportUpsertBulk) DoNothing() *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataExportCreateBulk.OnConflict
+// documentation for more info.
+func (u *DataExportUpsertBulk) Update(set func(*DataExportUpsert)) *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataExportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DataExportUpsertBulk) SetStatus(v dataexport.Status) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateStatus() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *DataExportUpsertBulk) SetSize(v int64) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *DataExportUpsertBulk) AddSize(v int64) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateSize() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DataExportUpsertBulk) SetError(v string) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateError() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *DataExportUpsertBulk) ClearError() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *DataExportUpsertBulk) SetExpiresAt(v time.Time) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateExpiresAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DataExportUpsertBulk) SetCreatedAt(v time.Time) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateCreatedAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DataExportUpsertBulk) SetUpdatedAt(v time.Time) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateUpdatedAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DataExportUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DataExportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DataExportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataExportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -1,3 +1,4 @@
 package ent
 
+// 生成的代码和 schema 一起提交，修改 schema 后需要重新生成并提交
 //go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/upsert ./schema
@@ -0,0 +1,273 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Message is the model entity for the Message schema.
+type Message struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID string `json:"conversation_id,omitempty"`
+	// ParentMessageID holds the value of the "parent_message_id" field.
+	ParentMessageID string `json:"parent_message_id,omitempty"`
+	// Hidden holds the value of the "hidden" field.
+	// 等待审核或被管理员隐藏的消息不会公开显示
+	Hidden bool `json:"hidden,omitempty"`
+	// Status holds the value of the "status" field.
+	// 提问所在这一轮的状态，pending 和 streaming 的提问还在等待回复
+	Status message.Status `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	// 软删除的时间，被删除的消息在会话树中显示为占位，超过保留期限后彻底删除
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	// 删除者的用户 ID，作者只能恢复自己删除的内容
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageQuery when eager-loading is set.
+	Edges   MessageEdges `json:"edges"`
+	user_id *string
+}
+
+// MessageEdges holds the relations/edges for other nodes in the graph.
+type MessageEdges struct {
+	// Conversation holds the value of the conversation edge.
+	Conversation *Conversation `json:"conversation,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ConversationOrErr returns the Conversation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ConversationOrErr() (*Conversation, error) {
+	if e.loadedTypes[0] {
+		if e.Conversation == nil {
+			// The edge conversation was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: conversation.Label}
+		}
+		return e.Conversation, nil
+	}
+	return nil, &NotLoadedError{edge: "conversation"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Message) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case message.FieldHidden:
+			values[i] = new(sql.NullBool)
+		case message.FieldID, message.FieldContent, message.FieldContentType, message.FieldRole, message.FieldConversationID, message.FieldParentMessageID, message.FieldStatus, message.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case message.FieldCreatedAt, message.FieldUpdatedAt, message.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case message.ForeignKeys[0]: // user_id
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Message", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Message fields.
+func (m *Message) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case message.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				m.ID = value.String
+			}
+		case message.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				m.Content = value.String
+			}
+		case message.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				m.ContentType = value.String
+			}
+		case message.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				m.Role = value.String
+			}
+		case message.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				m.ConversationID = value.String
+			}
+		case message.FieldParentMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_message_id", values[i])
+			} else if value.Valid {
+				m.ParentMessageID = value.String
+			}
+		case message.FieldHidden:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hidden", values[i])
+			} else if value.Valid {
+				m.Hidden = value.Bool
+			}
+		case message.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				m.Status = message.Status(value.String)
+			}
+		case message.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case message.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				m.UpdatedAt = value.Time
+			}
+		case message.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				m.DeletedAt = new(time.Time)
+				*m.DeletedAt = value.Time
+			}
+		case message.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				m.DeletedBy = value.String
+			}
+		case message.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				m.user_id = new(string)
+				*m.user_id = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryConversation queries the "conversation" edge of the Message entity.
+func (m *Message) QueryConversation() *ConversationQuery {
+	return (&MessageClient{config: m.config}).QueryConversation(m)
+}
+
+// QueryUser queries the "user" edge of the Message entity.
+func (m *Message) QueryUser() *UserQuery {
+	return (&MessageClient{config: m.config}).QueryUser(m)
+}
+
+// Update returns a builder for updating this Message.
+// Note that you need to call Message.Unwrap() before calling this method if this Message
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Message) Update() *MessageUpdateOne {
+	return (&MessageClient{config: m.config}).UpdateOne(m)
+}
+
+// Unwrap unwraps the Message entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Message) Unwrap() *Message {
+	tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Message is not a transactional entity")
+	}
+	m.config.driver = tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Message) String() string {
+	var builder strings.Builder
+	builder.WriteString("Message(")
+	builder.WriteString(fmt.Sprintf("id=%v", m.ID))
+	builder.WriteString(", content=")
+	builder.WriteString(m.Content)
+	builder.WriteString(", content_type=")
+	builder.WriteString(m.ContentType)
+	builder.WriteString(", role=")
+	builder.WriteString(m.Role)
+	builder.WriteString(", conversation_id=")
+	builder.WriteString(m.ConversationID)
+	builder.WriteString(", parent_message_id=")
+	builder.WriteString(m.ParentMessageID)
+	builder.WriteString(", hidden=")
+	builder.WriteString(fmt.Sprintf("%v", m.Hidden))
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", m.Status))
+	builder.WriteString(", created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(m.UpdatedAt.Format(time.ANSIC))
+	if v := m.DeletedAt; v != nil {
+		builder.WriteString(", deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", deleted_by=")
+	builder.WriteString(m.DeletedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Messages is a parsable slice of Message.
+type Messages []*Message
+
+func (m Messages) config(cfg config) {
+	for _i := range m {
+		m[_i].config = cfg
+	}
+}
@@ -0,0 +1,988 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/predicate"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageUpdate is the builder for updating Message entities.
+type MessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageMutation
+}
+
+// Where appends a list predicates to the MessageUpdate builder.
+func (mu *MessageUpdate) Where(ps ...predicate.Message) *MessageUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetContent sets the "content" field.
+func (mu *MessageUpdate) SetContent(s string) *MessageUpdate {
+	mu.mutation.SetContent(s)
+	return mu
+}
+
+// SetContentType sets the "content_type" field.
+func (mu *MessageUpdate) SetContentType(s string) *MessageUpdate {
+	mu.mutation.SetContentType(s)
+	return mu
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableContentType(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetContentType(*s)
+	}
+	return mu
+}
+
+// SetRole sets the "role" field.
+func (mu *MessageUpdate) SetRole(s string) *MessageUpdate {
+	mu.mutation.SetRole(s)
+	return mu
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (mu *MessageUpdate) SetConversationID(s string) *MessageUpdate {
+	mu.mutation.SetConversationID(s)
+	return mu
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableConversationID(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetConversationID(*s)
+	}
+	return mu
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (mu *MessageUpdate) ClearConversationID() *MessageUpdate {
+	mu.mutation.ClearConversationID()
+	return mu
+}
+
+// SetParentMessageID sets the "parent_message_id" field.
+func (mu *MessageUpdate) SetParentMessageID(s string) *MessageUpdate {
+	mu.mutation.SetParentMessageID(s)
+	return mu
+}
+
+// SetNillableParentMessageID sets the "parent_message_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableParentMessageID(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetParentMessageID(*s)
+	}
+	return mu
+}
+
+// ClearParentMessageID clears the value of the "parent_message_id" field.
+func (mu *MessageUpdate) ClearParentMessageID() *MessageUpdate {
+	mu.mutation.ClearParentMessageID()
+	return mu
+}
+
+// SetHidden sets the "hidden" field.
+func (mu *MessageUpdate) SetHidden(b bool) *MessageUpdate {
+	mu.mutation.SetHidden(b)
+	return mu
+}
+
+// SetNillableHidden sets the "hidden" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableHidden(b *bool) *MessageUpdate {
+	if b != nil {
+		mu.SetHidden(*b)
+	}
+	return mu
+}
+
+// SetStatus sets the "status" field.
+func (mu *MessageUpdate) SetStatus(m message.Status) *MessageUpdate {
+	mu.mutation.SetStatus(m)
+	return mu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableStatus(m *message.Status) *MessageUpdate {
+	if m != nil {
+		mu.SetStatus(*m)
+	}
+	return mu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mu *MessageUpdate) SetCreatedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetCreatedAt(t)
+	return mu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableCreatedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetCreatedAt(*t)
+	}
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MessageUpdate) SetUpdatedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mu *MessageUpdate) SetDeletedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetDeletedAt(t)
+	return mu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableDeletedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetDeletedAt(*t)
+	}
+	return mu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mu *MessageUpdate) ClearDeletedAt() *MessageUpdate {
+	mu.mutation.ClearDeletedAt()
+	return mu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (mu *MessageUpdate) SetDeletedBy(s string) *MessageUpdate {
+	mu.mutation.SetDeletedBy(s)
+	return mu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableDeletedBy(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetDeletedBy(*s)
+	}
+	return mu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (mu *MessageUpdate) ClearDeletedBy() *MessageUpdate {
+	mu.mutation.ClearDeletedBy()
+	return mu
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (mu *MessageUpdate) SetConversation(c *Conversation) *MessageUpdate {
+	return mu.SetConversationID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (mu *MessageUpdate) SetUserID(id string) *MessageUpdate {
+	mu.mutation.SetUserID(id)
+	return mu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mu *MessageUpdate) SetUser(u *User) *MessageUpdate {
+	return mu.SetUserID(u.ID)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (mu *MessageUpdate) Mutation() *MessageMutation {
+	return mu.mutation
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (mu *MessageUpdate) ClearConversation() *MessageUpdate {
+	mu.mutation.ClearConversation()
+	return mu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (mu *MessageUpdate) ClearUser() *MessageUpdate {
+	mu.mutation.ClearUser()
+	return mu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MessageUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	mu.defaults()
+	if len(mu.hooks) == 0 {
+		if err = mu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = mu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mu.check(); err != nil {
+				return 0, err
+			}
+			mu.mutation = mutation
+			affected, err = mu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mu.hooks) - 1; i >= 0; i-- {
+			if mu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MessageUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MessageUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MessageUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := message.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MessageUpdate) check() error {
+	if v, ok := mu.mutation.Content(); ok {
+		if err := message.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Message.content": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Status(); ok {
+		if err := message.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Message.status": %w`, err)}
+		}
+	}
+	if _, ok := mu.mutation.UserID(); mu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Message.user"`)
+	}
+	return nil
+}
+
+func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   message.Table,
+			Columns: message.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: message.FieldID,
+			},
+		},
+	}
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.Content(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: message.FieldContent,
+		})
+	}
+	if value, ok := mu.mutation.ContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: message.FieldContentType,
+		})
+	}
+	if value, ok := mu.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: message.FieldRole,
+		})
+	}
+	if value, ok := mu.mutation.ParentMessageID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: message.FieldParentMessageID,
+		})
+	}
+	if mu.mutation.ParentMessageIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: message.FieldParentMessageID,
+		})
+	}
+	if value, ok := mu.mutation.Hidden(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: message.FieldHidden,
+		})
+	}
+	if value, ok := mu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: message.FieldStatus,
+		})
+	}
+	if value, ok := mu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: message.FieldCreatedAt,
+		})
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: message.FieldUpdatedAt,
+		})
+	}
+	if value, ok := mu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: message.FieldDeletedAt,
+		})
+	}
+	if mu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: message.FieldDeletedAt,
+		})
+	}
+	if value, ok := mu.mutation.DeletedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: message.FieldDeletedBy,
+		})
+	}
+	if mu.mutation.DeletedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: message.FieldDeletedBy,
+		})
+	}
+	if mu.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ConversationTable,
+			Columns: []string{message.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: conversation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ConversationTable,
+			Columns: []string{message.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: conversation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.UserTable,
+			Columns: []string{message.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.UserTable,
+			Columns: []string{message.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MessageUpdateOne is the builder for updating a single Message entity.
+type MessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageMutation
+}
+
+// SetContent sets the "content" field.
+func (muo *MessageUpdateOne) SetContent(s string) *MessageUpdateOne {
+	muo.mutation.SetContent(s)
+	return muo
+}
+
+// SetContentType sets the "content_type" field.
+func (muo *MessageUpdateOne) SetContentType(s string) *MessageUpdateOne {
+	muo.mutation.SetContentType(s)
+	return muo
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableContentType(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetContentType(*s)
+	}
+	return muo
+}
+
+// SetRole sets the "role" field.
+func (muo *MessageUpdateOne) SetRole(s string) *MessageUpdateOne {
+	muo.mutation.SetRole(s)
+	return muo
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (muo *MessageUpdateOne) SetConversationID(s string) *MessageUpdateOne {
+	muo.mutation.SetConversationID(s)
+	return muo
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableConversationID(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetConversationID(*s)
+	}
+	return muo
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (muo *MessageUpdateOne) ClearConversationID() *MessageUpdateOne {
+	muo.mutation.ClearConversationID()
+	return muo
+}
+
+// SetParentMessageID sets the "parent_message_id" field.
+func (muo *MessageUpdateOne) SetParentMessageID(s string) *MessageUpdateOne {
+	muo.mutation.SetParentMessageID(s)
+	return muo
+}
+
+// SetNillableParentMessageID sets the "parent_message_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableParentMessageID(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetParentMessageID(*s)
+	}
+	return muo
+}
+
+// ClearParentMessageID clears the value of the "parent_message_id" field.
+func (muo *MessageUpdateOne) ClearParentMessageID() *MessageUpdateOne {
+	muo.mutation.ClearParentMessageID()
+	return muo
+}
+
+// SetHidden sets the "hidden" field.
+func (muo *MessageUpdateOne) SetHidden(b bool) *MessageUpdateOne {
+	muo.mutation.SetHidden(b)
+	return muo
+}
+
+// SetNillableHidden sets the "hidden" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableHidden(b *bool) *MessageUpdateOne {
+	if b != nil {
+		muo.SetHidden(*b)
+	}
+	return muo
+}
+
+// SetStatus sets the "status" field.
+func (muo *MessageUpdateOne) SetStatus(m message.Status) *MessageUpdateOne {
+	muo.mutation.SetStatus(m)
+	return muo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableStatus(m *message.Status) *MessageUpdateOne {
+	if m != nil {
+		muo.SetStatus(*m)
+	}
+	return muo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (muo *MessageUpdateOne) SetCreatedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetCreatedAt(t)
+	return muo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableCreatedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetCreatedAt(*t)
+	}
+	return muo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MessageUpdateOne) SetUpdatedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (muo *MessageUpdateOne) SetDeletedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetDeletedAt(t)
+	return muo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableDeletedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetDeletedAt(*t)
+	}
+	return muo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (muo *MessageUpdateOne) ClearDeletedAt() *MessageUpdateOne {
+	muo.mutation.ClearDeletedAt()
+	return muo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (muo *MessageUpdateOne) SetDeletedBy(s string) *MessageUpdateOne {
+	muo.mutation.SetDeletedBy(s)
+	return muo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableDeletedBy(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetDeletedBy(*s)
+	}
+	return muo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (muo *MessageUpdateOne) ClearDeletedBy() *MessageUpdateOne {
+	muo.mutation.ClearDeletedBy()
+	return muo
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (muo *MessageUpdateOne) SetConversation(c *Conversation) *MessageUpdateOne {
+	return muo.SetConversationID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (muo *MessageUpdateOne) SetUserID(id string) *MessageUpdateOne {
+	muo.mutation.SetUserID(id)
+	return muo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (muo *MessageUpdateOne) SetUser(u *User) *MessageUpdateOne {
+	return muo.SetUserID(u.ID)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (muo *MessageUpdateOne) Mutation() *MessageMutation {
+	return muo.mutation
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (muo *MessageUpdateOne) ClearConversation() *MessageUpdateOne {
+	muo.mutation.ClearConversation()
+	return muo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (muo *MessageUpdateOne) ClearUser() *MessageUpdateOne {
+	muo.mutation.ClearUser()
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MessageUpdateOne) Select(field string, fields ...string) *MessageUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Message entity.
+func (muo *MessageUpdateOne) Save(ctx context.Context) (*Message, error) {
+	var (
+		err  error
+		node *Message
+	)
+	muo.defaults()
+	if len(muo.hooks) == 0 {
+		if err = muo.check(); err != nil {
+			return nil, err
+		}
+		node, err = muo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = muo.check(); err != nil {
+				return nil, err
+			}
+			muo.mutation = mutation
+			node, err = muo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(muo.hooks) - 1; i >= 0; i-- {
+			if muo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = muo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, muo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MessageUpdateOne) SaveX(ctx context.Context) *Message {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MessageUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MessageUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := message.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MessageUpdateOne) check() error {
+	if v, ok := muo.mutation.Content(); ok {
+		if err := message.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Message.content": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Status(); ok {
+		if err := message.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Message.status": %w`, err)}
+		}
+	}
+	if _, ok := muo.mutation.UserID(); muo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Message.user"`)
+	}
+	return nil
+}
+
+func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   message.Table,
+			Columns: message.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: message.FieldID,
+			},
+		},
+	}
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Message.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, message.FieldID)
+		for _, f := range fields {
+			if !message.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != message.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.Content(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: message.FieldContent,
+		})
+	}
+	if value, ok := muo.mutation.ContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: message.FieldContentType,
+		})
+	}
+	if value, ok := muo.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: message.FieldRole,
+		})
+	}
+	if value, ok := muo.mutation.ParentMessageID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: message.FieldParentMessageID,
+		})
+	}
+	if muo.mutation.ParentMessageIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: message.FieldParentMessageID,
+		})
+	}
+	if value, ok := muo.mutation.Hidden(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: message.FieldHidden,
+		})
+	}
+	if value, ok := muo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: message.FieldStatus,
+		})
+	}
+	if value, ok := muo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: message.FieldCreatedAt,
+		})
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: message.FieldUpdatedAt,
+		})
+	}
+	if value, ok := muo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: message.FieldDeletedAt,
+		})
+	}
+	if muo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: message.FieldDeletedAt,
+		})
+	}
+	if value, ok := muo.mutation.DeletedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: message.FieldDeletedBy,
+		})
+	}
+	if muo.mutation.DeletedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: message.FieldDeletedBy,
+		})
+	}
+	if muo.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ConversationTable,
+			Columns: []string{message.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: conversation.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ConversationTable,
+			Columns: []string{message.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: conversation.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.UserTable,
+			Columns: []string{message.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.UserTable,
+			Columns: []string{message.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Message{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
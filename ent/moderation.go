@@ -0,0 +1,248 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"community.threetenth.chatgpt/ent/moderation"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Moderation is the model entity for the Moderation schema.
+type Moderation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Stage holds the value of the "stage" field.
+	Stage moderation.Stage `json:"stage,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Decision holds the value of the "decision" field.
+	Decision moderation.Decision `json:"decision,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Review holds the value of the "review" field.
+	// 管理员审核状态，只有 hold 的结果为 pending
+	Review moderation.Review `json:"review,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID string `json:"message_id,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID string `json:"conversation_id,omitempty"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID string `json:"reviewer_id,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ModerationQuery when eager-loading is set.
+	Edges   ModerationEdges `json:"edges"`
+	user_id *string
+}
+
+// ModerationEdges holds the relations/edges for other nodes in the graph.
+type ModerationEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ModerationEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Moderation) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case moderation.FieldID:
+			values[i] = new(sql.NullInt64)
+		case moderation.FieldStage, moderation.FieldContent, moderation.FieldDecision, moderation.FieldReason, moderation.FieldProvider, moderation.FieldReview, moderation.FieldMessageID, moderation.FieldConversationID, moderation.FieldReviewerID:
+			values[i] = new(sql.NullString)
+		case moderation.FieldReviewedAt, moderation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case moderation.ForeignKeys[0]: // user_id
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Moderation", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Moderation fields.
+func (m *Moderation) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case moderation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			m.ID = int(value.Int64)
+		case moderation.FieldStage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stage", values[i])
+			} else if value.Valid {
+				m.Stage = moderation.Stage(value.String)
+			}
+		case moderation.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				m.Content = value.String
+			}
+		case moderation.FieldDecision:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decision", values[i])
+			} else if value.Valid {
+				m.Decision = moderation.Decision(value.String)
+			}
+		case moderation.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				m.Reason = value.String
+			}
+		case moderation.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				m.Provider = value.String
+			}
+		case moderation.FieldReview:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review", values[i])
+			} else if value.Valid {
+				m.Review = moderation.Review(value.String)
+			}
+		case moderation.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				m.MessageID = value.String
+			}
+		case moderation.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				m.ConversationID = value.String
+			}
+		case moderation.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				m.ReviewerID = value.String
+			}
+		case moderation.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				m.ReviewedAt = new(time.Time)
+				*m.ReviewedAt = value.Time
+			}
+		case moderation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case moderation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				m.user_id = new(string)
+				*m.user_id = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the Moderation entity.
+func (m *Moderation) QueryUser() *UserQuery {
+	return (&ModerationClient{config: m.config}).QueryUser(m)
+}
+
+// Update returns a builder for updating this Moderation.
+// Note that you need to call Moderation.Unwrap() before calling this method if this Moderation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Moderation) Update() *ModerationUpdateOne {
+	return (&ModerationClient{config: m.config}).UpdateOne(m)
+}
+
+// Unwrap unwraps the Moderation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Moderation) Unwrap() *Moderation {
+	tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Moderation is not a transactional entity")
+	}
+	m.config.driver = tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Moderation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Moderation(")
+	builder.WriteString(fmt.Sprintf("id=%v", m.ID))
+	builder.WriteString(", stage=")
+	builder.WriteString(fmt.Sprintf("%v", m.Stage))
+	builder.WriteString(", content=")
+	builder.WriteString(m.Content)
+	builder.WriteString(", decision=")
+	builder.WriteString(fmt.Sprintf("%v", m.Decision))
+	builder.WriteString(", reason=")
+	builder.WriteString(m.Reason)
+	builder.WriteString(", provider=")
+	builder.WriteString(m.Provider)
+	builder.WriteString(", review=")
+	builder.WriteString(fmt.Sprintf("%v", m.Review))
+	builder.WriteString(", message_id=")
+	builder.WriteString(m.MessageID)
+	builder.WriteString(", conversation_id=")
+	builder.WriteString(m.ConversationID)
+	builder.WriteString(", reviewer_id=")
+	builder.WriteString(m.ReviewerID)
+	if v := m.ReviewedAt; v != nil {
+		builder.WriteString(", reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Moderations is a parsable slice of Moderation.
+type Moderations []*Moderation
+
+func (m Moderations) config(cfg config) {
+	for _i := range m {
+		m[_i].config = cfg
+	}
+}
@@ -0,0 +1,165 @@
+// Code generated by entc, DO NOT EDIT.
+
+package moderation
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the moderation type in the database.
+	Label = "moderation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStage holds the string denoting the stage field in the database.
+	FieldStage = "stage"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldDecision holds the string denoting the decision field in the database.
+	FieldDecision = "decision"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldReview holds the string denoting the review field in the database.
+	FieldReview = "review"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldConversationID holds the string denoting the conversation_id field in the database.
+	FieldConversationID = "conversation_id"
+	// FieldReviewerID holds the string denoting the reviewer_id field in the database.
+	FieldReviewerID = "reviewer_id"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the moderation in the database.
+	Table = "moderations"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "moderations"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for moderation fields.
+var Columns = []string{
+	FieldID,
+	FieldStage,
+	FieldContent,
+	FieldDecision,
+	FieldReason,
+	FieldProvider,
+	FieldReview,
+	FieldMessageID,
+	FieldConversationID,
+	FieldReviewerID,
+	FieldReviewedAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "moderations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Stage defines the type for the "stage" enum field.
+type Stage string
+
+// Stage values.
+const (
+	StagePrompt Stage = "prompt"
+	StageAnswer Stage = "answer"
+)
+
+func (s Stage) String() string {
+	return string(s)
+}
+
+// StageValidator is a validator for the "stage" field enum values. It is called by the builders before save.
+func StageValidator(s Stage) error {
+	switch s {
+	case StagePrompt, StageAnswer:
+		return nil
+	default:
+		return fmt.Errorf("moderation: invalid enum value for stage field: %q", s)
+	}
+}
+
+// Decision defines the type for the "decision" enum field.
+type Decision string
+
+// Decision values.
+const (
+	DecisionAllow  Decision = "allow"
+	DecisionHold   Decision = "hold"
+	DecisionReject Decision = "reject"
+)
+
+func (d Decision) String() string {
+	return string(d)
+}
+
+// DecisionValidator is a validator for the "decision" field enum values. It is called by the builders before save.
+func DecisionValidator(d Decision) error {
+	switch d {
+	case DecisionAllow, DecisionHold, DecisionReject:
+		return nil
+	default:
+		return fmt.Errorf("moderation: invalid enum value for decision field: %q", d)
+	}
+}
+
+// Review defines the type for the "review" enum field.
+type Review string
+
+// ReviewNone is the default value of the Review enum.
+const DefaultReview = ReviewNone
+
+// Review values.
+const (
+	ReviewNone     Review = "none"
+	ReviewPending  Review = "pending"
+	ReviewApproved Review = "approved"
+	ReviewRejected Review = "rejected"
+)
+
+func (r Review) String() string {
+	return string(r)
+}
+
+// ReviewValidator is a validator for the "review" field enum values. It is called by the builders before save.
+func ReviewValidator(r Review) error {
+	switch r {
+	case ReviewNone, ReviewPending, ReviewApproved, ReviewRejected:
+		return nil
+	default:
+		return fmt.Errorf("moderation: invalid enum value for review field: %q", r)
+	}
+}
@@ -0,0 +1,1301 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"community.threetenth.chatgpt/ent/moderation"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ModerationCreate is the builder for creating a Moderation entity.
+type ModerationCreate struct {
+	config
+	mutation *ModerationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetStage sets the "stage" field.
+func (mc *ModerationCreate) SetStage(m moderation.Stage) *ModerationCreate {
+	mc.mutation.SetStage(m)
+	return mc
+}
+
+// SetContent sets the "content" field.
+func (mc *ModerationCreate) SetContent(s string) *ModerationCreate {
+	mc.mutation.SetContent(s)
+	return mc
+}
+
+// SetDecision sets the "decision" field.
+func (mc *ModerationCreate) SetDecision(m moderation.Decision) *ModerationCreate {
+	mc.mutation.SetDecision(m)
+	return mc
+}
+
+// SetReason sets the "reason" field.
+func (mc *ModerationCreate) SetReason(s string) *ModerationCreate {
+	mc.mutation.SetReason(s)
+	return mc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mc *ModerationCreate) SetNillableReason(s *string) *ModerationCreate {
+	if s != nil {
+		mc.SetReason(*s)
+	}
+	return mc
+}
+
+// SetProvider sets the "provider" field.
+func (mc *ModerationCreate) SetProvider(s string) *ModerationCreate {
+	mc.mutation.SetProvider(s)
+	return mc
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (mc *ModerationCreate) SetNillableProvider(s *string) *ModerationCreate {
+	if s != nil {
+		mc.SetProvider(*s)
+	}
+	return mc
+}
+
+// SetReview sets the "review" field.
+func (mc *ModerationCreate) SetReview(m moderation.Review) *ModerationCreate {
+	mc.mutation.SetReview(m)
+	return mc
+}
+
+// SetNillableReview sets the "review" field if the given value is not nil.
+func (mc *ModerationCreate) SetNillableReview(m *moderation.Review) *ModerationCreate {
+	if m != nil {
+		mc.SetReview(*m)
+	}
+	return mc
+}
+
+// SetMessageID sets the "message_id" field.
+func (mc *ModerationCreate) SetMessageID(s string) *ModerationCreate {
+	mc.mutation.SetMessageID(s)
+	return mc
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (mc *ModerationCreate) SetNillableMessageID(s *string) *ModerationCreate {
+	if s != nil {
+		mc.SetMessageID(*s)
+	}
+	return mc
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (mc *ModerationCreate) SetConversationID(s string) *ModerationCreate {
+	mc.mutation.SetConversationID(s)
+	return mc
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (mc *ModerationCreate) SetNillableConversationID(s *string) *ModerationCreate {
+	if s != nil {
+		mc.SetConversationID(*s)
+	}
+	return mc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (mc *ModerationCreate) SetReviewerID(s string) *ModerationCreate {
+	mc.mutation.SetReviewerID(s)
+	return mc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (mc *ModerationCreate) SetNillableReviewerID(s *string) *ModerationCreate {
+	if s != nil {
+		mc.SetReviewerID(*s)
+	}
+	return mc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (mc *ModerationCreate) SetReviewedAt(t time.Time) *ModerationCreate {
+	mc.mutation.SetReviewedAt(t)
+	return mc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (mc *ModerationCreate) SetNillableReviewedAt(t *time.Time) *ModerationCreate {
+	if t != nil {
+		mc.SetReviewedAt(*t)
+	}
+	return mc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *ModerationCreate) SetCreatedAt(t time.Time) *ModerationCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *ModerationCreate) SetNillableCreatedAt(t *time.Time) *ModerationCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (mc *ModerationCreate) SetUserID(id string) *ModerationCreate {
+	mc.mutation.SetUserID(id)
+	return mc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mc *ModerationCreate) SetUser(u *User) *ModerationCreate {
+	return mc.SetUserID(u.ID)
+}
+
+// Mutation returns the ModerationMutation object of the builder.
+func (mc *ModerationCreate) Mutation() *ModerationMutation {
+	return mc.mutation
+}
+
+// Save creates the Moderation in the database.
+func (mc *ModerationCreate) Save(ctx context.Context) (*Moderation, error) {
+	var (
+		err  error
+		node *Moderation
+	)
+	mc.defaults()
+	if len(mc.hooks) == 0 {
+		if err = mc.check(); err != nil {
+			return nil, err
+		}
+		node, err = mc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ModerationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mc.check(); err != nil {
+				return nil, err
+			}
+			mc.mutation = mutation
+			if node, err = mc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mc.hooks) - 1; i >= 0; i-- {
+			if mc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *ModerationCreate) SaveX(ctx context.Context) *Moderation {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *ModerationCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *ModerationCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *ModerationCreate) defaults() {
+	if _, ok := mc.mutation.Review(); !ok {
+		v := moderation.DefaultReview
+		mc.mutation.SetReview(v)
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := moderation.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *ModerationCreate) check() error {
+	if _, ok := mc.mutation.Stage(); !ok {
+		return &ValidationError{Name: "stage", err: errors.New(`ent: missing required field "Moderation.stage"`)}
+	}
+	if v, ok := mc.mutation.Stage(); ok {
+		if err := moderation.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "Moderation.stage": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Moderation.content"`)}
+	}
+	if _, ok := mc.mutation.Decision(); !ok {
+		return &ValidationError{Name: "decision", err: errors.New(`ent: missing required field "Moderation.decision"`)}
+	}
+	if v, ok := mc.mutation.Decision(); ok {
+		if err := moderation.DecisionValidator(v); err != nil {
+			return &ValidationError{Name: "decision", err: fmt.Errorf(`ent: validator failed for field "Moderation.decision": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Review(); !ok {
+		return &ValidationError{Name: "review", err: errors.New(`ent: missing required field "Moderation.review"`)}
+	}
+	if v, ok := mc.mutation.Review(); ok {
+		if err := moderation.ReviewValidator(v); err != nil {
+			return &ValidationError{Name: "review", err: fmt.Errorf(`ent: validator failed for field "Moderation.review": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Moderation.created_at"`)}
+	}
+	if _, ok := mc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Moderation.user"`)}
+	}
+	return nil
+}
+
+func (mc *ModerationCreate) sqlSave(ctx context.Context) (*Moderation, error) {
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (mc *ModerationCreate) createSpec() (*Moderation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Moderation{config: mc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: moderation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: moderation.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = mc.conflict
+	if value, ok := mc.mutation.Stage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: moderation.FieldStage,
+		})
+		_node.Stage = value
+	}
+	if value, ok := mc.mutation.Content(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldContent,
+		})
+		_node.Content = value
+	}
+	if value, ok := mc.mutation.Decision(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: moderation.FieldDecision,
+		})
+		_node.Decision = value
+	}
+	if value, ok := mc.mutation.Reason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldReason,
+		})
+		_node.Reason = value
+	}
+	if value, ok := mc.mutation.Provider(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldProvider,
+		})
+		_node.Provider = value
+	}
+	if value, ok := mc.mutation.Review(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: moderation.FieldReview,
+		})
+		_node.Review = value
+	}
+	if value, ok := mc.mutation.MessageID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldMessageID,
+		})
+		_node.MessageID = value
+	}
+	if value, ok := mc.mutation.ConversationID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldConversationID,
+		})
+		_node.ConversationID = value
+	}
+	if value, ok := mc.mutation.ReviewerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldReviewerID,
+		})
+		_node.ReviewerID = value
+	}
+	if value, ok := mc.mutation.ReviewedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: moderation.FieldReviewedAt,
+		})
+		_node.ReviewedAt = &value
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: moderation.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := mc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderation.UserTable,
+			Columns: []string{moderation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Moderation.Create().
+//		SetStage(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationUpsert) {
+//			SetStage(v+v).
+//		}).
+//		Exec(ctx)
+func (mc *ModerationCreate) OnConflict(opts ...sql.ConflictOption) *ModerationUpsertOne {
+	mc.conflict = opts
+	return &ModerationUpsertOne{
+		create: mc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Moderation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mc *ModerationCreate) OnConflictColumns(columns ...string) *ModerationUpsertOne {
+	mc.conflict = append(mc.conflict, sql.ConflictColumns(columns...))
+	return &ModerationUpsertOne{
+		create: mc,
+	}
+}
+
+type (
+	// ModerationUpsertOne is the builder for "upsert"-ing
+	//  one Moderation node.
+	ModerationUpsertOne struct {
+		create *ModerationCreate
+	}
+
+	// ModerationUpsert is the "OnConflict" setter.
+	ModerationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStage sets the "stage" field.
+func (u *ModerationUpsert) SetStage(v moderation.Stage) *ModerationUpsert {
+	u.Set(moderation.FieldStage, v)
+	return u
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *ModerationUpsert) UpdateStage() *ModerationUpsert {
+	u.SetExcluded(moderation.FieldStage)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *ModerationUpsert) SetContent(v string) *ModerationUpsert {
+	u.Set(moderation.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ModerationUpsert) UpdateContent() *ModerationUpsert {
+	u.SetExcluded(moderation.FieldContent)
+	return u
+}
+
+// SetDecision sets the "decision" field.
+func (u *ModerationUpsert) SetDecision(v moderation.Decision) *ModerationUpsert {
+	u.Set(moderation.FieldDecision, v)
+	return u
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *ModerationUpsert) UpdateDecision() *ModerationUpsert {
+	u.SetExcluded(moderation.FieldDecision)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ModerationUpsert) SetReason(v string) *ModerationUpsert {
+	u.Set(moderation.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ModerationUpsert) UpdateReason() *ModerationUpsert {
+	u.SetExcluded(moderation.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ModerationUpsert) ClearReason() *ModerationUpsert {
+	u.SetNull(moderation.FieldReason)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *ModerationUpsert) SetProvider(v string) *ModerationUpsert {
+	u.Set(moderation.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ModerationUpsert) UpdateProvider() *ModerationUpsert {
+	u.SetExcluded(moderation.FieldProvider)
+	return u
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (u *ModerationUpsert) ClearProvider() *ModerationUpsert {
+	u.SetNull(moderation.FieldProvider)
+	return u
+}
+
+// SetReview sets the "review" field.
+func (u *ModerationUpsert) SetReview(v moderation.Review) *ModerationUpsert {
+	u.Set(moderation.FieldReview, v)
+	return u
+}
+
+// UpdateReview sets the "review" field to the value that was provided on create.
+func (u *ModerationUpsert) UpdateReview() *ModerationUpsert {
+	u.SetExcluded(moderation.FieldReview)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *ModerationUpsert) SetMessageID(v string) *ModerationUpsert {
+	u.Set(moderation.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *ModerationUpsert) UpdateMessageID() *ModerationUpsert {
+	u.SetExcluded(moderation.FieldMessageID)
+	return u
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (u *ModerationUpsert) ClearMessageID() *ModerationUpsert {
+	u.SetNull(moderation.FieldMessageID)
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ModerationUpsert) SetConversationID(v string) *ModerationUpsert {
+	u.Set(moderation.FieldConversationID, v)
+	return u
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ModerationUpsert) UpdateConversationID() *ModerationUpsert {
+	u.SetExcluded(moderation.FieldConversationID)
+	return u
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *ModerationUpsert) ClearConversationID() *ModerationUpsert {
+	u.SetNull(moderation.FieldConversationID)
+	return u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ModerationUpsert) SetReviewerID(v string) *ModerationUpsert {
+	u.Set(moderation.FieldReviewerID, v)
+	return u
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ModerationUpsert) UpdateReviewerID() *ModerationUpsert {
+	u.SetExcluded(moderation.FieldReviewerID)
+	return u
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *ModerationUpsert) ClearReviewerID() *ModerationUpsert {
+	u.SetNull(moderation.FieldReviewerID)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ModerationUpsert) SetReviewedAt(v time.Time) *ModerationUpsert {
+	u.Set(moderation.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ModerationUpsert) UpdateReviewedAt() *ModerationUpsert {
+	u.SetExcluded(moderation.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ModerationUpsert) ClearReviewedAt() *ModerationUpsert {
+	u.SetNull(moderation.FieldReviewedAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ModerationUpsert) SetCreatedAt(v time.Time) *ModerationUpsert {
+	u.Set(moderation.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ModerationUpsert) UpdateCreatedAt() *ModerationUpsert {
+	u.SetExcluded(moderation.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Moderation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModerationUpsertOne) UpdateNewValues() *ModerationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(moderation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Moderation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModerationUpsertOne) Ignore() *ModerationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationUpsertOne) DoNothing() *ModerationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationCreate.OnConflict
+// documentation for more info.
+func (u *ModerationUpsertOne) Update(set func(*ModerationUpsert)) *ModerationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStage sets the "stage" field.
+func (u *ModerationUpsertOne) SetStage(v moderation.Stage) *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *ModerationUpsertOne) UpdateStage() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ModerationUpsertOne) SetContent(v string) *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ModerationUpsertOne) UpdateContent() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetDecision sets the "decision" field.
+func (u *ModerationUpsertOne) SetDecision(v moderation.Decision) *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetDecision(v)
+	})
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *ModerationUpsertOne) UpdateDecision() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateDecision()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ModerationUpsertOne) SetReason(v string) *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ModerationUpsertOne) UpdateReason() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ModerationUpsertOne) ClearReason() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *ModerationUpsertOne) SetProvider(v string) *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ModerationUpsertOne) UpdateProvider() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (u *ModerationUpsertOne) ClearProvider() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearProvider()
+	})
+}
+
+// SetReview sets the "review" field.
+func (u *ModerationUpsertOne) SetReview(v moderation.Review) *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetReview(v)
+	})
+}
+
+// UpdateReview sets the "review" field to the value that was provided on create.
+func (u *ModerationUpsertOne) UpdateReview() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateReview()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *ModerationUpsertOne) SetMessageID(v string) *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *ModerationUpsertOne) UpdateMessageID() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (u *ModerationUpsertOne) ClearMessageID() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearMessageID()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ModerationUpsertOne) SetConversationID(v string) *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ModerationUpsertOne) UpdateConversationID() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *ModerationUpsertOne) ClearConversationID() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearConversationID()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ModerationUpsertOne) SetReviewerID(v string) *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ModerationUpsertOne) UpdateReviewerID() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *ModerationUpsertOne) ClearReviewerID() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ModerationUpsertOne) SetReviewedAt(v time.Time) *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ModerationUpsertOne) UpdateReviewedAt() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ModerationUpsertOne) ClearReviewedAt() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ModerationUpsertOne) SetCreatedAt(v time.Time) *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ModerationUpsertOne) UpdateCreatedAt() *ModerationUpsertOne {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModerationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModerationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ModerationCreateBulk is the builder for creating many Moderation entities in bulk.
+type ModerationCreateBulk struct {
+	config
+	builders []*ModerationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Moderation entities in the database.
+func (mcb *ModerationCreateBulk) Save(ctx context.Context) ([]*Moderation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Moderation, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *ModerationCreateBulk) SaveX(ctx context.Context) []*Moderation {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *ModerationCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *ModerationCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Moderation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationUpsert) {
+//			SetStage(v+v).
+//		}).
+//		Exec(ctx)
+func (mcb *ModerationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModerationUpsertBulk {
+	mcb.conflict = opts
+	return &ModerationUpsertBulk{
+		create: mcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Moderation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mcb *ModerationCreateBulk) OnConflictColumns(columns ...string) *ModerationUpsertBulk {
+	mcb.conflict = append(mcb.conflict, sql.ConflictColumns(columns...))
+	return &ModerationUpsertBulk{
+		create: mcb,
+	}
+}
+
+// ModerationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Moderation nodes.
+type ModerationUpsertBulk struct {
+	create *ModerationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Moderation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModerationUpsertBulk) UpdateNewValues() *ModerationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(moderation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Moderation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModerationUpsertBulk) Ignore() *ModerationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationUpsertBulk) DoNothing() *ModerationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModerationUpsertBulk) Update(set func(*ModerationUpsert)) *ModerationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStage sets the "stage" field.
+func (u *ModerationUpsertBulk) SetStage(v moderation.Stage) *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetStage(v)
+	})
+}
+
+// UpdateStage sets the "stage" field to the value that was provided on create.
+func (u *ModerationUpsertBulk) UpdateStage() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateStage()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ModerationUpsertBulk) SetContent(v string) *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ModerationUpsertBulk) UpdateContent() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetDecision sets the "decision" field.
+func (u *ModerationUpsertBulk) SetDecision(v moderation.Decision) *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetDecision(v)
+	})
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *ModerationUpsertBulk) UpdateDecision() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateDecision()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ModerationUpsertBulk) SetReason(v string) *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ModerationUpsertBulk) UpdateReason() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ModerationUpsertBulk) ClearReason() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *ModerationUpsertBulk) SetProvider(v string) *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ModerationUpsertBulk) UpdateProvider() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (u *ModerationUpsertBulk) ClearProvider() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearProvider()
+	})
+}
+
+// SetReview sets the "review" field.
+func (u *ModerationUpsertBulk) SetReview(v moderation.Review) *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetReview(v)
+	})
+}
+
+// UpdateReview sets the "review" field to the value that was provided on create.
+func (u *ModerationUpsertBulk) UpdateReview() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateReview()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *ModerationUpsertBulk) SetMessageID(v string) *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *ModerationUpsertBulk) UpdateMessageID() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (u *ModerationUpsertBulk) ClearMessageID() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearMessageID()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ModerationUpsertBulk) SetConversationID(v string) *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ModerationUpsertBulk) UpdateConversationID() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *ModerationUpsertBulk) ClearConversationID() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearConversationID()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ModerationUpsertBulk) SetReviewerID(v string) *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ModerationUpsertBulk) UpdateReviewerID() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *ModerationUpsertBulk) ClearReviewerID() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ModerationUpsertBulk) SetReviewedAt(v time.Time) *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ModerationUpsertBulk) UpdateReviewedAt() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ModerationUpsertBulk) ClearReviewedAt() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ModerationUpsertBulk) SetCreatedAt(v time.Time) *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ModerationUpsertBulk) UpdateCreatedAt() *ModerationUpsertBulk {
+	return u.Update(func(s *ModerationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ModerationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,919 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"community.threetenth.chatgpt/ent/moderation"
+	"community.threetenth.chatgpt/ent/predicate"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ModerationUpdate is the builder for updating Moderation entities.
+type ModerationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModerationMutation
+}
+
+// Where appends a list predicates to the ModerationUpdate builder.
+func (mu *ModerationUpdate) Where(ps ...predicate.Moderation) *ModerationUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetStage sets the "stage" field.
+func (mu *ModerationUpdate) SetStage(m moderation.Stage) *ModerationUpdate {
+	mu.mutation.SetStage(m)
+	return mu
+}
+
+// SetContent sets the "content" field.
+func (mu *ModerationUpdate) SetContent(s string) *ModerationUpdate {
+	mu.mutation.SetContent(s)
+	return mu
+}
+
+// SetDecision sets the "decision" field.
+func (mu *ModerationUpdate) SetDecision(m moderation.Decision) *ModerationUpdate {
+	mu.mutation.SetDecision(m)
+	return mu
+}
+
+// SetReason sets the "reason" field.
+func (mu *ModerationUpdate) SetReason(s string) *ModerationUpdate {
+	mu.mutation.SetReason(s)
+	return mu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mu *ModerationUpdate) SetNillableReason(s *string) *ModerationUpdate {
+	if s != nil {
+		mu.SetReason(*s)
+	}
+	return mu
+}
+
+// ClearReason clears the value of the "reason" field.
+func (mu *ModerationUpdate) ClearReason() *ModerationUpdate {
+	mu.mutation.ClearReason()
+	return mu
+}
+
+// SetProvider sets the "provider" field.
+func (mu *ModerationUpdate) SetProvider(s string) *ModerationUpdate {
+	mu.mutation.SetProvider(s)
+	return mu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (mu *ModerationUpdate) SetNillableProvider(s *string) *ModerationUpdate {
+	if s != nil {
+		mu.SetProvider(*s)
+	}
+	return mu
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (mu *ModerationUpdate) ClearProvider() *ModerationUpdate {
+	mu.mutation.ClearProvider()
+	return mu
+}
+
+// SetReview sets the "review" field.
+func (mu *ModerationUpdate) SetReview(m moderation.Review) *ModerationUpdate {
+	mu.mutation.SetReview(m)
+	return mu
+}
+
+// SetNillableReview sets the "review" field if the given value is not nil.
+func (mu *ModerationUpdate) SetNillableReview(m *moderation.Review) *ModerationUpdate {
+	if m != nil {
+		mu.SetReview(*m)
+	}
+	return mu
+}
+
+// SetMessageID sets the "message_id" field.
+func (mu *ModerationUpdate) SetMessageID(s string) *ModerationUpdate {
+	mu.mutation.SetMessageID(s)
+	return mu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (mu *ModerationUpdate) SetNillableMessageID(s *string) *ModerationUpdate {
+	if s != nil {
+		mu.SetMessageID(*s)
+	}
+	return mu
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (mu *ModerationUpdate) ClearMessageID() *ModerationUpdate {
+	mu.mutation.ClearMessageID()
+	return mu
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (mu *ModerationUpdate) SetConversationID(s string) *ModerationUpdate {
+	mu.mutation.SetConversationID(s)
+	return mu
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (mu *ModerationUpdate) SetNillableConversationID(s *string) *ModerationUpdate {
+	if s != nil {
+		mu.SetConversationID(*s)
+	}
+	return mu
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (mu *ModerationUpdate) ClearConversationID() *ModerationUpdate {
+	mu.mutation.ClearConversationID()
+	return mu
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (mu *ModerationUpdate) SetReviewerID(s string) *ModerationUpdate {
+	mu.mutation.SetReviewerID(s)
+	return mu
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (mu *ModerationUpdate) SetNillableReviewerID(s *string) *ModerationUpdate {
+	if s != nil {
+		mu.SetReviewerID(*s)
+	}
+	return mu
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (mu *ModerationUpdate) ClearReviewerID() *ModerationUpdate {
+	mu.mutation.ClearReviewerID()
+	return mu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (mu *ModerationUpdate) SetReviewedAt(t time.Time) *ModerationUpdate {
+	mu.mutation.SetReviewedAt(t)
+	return mu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (mu *ModerationUpdate) SetNillableReviewedAt(t *time.Time) *ModerationUpdate {
+	if t != nil {
+		mu.SetReviewedAt(*t)
+	}
+	return mu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (mu *ModerationUpdate) ClearReviewedAt() *ModerationUpdate {
+	mu.mutation.ClearReviewedAt()
+	return mu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (mu *ModerationUpdate) SetUserID(id string) *ModerationUpdate {
+	mu.mutation.SetUserID(id)
+	return mu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mu *ModerationUpdate) SetUser(u *User) *ModerationUpdate {
+	return mu.SetUserID(u.ID)
+}
+
+// Mutation returns the ModerationMutation object of the builder.
+func (mu *ModerationUpdate) Mutation() *ModerationMutation {
+	return mu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (mu *ModerationUpdate) ClearUser() *ModerationUpdate {
+	mu.mutation.ClearUser()
+	return mu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *ModerationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mu.hooks) == 0 {
+		if err = mu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = mu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ModerationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mu.check(); err != nil {
+				return 0, err
+			}
+			mu.mutation = mutation
+			affected, err = mu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mu.hooks) - 1; i >= 0; i-- {
+			if mu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *ModerationUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *ModerationUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *ModerationUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *ModerationUpdate) check() error {
+	if v, ok := mu.mutation.Stage(); ok {
+		if err := moderation.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "Moderation.stage": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Decision(); ok {
+		if err := moderation.DecisionValidator(v); err != nil {
+			return &ValidationError{Name: "decision", err: fmt.Errorf(`ent: validator failed for field "Moderation.decision": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Review(); ok {
+		if err := moderation.ReviewValidator(v); err != nil {
+			return &ValidationError{Name: "review", err: fmt.Errorf(`ent: validator failed for field "Moderation.review": %w`, err)}
+		}
+	}
+	if _, ok := mu.mutation.UserID(); mu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Moderation.user"`)
+	}
+	return nil
+}
+
+func (mu *ModerationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   moderation.Table,
+			Columns: moderation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: moderation.FieldID,
+			},
+		},
+	}
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.Stage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: moderation.FieldStage,
+		})
+	}
+	if value, ok := mu.mutation.Content(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldContent,
+		})
+	}
+	if value, ok := mu.mutation.Decision(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: moderation.FieldDecision,
+		})
+	}
+	if value, ok := mu.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldReason,
+		})
+	}
+	if mu.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: moderation.FieldReason,
+		})
+	}
+	if value, ok := mu.mutation.Provider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldProvider,
+		})
+	}
+	if mu.mutation.ProviderCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: moderation.FieldProvider,
+		})
+	}
+	if value, ok := mu.mutation.Review(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: moderation.FieldReview,
+		})
+	}
+	if value, ok := mu.mutation.MessageID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldMessageID,
+		})
+	}
+	if mu.mutation.MessageIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: moderation.FieldMessageID,
+		})
+	}
+	if value, ok := mu.mutation.ConversationID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldConversationID,
+		})
+	}
+	if mu.mutation.ConversationIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: moderation.FieldConversationID,
+		})
+	}
+	if value, ok := mu.mutation.ReviewerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldReviewerID,
+		})
+	}
+	if mu.mutation.ReviewerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: moderation.FieldReviewerID,
+		})
+	}
+	if value, ok := mu.mutation.ReviewedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: moderation.FieldReviewedAt,
+		})
+	}
+	if mu.mutation.ReviewedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: moderation.FieldReviewedAt,
+		})
+	}
+	if mu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderation.UserTable,
+			Columns: []string{moderation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderation.UserTable,
+			Columns: []string{moderation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ModerationUpdateOne is the builder for updating a single Moderation entity.
+type ModerationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModerationMutation
+}
+
+// SetStage sets the "stage" field.
+func (muo *ModerationUpdateOne) SetStage(m moderation.Stage) *ModerationUpdateOne {
+	muo.mutation.SetStage(m)
+	return muo
+}
+
+// SetContent sets the "content" field.
+func (muo *ModerationUpdateOne) SetContent(s string) *ModerationUpdateOne {
+	muo.mutation.SetContent(s)
+	return muo
+}
+
+// SetDecision sets the "decision" field.
+func (muo *ModerationUpdateOne) SetDecision(m moderation.Decision) *ModerationUpdateOne {
+	muo.mutation.SetDecision(m)
+	return muo
+}
+
+// SetReason sets the "reason" field.
+func (muo *ModerationUpdateOne) SetReason(s string) *ModerationUpdateOne {
+	muo.mutation.SetReason(s)
+	return muo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (muo *ModerationUpdateOne) SetNillableReason(s *string) *ModerationUpdateOne {
+	if s != nil {
+		muo.SetReason(*s)
+	}
+	return muo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (muo *ModerationUpdateOne) ClearReason() *ModerationUpdateOne {
+	muo.mutation.ClearReason()
+	return muo
+}
+
+// SetProvider sets the "provider" field.
+func (muo *ModerationUpdateOne) SetProvider(s string) *ModerationUpdateOne {
+	muo.mutation.SetProvider(s)
+	return muo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (muo *ModerationUpdateOne) SetNillableProvider(s *string) *ModerationUpdateOne {
+	if s != nil {
+		muo.SetProvider(*s)
+	}
+	return muo
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (muo *ModerationUpdateOne) ClearProvider() *ModerationUpdateOne {
+	muo.mutation.ClearProvider()
+	return muo
+}
+
+// SetReview sets the "review" field.
+func (muo *ModerationUpdateOne) SetReview(m moderation.Review) *ModerationUpdateOne {
+	muo.mutation.SetReview(m)
+	return muo
+}
+
+// SetNillableReview sets the "review" field if the given value is not nil.
+func (muo *ModerationUpdateOne) SetNillableReview(m *moderation.Review) *ModerationUpdateOne {
+	if m != nil {
+		muo.SetReview(*m)
+	}
+	return muo
+}
+
+// SetMessageID sets the "message_id" field.
+func (muo *ModerationUpdateOne) SetMessageID(s string) *ModerationUpdateOne {
+	muo.mutation.SetMessageID(s)
+	return muo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (muo *ModerationUpdateOne) SetNillableMessageID(s *string) *ModerationUpdateOne {
+	if s != nil {
+		muo.SetMessageID(*s)
+	}
+	return muo
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (muo *ModerationUpdateOne) ClearMessageID() *ModerationUpdateOne {
+	muo.mutation.ClearMessageID()
+	return muo
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (muo *ModerationUpdateOne) SetConversationID(s string) *ModerationUpdateOne {
+	muo.mutation.SetConversationID(s)
+	return muo
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (muo *ModerationUpdateOne) SetNillableConversationID(s *string) *ModerationUpdateOne {
+	if s != nil {
+		muo.SetConversationID(*s)
+	}
+	return muo
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (muo *ModerationUpdateOne) ClearConversationID() *ModerationUpdateOne {
+	muo.mutation.ClearConversationID()
+	return muo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (muo *ModerationUpdateOne) SetReviewerID(s string) *ModerationUpdateOne {
+	muo.mutation.SetReviewerID(s)
+	return muo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (muo *ModerationUpdateOne) SetNillableReviewerID(s *string) *ModerationUpdateOne {
+	if s != nil {
+		muo.SetReviewerID(*s)
+	}
+	return muo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (muo *ModerationUpdateOne) ClearReviewerID() *ModerationUpdateOne {
+	muo.mutation.ClearReviewerID()
+	return muo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (muo *ModerationUpdateOne) SetReviewedAt(t time.Time) *ModerationUpdateOne {
+	muo.mutation.SetReviewedAt(t)
+	return muo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (muo *ModerationUpdateOne) SetNillableReviewedAt(t *time.Time) *ModerationUpdateOne {
+	if t != nil {
+		muo.SetReviewedAt(*t)
+	}
+	return muo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (muo *ModerationUpdateOne) ClearReviewedAt() *ModerationUpdateOne {
+	muo.mutation.ClearReviewedAt()
+	return muo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (muo *ModerationUpdateOne) SetUserID(id string) *ModerationUpdateOne {
+	muo.mutation.SetUserID(id)
+	return muo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (muo *ModerationUpdateOne) SetUser(u *User) *ModerationUpdateOne {
+	return muo.SetUserID(u.ID)
+}
+
+// Mutation returns the ModerationMutation object of the builder.
+func (muo *ModerationUpdateOne) Mutation() *ModerationMutation {
+	return muo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (muo *ModerationUpdateOne) ClearUser() *ModerationUpdateOne {
+	muo.mutation.ClearUser()
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *ModerationUpdateOne) Select(field string, fields ...string) *ModerationUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Moderation entity.
+func (muo *ModerationUpdateOne) Save(ctx context.Context) (*Moderation, error) {
+	var (
+		err  error
+		node *Moderation
+	)
+	if len(muo.hooks) == 0 {
+		if err = muo.check(); err != nil {
+			return nil, err
+		}
+		node, err = muo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ModerationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = muo.check(); err != nil {
+				return nil, err
+			}
+			muo.mutation = mutation
+			node, err = muo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(muo.hooks) - 1; i >= 0; i-- {
+			if muo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = muo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, muo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *ModerationUpdateOne) SaveX(ctx context.Context) *Moderation {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *ModerationUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *ModerationUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *ModerationUpdateOne) check() error {
+	if v, ok := muo.mutation.Stage(); ok {
+		if err := moderation.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "Moderation.stage": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Decision(); ok {
+		if err := moderation.DecisionValidator(v); err != nil {
+			return &ValidationError{Name: "decision", err: fmt.Errorf(`ent: validator failed for field "Moderation.decision": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Review(); ok {
+		if err := moderation.ReviewValidator(v); err != nil {
+			return &ValidationError{Name: "review", err: fmt.Errorf(`ent: validator failed for field "Moderation.review": %w`, err)}
+		}
+	}
+	if _, ok := muo.mutation.UserID(); muo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Moderation.user"`)
+	}
+	return nil
+}
+
+func (muo *ModerationUpdateOne) sqlSave(ctx context.Context) (_node *Moderation, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   moderation.Table,
+			Columns: moderation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: moderation.FieldID,
+			},
+		},
+	}
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Moderation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderation.FieldID)
+		for _, f := range fields {
+			if !moderation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != moderation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.Stage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: moderation.FieldStage,
+		})
+	}
+	if value, ok := muo.mutation.Content(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldContent,
+		})
+	}
+	if value, ok := muo.mutation.Decision(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: moderation.FieldDecision,
+		})
+	}
+	if value, ok := muo.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldReason,
+		})
+	}
+	if muo.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: moderation.FieldReason,
+		})
+	}
+	if value, ok := muo.mutation.Provider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldProvider,
+		})
+	}
+	if muo.mutation.ProviderCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: moderation.FieldProvider,
+		})
+	}
+	if value, ok := muo.mutation.Review(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: moderation.FieldReview,
+		})
+	}
+	if value, ok := muo.mutation.MessageID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldMessageID,
+		})
+	}
+	if muo.mutation.MessageIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: moderation.FieldMessageID,
+		})
+	}
+	if value, ok := muo.mutation.ConversationID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldConversationID,
+		})
+	}
+	if muo.mutation.ConversationIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: moderation.FieldConversationID,
+		})
+	}
+	if value, ok := muo.mutation.ReviewerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: moderation.FieldReviewerID,
+		})
+	}
+	if muo.mutation.ReviewerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: moderation.FieldReviewerID,
+		})
+	}
+	if value, ok := muo.mutation.ReviewedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: moderation.FieldReviewedAt,
+		})
+	}
+	if muo.mutation.ReviewedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: moderation.FieldReviewedAt,
+		})
+	}
+	if muo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderation.UserTable,
+			Columns: []string{moderation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderation.UserTable,
+			Columns: []string{moderation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Moderation{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,217 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"community.threetenth.chatgpt/ent/report"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Report is the model entity for the Report schema.
+type Report struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TargetType holds the value of the "target_type" field.
+	TargetType report.TargetType `json:"target_type,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID string `json:"target_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason report.Reason `json:"reason,omitempty"`
+	// Detail holds the value of the "detail" field.
+	Detail string `json:"detail,omitempty"`
+	// Status holds the value of the "status" field.
+	Status report.Status `json:"status,omitempty"`
+	// HandlerID holds the value of the "handler_id" field.
+	HandlerID string `json:"handler_id,omitempty"`
+	// HandledAt holds the value of the "handled_at" field.
+	HandledAt *time.Time `json:"handled_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReportQuery when eager-loading is set.
+	Edges   ReportEdges `json:"edges"`
+	user_id *string
+}
+
+// ReportEdges holds the relations/edges for other nodes in the graph.
+type ReportEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Report) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldID:
+			values[i] = new(sql.NullInt64)
+		case report.FieldTargetType, report.FieldTargetID, report.FieldReason, report.FieldDetail, report.FieldStatus, report.FieldHandlerID:
+			values[i] = new(sql.NullString)
+		case report.FieldHandledAt, report.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case report.ForeignKeys[0]: // user_id
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Report", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Report fields.
+func (r *Report) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case report.FieldTargetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_type", values[i])
+			} else if value.Valid {
+				r.TargetType = report.TargetType(value.String)
+			}
+		case report.FieldTargetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				r.TargetID = value.String
+			}
+		case report.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				r.Reason = report.Reason(value.String)
+			}
+		case report.FieldDetail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detail", values[i])
+			} else if value.Valid {
+				r.Detail = value.String
+			}
+		case report.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = report.Status(value.String)
+			}
+		case report.FieldHandlerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handler_id", values[i])
+			} else if value.Valid {
+				r.HandlerID = value.String
+			}
+		case report.FieldHandledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field handled_at", values[i])
+			} else if value.Valid {
+				r.HandledAt = new(time.Time)
+				*r.HandledAt = value.Time
+			}
+		case report.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case report.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				r.user_id = new(string)
+				*r.user_id = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the Report entity.
+func (r *Report) QueryUser() *UserQuery {
+	return (&ReportClient{config: r.config}).QueryUser(r)
+}
+
+// Update returns a builder for updating this Report.
+// Note that you need to call Report.Unwrap() before calling this method if this Report
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Report) Update() *ReportUpdateOne {
+	return (&ReportClient{config: r.config}).UpdateOne(r)
+}
+
+// Unwrap unwraps the Report entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Report) Unwrap() *Report {
+	tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Report is not a transactional entity")
+	}
+	r.config.driver = tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Report) String() string {
+	var builder strings.Builder
+	builder.WriteString("Report(")
+	builder.WriteString(fmt.Sprintf("id=%v", r.ID))
+	builder.WriteString(", target_type=")
+	builder.WriteString(fmt.Sprintf("%v", r.TargetType))
+	builder.WriteString(", target_id=")
+	builder.WriteString(r.TargetID)
+	builder.WriteString(", reason=")
+	builder.WriteString(fmt.Sprintf("%v", r.Reason))
+	builder.WriteString(", detail=")
+	builder.WriteString(r.Detail)
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", handler_id=")
+	builder.WriteString(r.HandlerID)
+	if v := r.HandledAt; v != nil {
+		builder.WriteString(", handled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reports is a parsable slice of Report.
+type Reports []*Report
+
+func (r Reports) config(cfg config) {
+	for _i := range r {
+		r[_i].config = cfg
+	}
+}
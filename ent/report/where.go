@@ -0,0 +1,860 @@
+// Code generated by entc, DO NOT EDIT.
+
+package report
+
+import (
+	"time"
+
+	"community.threetenth.chatgpt/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTargetID), v))
+	})
+}
+
+// Detail applies equality check predicate on the "detail" field. It's identical to DetailEQ.
+func Detail(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDetail), v))
+	})
+}
+
+// HandlerID applies equality check predicate on the "handler_id" field. It's identical to HandlerIDEQ.
+func HandlerID(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandledAt applies equality check predicate on the "handled_at" field. It's identical to HandledAtEQ.
+func HandledAt(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHandledAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TargetTypeEQ applies the EQ predicate on the "target_type" field.
+func TargetTypeEQ(v TargetType) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTargetType), v))
+	})
+}
+
+// TargetTypeNEQ applies the NEQ predicate on the "target_type" field.
+func TargetTypeNEQ(v TargetType) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTargetType), v))
+	})
+}
+
+// TargetTypeIn applies the In predicate on the "target_type" field.
+func TargetTypeIn(vs ...TargetType) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTargetType), v...))
+	})
+}
+
+// TargetTypeNotIn applies the NotIn predicate on the "target_type" field.
+func TargetTypeNotIn(vs ...TargetType) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTargetType), v...))
+	})
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTargetID), v))
+	})
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTargetID), v))
+	})
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...string) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTargetID), v...))
+	})
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...string) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTargetID), v...))
+	})
+}
+
+// TargetIDGT applies the GT predicate on the "target_id" field.
+func TargetIDGT(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTargetID), v))
+	})
+}
+
+// TargetIDGTE applies the GTE predicate on the "target_id" field.
+func TargetIDGTE(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTargetID), v))
+	})
+}
+
+// TargetIDLT applies the LT predicate on the "target_id" field.
+func TargetIDLT(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTargetID), v))
+	})
+}
+
+// TargetIDLTE applies the LTE predicate on the "target_id" field.
+func TargetIDLTE(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTargetID), v))
+	})
+}
+
+// TargetIDContains applies the Contains predicate on the "target_id" field.
+func TargetIDContains(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTargetID), v))
+	})
+}
+
+// TargetIDHasPrefix applies the HasPrefix predicate on the "target_id" field.
+func TargetIDHasPrefix(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTargetID), v))
+	})
+}
+
+// TargetIDHasSuffix applies the HasSuffix predicate on the "target_id" field.
+func TargetIDHasSuffix(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTargetID), v))
+	})
+}
+
+// TargetIDEqualFold applies the EqualFold predicate on the "target_id" field.
+func TargetIDEqualFold(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTargetID), v))
+	})
+}
+
+// TargetIDContainsFold applies the ContainsFold predicate on the "target_id" field.
+func TargetIDContainsFold(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTargetID), v))
+	})
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v Reason) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v Reason) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...Reason) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReason), v...))
+	})
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...Reason) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReason), v...))
+	})
+}
+
+// DetailEQ applies the EQ predicate on the "detail" field.
+func DetailEQ(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDetail), v))
+	})
+}
+
+// DetailNEQ applies the NEQ predicate on the "detail" field.
+func DetailNEQ(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDetail), v))
+	})
+}
+
+// DetailIn applies the In predicate on the "detail" field.
+func DetailIn(vs ...string) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDetail), v...))
+	})
+}
+
+// DetailNotIn applies the NotIn predicate on the "detail" field.
+func DetailNotIn(vs ...string) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDetail), v...))
+	})
+}
+
+// DetailGT applies the GT predicate on the "detail" field.
+func DetailGT(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDetail), v))
+	})
+}
+
+// DetailGTE applies the GTE predicate on the "detail" field.
+func DetailGTE(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDetail), v))
+	})
+}
+
+// DetailLT applies the LT predicate on the "detail" field.
+func DetailLT(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDetail), v))
+	})
+}
+
+// DetailLTE applies the LTE predicate on the "detail" field.
+func DetailLTE(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDetail), v))
+	})
+}
+
+// DetailContains applies the Contains predicate on the "detail" field.
+func DetailContains(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDetail), v))
+	})
+}
+
+// DetailHasPrefix applies the HasPrefix predicate on the "detail" field.
+func DetailHasPrefix(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDetail), v))
+	})
+}
+
+// DetailHasSuffix applies the HasSuffix predicate on the "detail" field.
+func DetailHasSuffix(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDetail), v))
+	})
+}
+
+// DetailIsNil applies the IsNil predicate on the "detail" field.
+func DetailIsNil() predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDetail)))
+	})
+}
+
+// DetailNotNil applies the NotNil predicate on the "detail" field.
+func DetailNotNil() predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDetail)))
+	})
+}
+
+// DetailEqualFold applies the EqualFold predicate on the "detail" field.
+func DetailEqualFold(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDetail), v))
+	})
+}
+
+// DetailContainsFold applies the ContainsFold predicate on the "detail" field.
+func DetailContainsFold(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDetail), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// HandlerIDEQ applies the EQ predicate on the "handler_id" field.
+func HandlerIDEQ(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandlerIDNEQ applies the NEQ predicate on the "handler_id" field.
+func HandlerIDNEQ(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandlerIDIn applies the In predicate on the "handler_id" field.
+func HandlerIDIn(vs ...string) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHandlerID), v...))
+	})
+}
+
+// HandlerIDNotIn applies the NotIn predicate on the "handler_id" field.
+func HandlerIDNotIn(vs ...string) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHandlerID), v...))
+	})
+}
+
+// HandlerIDGT applies the GT predicate on the "handler_id" field.
+func HandlerIDGT(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandlerIDGTE applies the GTE predicate on the "handler_id" field.
+func HandlerIDGTE(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandlerIDLT applies the LT predicate on the "handler_id" field.
+func HandlerIDLT(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandlerIDLTE applies the LTE predicate on the "handler_id" field.
+func HandlerIDLTE(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandlerIDContains applies the Contains predicate on the "handler_id" field.
+func HandlerIDContains(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandlerIDHasPrefix applies the HasPrefix predicate on the "handler_id" field.
+func HandlerIDHasPrefix(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandlerIDHasSuffix applies the HasSuffix predicate on the "handler_id" field.
+func HandlerIDHasSuffix(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandlerIDIsNil applies the IsNil predicate on the "handler_id" field.
+func HandlerIDIsNil() predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldHandlerID)))
+	})
+}
+
+// HandlerIDNotNil applies the NotNil predicate on the "handler_id" field.
+func HandlerIDNotNil() predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldHandlerID)))
+	})
+}
+
+// HandlerIDEqualFold applies the EqualFold predicate on the "handler_id" field.
+func HandlerIDEqualFold(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandlerIDContainsFold applies the ContainsFold predicate on the "handler_id" field.
+func HandlerIDContainsFold(v string) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldHandlerID), v))
+	})
+}
+
+// HandledAtEQ applies the EQ predicate on the "handled_at" field.
+func HandledAtEQ(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHandledAt), v))
+	})
+}
+
+// HandledAtNEQ applies the NEQ predicate on the "handled_at" field.
+func HandledAtNEQ(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHandledAt), v))
+	})
+}
+
+// HandledAtIn applies the In predicate on the "handled_at" field.
+func HandledAtIn(vs ...time.Time) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHandledAt), v...))
+	})
+}
+
+// HandledAtNotIn applies the NotIn predicate on the "handled_at" field.
+func HandledAtNotIn(vs ...time.Time) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHandledAt), v...))
+	})
+}
+
+// HandledAtGT applies the GT predicate on the "handled_at" field.
+func HandledAtGT(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHandledAt), v))
+	})
+}
+
+// HandledAtGTE applies the GTE predicate on the "handled_at" field.
+func HandledAtGTE(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHandledAt), v))
+	})
+}
+
+// HandledAtLT applies the LT predicate on the "handled_at" field.
+func HandledAtLT(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHandledAt), v))
+	})
+}
+
+// HandledAtLTE applies the LTE predicate on the "handled_at" field.
+func HandledAtLTE(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHandledAt), v))
+	})
+}
+
+// HandledAtIsNil applies the IsNil predicate on the "handled_at" field.
+func HandledAtIsNil() predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldHandledAt)))
+	})
+}
+
+// HandledAtNotNil applies the NotNil predicate on the "handled_at" field.
+func HandledAtNotNil() predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldHandledAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Report {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Report(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Report) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Report) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Report) predicate.Report {
+	return predicate.Report(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
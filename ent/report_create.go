@@ -0,0 +1,1089 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"community.threetenth.chatgpt/ent/report"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReportCreate is the builder for creating a Report entity.
+type ReportCreate struct {
+	config
+	mutation *ReportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTargetType sets the "target_type" field.
+func (rc *ReportCreate) SetTargetType(rt report.TargetType) *ReportCreate {
+	rc.mutation.SetTargetType(rt)
+	return rc
+}
+
+// SetTargetID sets the "target_id" field.
+func (rc *ReportCreate) SetTargetID(s string) *ReportCreate {
+	rc.mutation.SetTargetID(s)
+	return rc
+}
+
+// SetReason sets the "reason" field.
+func (rc *ReportCreate) SetReason(r report.Reason) *ReportCreate {
+	rc.mutation.SetReason(r)
+	return rc
+}
+
+// SetDetail sets the "detail" field.
+func (rc *ReportCreate) SetDetail(s string) *ReportCreate {
+	rc.mutation.SetDetail(s)
+	return rc
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableDetail(s *string) *ReportCreate {
+	if s != nil {
+		rc.SetDetail(*s)
+	}
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReportCreate) SetStatus(r report.Status) *ReportCreate {
+	rc.mutation.SetStatus(r)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableStatus(r *report.Status) *ReportCreate {
+	if r != nil {
+		rc.SetStatus(*r)
+	}
+	return rc
+}
+
+// SetHandlerID sets the "handler_id" field.
+func (rc *ReportCreate) SetHandlerID(s string) *ReportCreate {
+	rc.mutation.SetHandlerID(s)
+	return rc
+}
+
+// SetNillableHandlerID sets the "handler_id" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableHandlerID(s *string) *ReportCreate {
+	if s != nil {
+		rc.SetHandlerID(*s)
+	}
+	return rc
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (rc *ReportCreate) SetHandledAt(t time.Time) *ReportCreate {
+	rc.mutation.SetHandledAt(t)
+	return rc
+}
+
+// SetNillableHandledAt sets the "handled_at" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableHandledAt(t *time.Time) *ReportCreate {
+	if t != nil {
+		rc.SetHandledAt(*t)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReportCreate) SetCreatedAt(t time.Time) *ReportCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableCreatedAt(t *time.Time) *ReportCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (rc *ReportCreate) SetUserID(id string) *ReportCreate {
+	rc.mutation.SetUserID(id)
+	return rc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rc *ReportCreate) SetUser(u *User) *ReportCreate {
+	return rc.SetUserID(u.ID)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (rc *ReportCreate) Mutation() *ReportMutation {
+	return rc.mutation
+}
+
+// Save creates the Report in the database.
+func (rc *ReportCreate) Save(ctx context.Context) (*Report, error) {
+	var (
+		err  error
+		node *Report
+	)
+	rc.defaults()
+	if len(rc.hooks) == 0 {
+		if err = rc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReportMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rc.check(); err != nil {
+				return nil, err
+			}
+			rc.mutation = mutation
+			if node, err = rc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rc.hooks) - 1; i >= 0; i-- {
+			if rc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReportCreate) SaveX(ctx context.Context) *Report {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReportCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReportCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReportCreate) defaults() {
+	if _, ok := rc.mutation.Status(); !ok {
+		v := report.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := report.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReportCreate) check() error {
+	if _, ok := rc.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "Report.target_type"`)}
+	}
+	if v, ok := rc.mutation.TargetType(); ok {
+		if err := report.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "Report.target_type": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "Report.target_id"`)}
+	}
+	if v, ok := rc.mutation.TargetID(); ok {
+		if err := report.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`ent: validator failed for field "Report.target_id": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Report.reason"`)}
+	}
+	if v, ok := rc.mutation.Reason(); ok {
+		if err := report.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Report.reason": %w`, err)}
+		}
+	}
+	if v, ok := rc.mutation.Detail(); ok {
+		if err := report.DetailValidator(v); err != nil {
+			return &ValidationError{Name: "detail", err: fmt.Errorf(`ent: validator failed for field "Report.detail": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Report.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Report.created_at"`)}
+	}
+	if _, ok := rc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Report.user"`)}
+	}
+	return nil
+}
+
+func (rc *ReportCreate) sqlSave(ctx context.Context) (*Report, error) {
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (rc *ReportCreate) createSpec() (*Report, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Report{config: rc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: report.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: report.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = rc.conflict
+	if value, ok := rc.mutation.TargetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: report.FieldTargetType,
+		})
+		_node.TargetType = value
+	}
+	if value, ok := rc.mutation.TargetID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: report.FieldTargetID,
+		})
+		_node.TargetID = value
+	}
+	if value, ok := rc.mutation.Reason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: report.FieldReason,
+		})
+		_node.Reason = value
+	}
+	if value, ok := rc.mutation.Detail(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: report.FieldDetail,
+		})
+		_node.Detail = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: report.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.HandlerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: report.FieldHandlerID,
+		})
+		_node.HandlerID = value
+	}
+	if value, ok := rc.mutation.HandledAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: report.FieldHandledAt,
+		})
+		_node.HandledAt = &value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: report.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := rc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.UserTable,
+			Columns: []string{report.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Report.Create().
+//		SetTargetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *ReportCreate) OnConflict(opts ...sql.ConflictOption) *ReportUpsertOne {
+	rc.conflict = opts
+	return &ReportUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *ReportCreate) OnConflictColumns(columns ...string) *ReportUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &ReportUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// ReportUpsertOne is the builder for "upsert"-ing
+	//  one Report node.
+	ReportUpsertOne struct {
+		create *ReportCreate
+	}
+
+	// ReportUpsert is the "OnConflict" setter.
+	ReportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTargetType sets the "target_type" field.
+func (u *ReportUpsert) SetTargetType(v report.TargetType) *ReportUpsert {
+	u.Set(report.FieldTargetType, v)
+	return u
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateTargetType() *ReportUpsert {
+	u.SetExcluded(report.FieldTargetType)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *ReportUpsert) SetTargetID(v string) *ReportUpsert {
+	u.Set(report.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateTargetID() *ReportUpsert {
+	u.SetExcluded(report.FieldTargetID)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ReportUpsert) SetReason(v report.Reason) *ReportUpsert {
+	u.Set(report.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateReason() *ReportUpsert {
+	u.SetExcluded(report.FieldReason)
+	return u
+}
+
+// SetDetail sets the "detail" field.
+func (u *ReportUpsert) SetDetail(v string) *ReportUpsert {
+	u.Set(report.FieldDetail, v)
+	return u
+}
+
+// UpdateDetail sets the "detail" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateDetail() *ReportUpsert {
+	u.SetExcluded(report.FieldDetail)
+	return u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (u *ReportUpsert) ClearDetail() *ReportUpsert {
+	u.SetNull(report.FieldDetail)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsert) SetStatus(v report.Status) *ReportUpsert {
+	u.Set(report.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateStatus() *ReportUpsert {
+	u.SetExcluded(report.FieldStatus)
+	return u
+}
+
+// SetHandlerID sets the "handler_id" field.
+func (u *ReportUpsert) SetHandlerID(v string) *ReportUpsert {
+	u.Set(report.FieldHandlerID, v)
+	return u
+}
+
+// UpdateHandlerID sets the "handler_id" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateHandlerID() *ReportUpsert {
+	u.SetExcluded(report.FieldHandlerID)
+	return u
+}
+
+// ClearHandlerID clears the value of the "handler_id" field.
+func (u *ReportUpsert) ClearHandlerID() *ReportUpsert {
+	u.SetNull(report.FieldHandlerID)
+	return u
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (u *ReportUpsert) SetHandledAt(v time.Time) *ReportUpsert {
+	u.Set(report.FieldHandledAt, v)
+	return u
+}
+
+// UpdateHandledAt sets the "handled_at" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateHandledAt() *ReportUpsert {
+	u.SetExcluded(report.FieldHandledAt)
+	return u
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (u *ReportUpsert) ClearHandledAt() *ReportUpsert {
+	u.SetNull(report.FieldHandledAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportUpsert) SetCreatedAt(v time.Time) *ReportUpsert {
+	u.Set(report.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateCreatedAt() *ReportUpsert {
+	u.SetExcluded(report.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ReportUpsertOne) UpdateNewValues() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.TargetType(); exists {
+			s.SetIgnore(report.FieldTargetType)
+		}
+		if _, exists := u.create.mutation.TargetID(); exists {
+			s.SetIgnore(report.FieldTargetID)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(report.FieldReason)
+		}
+		if _, exists := u.create.mutation.Detail(); exists {
+			s.SetIgnore(report.FieldDetail)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(report.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReportUpsertOne) Ignore() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportUpsertOne) DoNothing() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportCreate.OnConflict
+// documentation for more info.
+func (u *ReportUpsertOne) Update(set func(*ReportUpsert)) *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *ReportUpsertOne) SetTargetType(v report.TargetType) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateTargetType() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *ReportUpsertOne) SetTargetID(v string) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateTargetID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ReportUpsertOne) SetReason(v report.Reason) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateReason() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetDetail sets the "detail" field.
+func (u *ReportUpsertOne) SetDetail(v string) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetDetail(v)
+	})
+}
+
+// UpdateDetail sets the "detail" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateDetail() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateDetail()
+	})
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (u *ReportUpsertOne) ClearDetail() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearDetail()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsertOne) SetStatus(v report.Status) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateStatus() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHandlerID sets the "handler_id" field.
+func (u *ReportUpsertOne) SetHandlerID(v string) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetHandlerID(v)
+	})
+}
+
+// UpdateHandlerID sets the "handler_id" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateHandlerID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateHandlerID()
+	})
+}
+
+// ClearHandlerID clears the value of the "handler_id" field.
+func (u *ReportUpsertOne) ClearHandlerID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearHandlerID()
+	})
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (u *ReportUpsertOne) SetHandledAt(v time.Time) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetHandledAt(v)
+	})
+}
+
+// UpdateHandledAt sets the "handled_at" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateHandledAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateHandledAt()
+	})
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (u *ReportUpsertOne) ClearHandledAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearHandledAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportUpsertOne) SetCreatedAt(v time.Time) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateCreatedAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReportUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReportUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReportCreateBulk is the builder for creating many Report entities in bulk.
+type ReportCreateBulk struct {
+	config
+	builders []*ReportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Report entities in the database.
+func (rcb *ReportCreateBulk) Save(ctx context.Context) ([]*Report, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Report, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReportCreateBulk) SaveX(ctx context.Context) []*Report {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReportCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Report.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *ReportCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReportUpsertBulk {
+	rcb.conflict = opts
+	return &ReportUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *ReportCreateBulk) OnConflictColumns(columns ...string) *ReportUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &ReportUpsertBulk{
+		create: rcb,
+	}
+}
+
+// ReportUpsertBulk is the builder for "upsert"-ing
+// a bulk of Report nodes.
+type ReportUpsertBulk struct {
+	create *ReportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ReportUpsertBulk) UpdateNewValues() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.TargetType(); exists {
+				s.SetIgnore(report.FieldTargetType)
+			}
+			if _, exists := b.mutation.TargetID(); exists {
+				s.SetIgnore(report.FieldTargetID)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(report.FieldReason)
+			}
+			if _, exists := b.mutation.Detail(); exists {
+				s.SetIgnore(report.FieldDetail)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(report.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReportUpsertBulk) Ignore() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportUpsertBulk) DoNothing() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReportUpsertBulk) Update(set func(*ReportUpsert)) *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *ReportUpsertBulk) SetTargetType(v report.TargetType) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateTargetType() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *ReportUpsertBulk) SetTargetID(v string) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateTargetID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ReportUpsertBulk) SetReason(v report.Reason) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateReason() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetDetail sets the "detail" field.
+func (u *ReportUpsertBulk) SetDetail(v string) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetDetail(v)
+	})
+}
+
+// UpdateDetail sets the "detail" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateDetail() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateDetail()
+	})
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (u *ReportUpsertBulk) ClearDetail() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearDetail()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsertBulk) SetStatus(v report.Status) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateStatus() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetHandlerID sets the "handler_id" field.
+func (u *ReportUpsertBulk) SetHandlerID(v string) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetHandlerID(v)
+	})
+}
+
+// UpdateHandlerID sets the "handler_id" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateHandlerID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateHandlerID()
+	})
+}
+
+// ClearHandlerID clears the value of the "handler_id" field.
+func (u *ReportUpsertBulk) ClearHandlerID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearHandlerID()
+	})
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (u *ReportUpsertBulk) SetHandledAt(v time.Time) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetHandledAt(v)
+	})
+}
+
+// UpdateHandledAt sets the "handled_at" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateHandledAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateHandledAt()
+	})
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (u *ReportUpsertBulk) ClearHandledAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearHandledAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportUpsertBulk) SetCreatedAt(v time.Time) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateCreatedAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
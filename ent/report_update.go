@@ -0,0 +1,569 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"community.threetenth.chatgpt/ent/predicate"
+	"community.threetenth.chatgpt/ent/report"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReportUpdate is the builder for updating Report entities.
+type ReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReportMutation
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (ru *ReportUpdate) Where(ps ...predicate.Report) *ReportUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReportUpdate) SetStatus(r report.Status) *ReportUpdate {
+	ru.mutation.SetStatus(r)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableStatus(r *report.Status) *ReportUpdate {
+	if r != nil {
+		ru.SetStatus(*r)
+	}
+	return ru
+}
+
+// SetHandlerID sets the "handler_id" field.
+func (ru *ReportUpdate) SetHandlerID(s string) *ReportUpdate {
+	ru.mutation.SetHandlerID(s)
+	return ru
+}
+
+// SetNillableHandlerID sets the "handler_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableHandlerID(s *string) *ReportUpdate {
+	if s != nil {
+		ru.SetHandlerID(*s)
+	}
+	return ru
+}
+
+// ClearHandlerID clears the value of the "handler_id" field.
+func (ru *ReportUpdate) ClearHandlerID() *ReportUpdate {
+	ru.mutation.ClearHandlerID()
+	return ru
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (ru *ReportUpdate) SetHandledAt(t time.Time) *ReportUpdate {
+	ru.mutation.SetHandledAt(t)
+	return ru
+}
+
+// SetNillableHandledAt sets the "handled_at" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableHandledAt(t *time.Time) *ReportUpdate {
+	if t != nil {
+		ru.SetHandledAt(*t)
+	}
+	return ru
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (ru *ReportUpdate) ClearHandledAt() *ReportUpdate {
+	ru.mutation.ClearHandledAt()
+	return ru
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ru *ReportUpdate) SetUserID(id string) *ReportUpdate {
+	ru.mutation.SetUserID(id)
+	return ru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ru *ReportUpdate) SetUser(u *User) *ReportUpdate {
+	return ru.SetUserID(u.ID)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ru *ReportUpdate) Mutation() *ReportMutation {
+	return ru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ru *ReportUpdate) ClearUser() *ReportUpdate {
+	ru.mutation.ClearUser()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReportUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ru.hooks) == 0 {
+		if err = ru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReportMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ru.check(); err != nil {
+				return 0, err
+			}
+			ru.mutation = mutation
+			affected, err = ru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ru.hooks) - 1; i >= 0; i-- {
+			if ru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReportUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReportUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReportUpdate) check() error {
+	if v, ok := ru.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if _, ok := ru.mutation.UserID(); ru.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Report.user"`)
+	}
+	return nil
+}
+
+func (ru *ReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   report.Table,
+			Columns: report.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: report.FieldID,
+			},
+		},
+	}
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ru.mutation.DetailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: report.FieldDetail,
+		})
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: report.FieldStatus,
+		})
+	}
+	if value, ok := ru.mutation.HandlerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: report.FieldHandlerID,
+		})
+	}
+	if ru.mutation.HandlerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: report.FieldHandlerID,
+		})
+	}
+	if value, ok := ru.mutation.HandledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: report.FieldHandledAt,
+		})
+	}
+	if ru.mutation.HandledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: report.FieldHandledAt,
+		})
+	}
+	if ru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.UserTable,
+			Columns: []string{report.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.UserTable,
+			Columns: []string{report.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ReportUpdateOne is the builder for updating a single Report entity.
+type ReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReportMutation
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReportUpdateOne) SetStatus(r report.Status) *ReportUpdateOne {
+	ruo.mutation.SetStatus(r)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableStatus(r *report.Status) *ReportUpdateOne {
+	if r != nil {
+		ruo.SetStatus(*r)
+	}
+	return ruo
+}
+
+// SetHandlerID sets the "handler_id" field.
+func (ruo *ReportUpdateOne) SetHandlerID(s string) *ReportUpdateOne {
+	ruo.mutation.SetHandlerID(s)
+	return ruo
+}
+
+// SetNillableHandlerID sets the "handler_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableHandlerID(s *string) *ReportUpdateOne {
+	if s != nil {
+		ruo.SetHandlerID(*s)
+	}
+	return ruo
+}
+
+// ClearHandlerID clears the value of the "handler_id" field.
+func (ruo *ReportUpdateOne) ClearHandlerID() *ReportUpdateOne {
+	ruo.mutation.ClearHandlerID()
+	return ruo
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (ruo *ReportUpdateOne) SetHandledAt(t time.Time) *ReportUpdateOne {
+	ruo.mutation.SetHandledAt(t)
+	return ruo
+}
+
+// SetNillableHandledAt sets the "handled_at" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableHandledAt(t *time.Time) *ReportUpdateOne {
+	if t != nil {
+		ruo.SetHandledAt(*t)
+	}
+	return ruo
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (ruo *ReportUpdateOne) ClearHandledAt() *ReportUpdateOne {
+	ruo.mutation.ClearHandledAt()
+	return ruo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ruo *ReportUpdateOne) SetUserID(id string) *ReportUpdateOne {
+	ruo.mutation.SetUserID(id)
+	return ruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ruo *ReportUpdateOne) SetUser(u *User) *ReportUpdateOne {
+	return ruo.SetUserID(u.ID)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ruo *ReportUpdateOne) Mutation() *ReportMutation {
+	return ruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ruo *ReportUpdateOne) ClearUser() *ReportUpdateOne {
+	ruo.mutation.ClearUser()
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReportUpdateOne) Select(field string, fields ...string) *ReportUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Report entity.
+func (ruo *ReportUpdateOne) Save(ctx context.Context) (*Report, error) {
+	var (
+		err  error
+		node *Report
+	)
+	if len(ruo.hooks) == 0 {
+		if err = ruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReportMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ruo.check(); err != nil {
+				return nil, err
+			}
+			ruo.mutation = mutation
+			node, err = ruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ruo.hooks) - 1; i >= 0; i-- {
+			if ruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReportUpdateOne) SaveX(ctx context.Context) *Report {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReportUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReportUpdateOne) check() error {
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if _, ok := ruo.mutation.UserID(); ruo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Report.user"`)
+	}
+	return nil
+}
+
+func (ruo *ReportUpdateOne) sqlSave(ctx context.Context) (_node *Report, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   report.Table,
+			Columns: report.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: report.FieldID,
+			},
+		},
+	}
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Report.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, report.FieldID)
+		for _, f := range fields {
+			if !report.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != report.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ruo.mutation.DetailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: report.FieldDetail,
+		})
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: report.FieldStatus,
+		})
+	}
+	if value, ok := ruo.mutation.HandlerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: report.FieldHandlerID,
+		})
+	}
+	if ruo.mutation.HandlerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: report.FieldHandlerID,
+		})
+	}
+	if value, ok := ruo.mutation.HandledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: report.FieldHandledAt,
+		})
+	}
+	if ruo.mutation.HandledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: report.FieldHandledAt,
+		})
+	}
+	if ruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.UserTable,
+			Columns: []string{report.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.UserTable,
+			Columns: []string{report.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Report{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
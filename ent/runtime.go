@@ -0,0 +1,260 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"time"
+
+	"community.threetenth.chatgpt/ent/apikey"
+	"community.threetenth.chatgpt/ent/auditevent"
+	"community.threetenth.chatgpt/ent/conversation"
+	"community.threetenth.chatgpt/ent/credential"
+	"community.threetenth.chatgpt/ent/dataexport"
+	"community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/ent/moderation"
+	"community.threetenth.chatgpt/ent/report"
+	"community.threetenth.chatgpt/ent/schema"
+	"community.threetenth.chatgpt/ent/share"
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/ent/user"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[0].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = func() func(string) error {
+		validators := apikeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[7].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescAction is the schema descriptor for action field.
+	auditeventDescAction := auditeventFields[0].Descriptor()
+	// auditevent.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditevent.ActionValidator = auditeventDescAction.Validators[0].(func(string) error)
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[6].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	conversationFields := schema.Conversation{}.Fields()
+	_ = conversationFields
+	// conversationDescTitle is the schema descriptor for title field.
+	conversationDescTitle := conversationFields[2].Descriptor()
+	// conversation.DefaultTitle holds the default value on creation for the title field.
+	conversation.DefaultTitle = conversationDescTitle.Default.(string)
+	// conversation.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	conversation.TitleValidator = conversationDescTitle.Validators[0].(func(string) error)
+	// conversationDescCustomTitle is the schema descriptor for custom_title field.
+	conversationDescCustomTitle := conversationFields[3].Descriptor()
+	// conversation.DefaultCustomTitle holds the default value on creation for the custom_title field.
+	conversation.DefaultCustomTitle = conversationDescCustomTitle.Default.(bool)
+	// conversationDescMessageCount is the schema descriptor for message_count field.
+	conversationDescMessageCount := conversationFields[8].Descriptor()
+	// conversation.DefaultMessageCount holds the default value on creation for the message_count field.
+	conversation.DefaultMessageCount = conversationDescMessageCount.Default.(int)
+	// conversation.MessageCountValidator is a validator for the "message_count" field. It is called by the builders before save.
+	conversation.MessageCountValidator = conversationDescMessageCount.Validators[0].(func(int) error)
+	// conversationDescSummaryMessageCount is the schema descriptor for summary_message_count field.
+	conversationDescSummaryMessageCount := conversationFields[9].Descriptor()
+	// conversation.DefaultSummaryMessageCount holds the default value on creation for the summary_message_count field.
+	conversation.DefaultSummaryMessageCount = conversationDescSummaryMessageCount.Default.(int)
+	// conversation.SummaryMessageCountValidator is a validator for the "summary_message_count" field. It is called by the builders before save.
+	conversation.SummaryMessageCountValidator = conversationDescSummaryMessageCount.Validators[0].(func(int) error)
+	// conversationDescLastActivityAt is the schema descriptor for last_activity_at field.
+	conversationDescLastActivityAt := conversationFields[10].Descriptor()
+	// conversation.DefaultLastActivityAt holds the default value on creation for the last_activity_at field.
+	conversation.DefaultLastActivityAt = conversationDescLastActivityAt.Default.(func() time.Time)
+	// conversationDescCreatedAt is the schema descriptor for created_at field.
+	conversationDescCreatedAt := conversationFields[11].Descriptor()
+	// conversation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversation.DefaultCreatedAt = conversationDescCreatedAt.Default.(func() time.Time)
+	// conversationDescUpdatedAt is the schema descriptor for updated_at field.
+	conversationDescUpdatedAt := conversationFields[12].Descriptor()
+	// conversation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	conversation.DefaultUpdatedAt = conversationDescUpdatedAt.Default.(func() time.Time)
+	// conversation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	conversation.UpdateDefaultUpdatedAt = conversationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// conversationDescID is the schema descriptor for id field.
+	conversationDescID := conversationFields[0].Descriptor()
+	// conversation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	conversation.IDValidator = conversationDescID.Validators[0].(func(string) error)
+	credentialFields := schema.Credential{}.Fields()
+	_ = credentialFields
+	// credentialDescCreatedAt is the schema descriptor for created_at field.
+	credentialDescCreatedAt := credentialFields[5].Descriptor()
+	// credential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credential.DefaultCreatedAt = credentialDescCreatedAt.Default.(func() time.Time)
+	// credentialDescUpdatedAt is the schema descriptor for updated_at field.
+	credentialDescUpdatedAt := credentialFields[6].Descriptor()
+	// credential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	credential.DefaultUpdatedAt = credentialDescUpdatedAt.Default.(func() time.Time)
+	// credential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	credential.UpdateDefaultUpdatedAt = credentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	dataexportFields := schema.DataExport{}.Fields()
+	_ = dataexportFields
+	// dataexportDescSize is the schema descriptor for size field.
+	dataexportDescSize := dataexportFields[2].Descriptor()
+	// dataexport.DefaultSize holds the default value on creation for the size field.
+	dataexport.DefaultSize = dataexportDescSize.Default.(int64)
+	// dataexport.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	dataexport.SizeValidator = dataexportDescSize.Validators[0].(func(int64) error)
+	// dataexportDescCreatedAt is the schema descriptor for created_at field.
+	dataexportDescCreatedAt := dataexportFields[5].Descriptor()
+	// dataexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dataexport.DefaultCreatedAt = dataexportDescCreatedAt.Default.(func() time.Time)
+	// dataexportDescUpdatedAt is the schema descriptor for updated_at field.
+	dataexportDescUpdatedAt := dataexportFields[6].Descriptor()
+	// dataexport.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dataexport.DefaultUpdatedAt = dataexportDescUpdatedAt.Default.(func() time.Time)
+	// dataexport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dataexport.UpdateDefaultUpdatedAt = dataexportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// dataexportDescID is the schema descriptor for id field.
+	dataexportDescID := dataexportFields[0].Descriptor()
+	// dataexport.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	dataexport.IDValidator = dataexportDescID.Validators[0].(func(string) error)
+	messageFields := schema.Message{}.Fields()
+	_ = messageFields
+	// messageDescContent is the schema descriptor for content field.
+	messageDescContent := messageFields[1].Descriptor()
+	// message.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	message.ContentValidator = messageDescContent.Validators[0].(func(string) error)
+	// messageDescContentType is the schema descriptor for content_type field.
+	messageDescContentType := messageFields[2].Descriptor()
+	// message.DefaultContentType holds the default value on creation for the content_type field.
+	message.DefaultContentType = messageDescContentType.Default.(string)
+	// messageDescHidden is the schema descriptor for hidden field.
+	messageDescHidden := messageFields[6].Descriptor()
+	// message.DefaultHidden holds the default value on creation for the hidden field.
+	message.DefaultHidden = messageDescHidden.Default.(bool)
+	// messageDescCreatedAt is the schema descriptor for created_at field.
+	messageDescCreatedAt := messageFields[8].Descriptor()
+	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
+	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
+	// messageDescUpdatedAt is the schema descriptor for updated_at field.
+	messageDescUpdatedAt := messageFields[9].Descriptor()
+	// message.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	message.DefaultUpdatedAt = messageDescUpdatedAt.Default.(func() time.Time)
+	// message.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	message.UpdateDefaultUpdatedAt = messageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messageDescID is the schema descriptor for id field.
+	messageDescID := messageFields[0].Descriptor()
+	// message.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	message.IDValidator = messageDescID.Validators[0].(func(string) error)
+	moderationFields := schema.Moderation{}.Fields()
+	_ = moderationFields
+	// moderationDescCreatedAt is the schema descriptor for created_at field.
+	moderationDescCreatedAt := moderationFields[10].Descriptor()
+	// moderation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderation.DefaultCreatedAt = moderationDescCreatedAt.Default.(func() time.Time)
+	reportFields := schema.Report{}.Fields()
+	_ = reportFields
+	// reportDescTargetID is the schema descriptor for target_id field.
+	reportDescTargetID := reportFields[1].Descriptor()
+	// report.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	report.TargetIDValidator = reportDescTargetID.Validators[0].(func(string) error)
+	// reportDescDetail is the schema descriptor for detail field.
+	reportDescDetail := reportFields[3].Descriptor()
+	// report.DetailValidator is a validator for the "detail" field. It is called by the builders before save.
+	report.DetailValidator = reportDescDetail.Validators[0].(func(string) error)
+	// reportDescCreatedAt is the schema descriptor for created_at field.
+	reportDescCreatedAt := reportFields[7].Descriptor()
+	// report.DefaultCreatedAt holds the default value on creation for the created_at field.
+	report.DefaultCreatedAt = reportDescCreatedAt.Default.(func() time.Time)
+	shareFields := schema.Share{}.Fields()
+	_ = shareFields
+	// shareDescConversationID is the schema descriptor for conversation_id field.
+	shareDescConversationID := shareFields[1].Descriptor()
+	// share.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	share.ConversationIDValidator = shareDescConversationID.Validators[0].(func(string) error)
+	// shareDescCreatedAt is the schema descriptor for created_at field.
+	shareDescCreatedAt := shareFields[6].Descriptor()
+	// share.DefaultCreatedAt holds the default value on creation for the created_at field.
+	share.DefaultCreatedAt = shareDescCreatedAt.Default.(func() time.Time)
+	// shareDescID is the schema descriptor for id field.
+	shareDescID := shareFields[0].Descriptor()
+	// share.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	share.IDValidator = shareDescID.Validators[0].(func(string) error)
+	topicFields := schema.Topic{}.Fields()
+	_ = topicFields
+	// topicDescConversationID is the schema descriptor for conversation_id field.
+	topicDescConversationID := topicFields[1].Descriptor()
+	// topic.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	topic.ConversationIDValidator = topicDescConversationID.Validators[0].(func(string) error)
+	// topicDescTitle is the schema descriptor for title field.
+	topicDescTitle := topicFields[2].Descriptor()
+	// topic.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	topic.TitleValidator = topicDescTitle.Validators[0].(func(string) error)
+	// topicDescCategory is the schema descriptor for category field.
+	topicDescCategory := topicFields[4].Descriptor()
+	// topic.DefaultCategory holds the default value on creation for the category field.
+	topic.DefaultCategory = topicDescCategory.Default.(string)
+	// topic.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	topic.CategoryValidator = topicDescCategory.Validators[0].(func(string) error)
+	// topicDescHidden is the schema descriptor for hidden field.
+	topicDescHidden := topicFields[6].Descriptor()
+	// topic.DefaultHidden holds the default value on creation for the hidden field.
+	topic.DefaultHidden = topicDescHidden.Default.(bool)
+	// topicDescLocked is the schema descriptor for locked field.
+	topicDescLocked := topicFields[7].Descriptor()
+	// topic.DefaultLocked holds the default value on creation for the locked field.
+	topic.DefaultLocked = topicDescLocked.Default.(bool)
+	// topicDescCreatedAt is the schema descriptor for created_at field.
+	topicDescCreatedAt := topicFields[8].Descriptor()
+	// topic.DefaultCreatedAt holds the default value on creation for the created_at field.
+	topic.DefaultCreatedAt = topicDescCreatedAt.Default.(func() time.Time)
+	// topicDescUpdatedAt is the schema descriptor for updated_at field.
+	topicDescUpdatedAt := topicFields[9].Descriptor()
+	// topic.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	topic.DefaultUpdatedAt = topicDescUpdatedAt.Default.(func() time.Time)
+	// topic.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	topic.UpdateDefaultUpdatedAt = topicDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// topicDescID is the schema descriptor for id field.
+	topicDescID := topicFields[0].Descriptor()
+	// topic.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	topic.IDValidator = topicDescID.Validators[0].(func(string) error)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[2].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescSessionStamp is the schema descriptor for session_stamp field.
+	userDescSessionStamp := userFields[5].Descriptor()
+	// user.DefaultSessionStamp holds the default value on creation for the session_stamp field.
+	user.DefaultSessionStamp = userDescSessionStamp.Default.(func() string)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[15].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[16].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	user.IDValidator = userDescID.Validators[0].(func(string) error)
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+//
+// APIKey 是用户为脚本和机器人创建的个人 API key，只保存 key 的 SHA-256 哈希。
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().MaxLen(64),
+		field.String("prefix").
+			Immutable().
+			Comment("key 的开头部分，用于在列表中识别 key"),
+		field.String("hash").
+			Unique().
+			Immutable().
+			Sensitive(),
+		field.Strings("scopes").
+			Comment("key 可以使用的权限，同时受用户角色的限制"),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("expires_at").Optional().Nillable().Immutable(),
+		field.Time("revoked_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("api_keys").
+			Unique().
+			Required().
+			Comment("The owner of the api key").
+			StructTag(`json:"user,omitempty"`),
+	}
+}
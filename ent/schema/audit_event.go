@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+//
+// AuditEvent 是只追加的审计记录，所有字段创建后都不能修改，只有超过保留期限的记录会被清理。
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("action").NotEmpty().Immutable(),
+		field.String("target_type").Optional().Immutable(),
+		field.String("target_id").Optional().Immutable(),
+		field.String("ip").Optional().Immutable().Comment("gin 根据可信代理获取的客户端 IP"),
+		field.String("user_agent").Optional().Immutable(),
+		field.JSON("payload", map[string]interface{}{}).Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the AuditEvent.
+func (AuditEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("actor", User.Type).
+			Ref("audit_events").
+			Unique().
+			Comment("The user who performed the action").
+			StructTag(`json:"actor,omitempty"`),
+	}
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("action", "created_at"),
+		index.Fields("target_type", "target_id"),
+		index.Fields("created_at").Edges("actor"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Conversation holds the schema definition for the Conversation entity.
+//
+// Conversation 是用户和 ChatGPT 的一个会话，id 是论坛生成的会话 ID，
+// ChatGPT 中的会话 ID 保存在 upstream_id 中，继续提问和导入去重时使用。
+type Conversation struct {
+	ent.Schema
+}
+
+// Fields of the Conversation.
+func (Conversation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().NotEmpty().Immutable().StructTag(`json:"id"`),
+		field.String("upstream_id").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("ChatGPT 中的会话 ID，第一次回复后才知道，为空的会话不能继续提问"),
+		field.String("title").Default("").MaxLen(255),
+		field.Bool("custom_title").Default(false).Comment("标题由用户修改过，不再自动生成"),
+		field.String("model").Optional(),
+		field.Text("system_prompt").Optional().Comment("会话的系统提示，只保存在论坛中"),
+		field.Enum("visibility").
+			Values("private", "unlisted", "public").
+			Default("private").
+			Comment("与会话发布的主题的可见性保持一致，没有发布时为 private"),
+		field.Text("summary").Optional().Comment("自动生成的一段会话摘要，使用会话的语言"),
+		field.Int("message_count").Default(0).NonNegative(),
+		field.Int("summary_message_count").
+			Default(0).
+			NonNegative().
+			Comment("生成标题和摘要时会话的消息数量，消息数量增长后重新生成"),
+		field.Time("last_activity_at").Default(time.Now).Comment("最后一次提问或回复的时间"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("软删除的时间，被删除的会话只出现在回收站中，超过保留期限后彻底删除"),
+		field.String("deleted_by").Optional().Comment("删除者的用户 ID，作者只能恢复自己删除的内容"),
+	}
+}
+
+// Edges of the Conversation.
+func (Conversation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("conversations").
+			Unique().
+			Required().
+			Comment("The owner of the conversation").
+			StructTag(`json:"user,omitempty"`),
+		edge.To("messages", Message.Type).
+			StructTag(`json:"messages,omitempty"`),
+	}
+}
+
+// Indexes of the Conversation.
+func (Conversation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("last_activity_at").Edges("user"),
+		index.Fields("deleted_at"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Credential holds the schema definition for the Credential entity.
+//
+// Credential 是持久化保存的上游凭据，value 是 openai.Credential 加密后的密文，明文只在 openai 包中出现。
+// 用户的 sessionToken 和 accessToken 关联到用户，cf_clearance 是全站共用的，没有关联用户。
+type Credential struct {
+	ent.Schema
+}
+
+// Fields of the Credential.
+func (Credential) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			Values("session_token", "access_token", "cf_clearance").
+			Immutable(),
+		field.Text("value").Sensitive(),
+		field.String("key_id").Comment("加密 value 使用的密钥 ID，用于轮换密钥"),
+		field.String("user_agent").Optional().Comment("获取 cf_clearance 时使用的 User-Agent"),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Credential.
+func (Credential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("credentials").
+			Unique().
+			Comment("The owner of the credential, empty for site-wide credentials").
+			StructTag(`json:"user,omitempty"`),
+	}
+}
+
+// Indexes of the Credential.
+func (Credential) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind").Edges("user").Unique(),
+		index.Fields("key_id"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DataExport holds the schema definition for the DataExport entity.
+//
+// DataExport 是用户导出全部数据的一次任务，归档文件保存在服务端的导出目录中，以 id 命名。
+type DataExport struct {
+	ent.Schema
+}
+
+// Fields of the DataExport.
+func (DataExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().NotEmpty().Immutable().StructTag(`json:"id"`),
+		field.Enum("status").
+			Values("pending", "ready", "failed").
+			Default("pending").
+			Comment("pending 的导出正在后台生成归档"),
+		field.Int64("size").Default(0).NonNegative().Comment("归档文件的字节数"),
+		field.String("error").Optional().Comment("生成失败的原因"),
+		field.Time("expires_at").Comment("过期后删除归档文件和记录"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DataExport.
+func (DataExport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("data_exports").
+			Unique().
+			Required().
+			Comment("The user whose data is exported").
+			StructTag(`json:"user,omitempty"`),
+	}
+}
+
+// Indexes of the DataExport.
+func (DataExport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Message holds the schema definition for the Message entity.
@@ -22,14 +23,30 @@ func (Message) Fields() []ent.Field {
 		field.String("role"),
 		field.String("conversation_id").Optional(),
 		field.String("parent_message_id").Optional(),
+		field.Bool("hidden").Default(false).Comment("等待审核或被管理员隐藏的消息不会公开显示"),
+		field.Enum("status").
+			Values("pending", "streaming", "complete", "failed", "cancelled").
+			Default("complete").
+			Comment("提问所在这一轮的状态，pending 和 streaming 的提问还在等待回复"),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("软删除的时间，被删除的消息在会话树中显示为占位，超过保留期限后彻底删除"),
+		field.String("deleted_by").Optional().Comment("删除者的用户 ID，作者只能恢复自己删除的内容"),
 	}
 }
 
 // Edges of the Message.
 func (Message) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("conversation", Conversation.Type).
+			Ref("messages").
+			Field("conversation_id").
+			Unique().
+			Comment("The conversation of the message").
+			StructTag(`json:"conversation,omitempty"`),
 		edge.From("user", User.Type).
 			Ref("messages").
 			Unique().
@@ -38,3 +55,11 @@ func (Message) Edges() []ent.Edge {
 			StructTag(`json:"user,omitempty"`),
 	}
 }
+
+// Indexes of the Message.
+func (Message) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "updated_at"),
+		index.Fields("deleted_at"),
+	}
+}
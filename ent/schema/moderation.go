@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Moderation holds the schema definition for the Moderation entity.
+//
+// Moderation 记录每一次内容审核的结果，hold 的结果会进入管理员的审核队列。
+type Moderation struct {
+	ent.Schema
+}
+
+// Fields of the Moderation.
+func (Moderation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("stage").Values("prompt", "answer"),
+		field.Text("content"),
+		field.Enum("decision").Values("allow", "hold", "reject"),
+		field.String("reason").Optional(),
+		field.String("provider").Optional(),
+		field.Enum("review").
+			Values("none", "pending", "approved", "rejected").
+			Default("none").
+			Comment("管理员审核状态，只有 hold 的结果为 pending"),
+		field.String("message_id").Optional(),
+		field.String("conversation_id").Optional(),
+		field.String("reviewer_id").Optional(),
+		field.Time("reviewed_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Moderation.
+func (Moderation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("moderations").
+			Unique().
+			Required().
+			Comment("The author of the moderated content").
+			StructTag(`json:"user,omitempty"`),
+	}
+}
+
+// Indexes of the Moderation.
+func (Moderation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("review", "created_at"),
+	}
+}
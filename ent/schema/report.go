@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Report holds the schema definition for the Report entity.
+//
+// Report 是用户对消息或主题的举报。
+type Report struct {
+	ent.Schema
+}
+
+// Fields of the Report.
+func (Report) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("target_type").Values("message", "topic").Immutable(),
+		field.String("target_id").NotEmpty().Immutable(),
+		field.Enum("reason").
+			Values("spam", "abuse", "harassment", "sexual", "violence", "misinformation", "privacy", "other").
+			Immutable(),
+		field.String("detail").Optional().MaxLen(1000).Immutable(),
+		field.Enum("status").Values("open", "resolved", "dismissed").Default("open"),
+		field.String("handler_id").Optional(),
+		field.Time("handled_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Report.
+func (Report) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("reports").
+			Unique().
+			Required().
+			Comment("The reporter").
+			StructTag(`json:"user,omitempty"`),
+	}
+}
+
+// Indexes of the Report.
+func (Report) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+		index.Fields("target_type", "target_id"),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SnapshotMessage 是分享快照中保存的单条消息，创建后不再改变
+type SnapshotMessage struct {
+	ID              string    `json:"id"`
+	Role            string    `json:"role"`
+	Content         string    `json:"content"`
+	ContentType     string    `json:"content_type"`
+	ParentMessageID string    `json:"parent_message_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// Share holds the schema definition for the Share entity.
+//
+// Share 是会话的只读快照，id 即分享链接中的短 slug。
+type Share struct {
+	ent.Schema
+}
+
+// Fields of the Share.
+func (Share) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().NotEmpty().Immutable().StructTag(`json:"id"`),
+		field.String("conversation_id").NotEmpty().Immutable(),
+		field.String("title").Immutable(),
+		field.Text("summary").Optional().Immutable().Comment("创建分享时会话的摘要"),
+		field.JSON("messages", []*SnapshotMessage{}).Immutable(),
+		field.Time("revoked_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Share.
+func (Share) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("shares").
+			Unique().
+			Required().
+			Comment("The owner of the share").
+			StructTag(`json:"user,omitempty"`),
+	}
+}
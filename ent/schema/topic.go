@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"regexp"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// categoryRegexp 限制分类名称，分类会出现在 URL 中
+var categoryRegexp = regexp.MustCompile(`^[a-z0-9-]{1,32}$`)
+
+// Topic holds the schema definition for the Topic entity.
+//
+// Topic 是发布到论坛的会话，通过 conversation_id 关联 Message。
+type Topic struct {
+	ent.Schema
+}
+
+// Fields of the Topic.
+func (Topic) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().NotEmpty().StructTag(`json:"id"`),
+		field.String("conversation_id").Unique().NotEmpty(),
+		field.String("title").NotEmpty(),
+		field.Text("summary").Optional().Comment("会话自动生成的摘要，用于列表和 feed"),
+		field.String("category").Default("general").Match(categoryRegexp),
+		field.Enum("visibility").
+			Values("private", "unlisted", "public").
+			Default("private").
+			Comment("private 仅作者可见，unlisted 持有链接可见，public 公开并出现在列表中"),
+		field.Bool("hidden").Default(false).Comment("被管理员隐藏的主题不会公开显示"),
+		field.Bool("locked").Default(false).Comment("被锁定的主题不能继续提问"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("软删除的时间，被删除的主题不再公开显示，超过保留期限后彻底删除"),
+		field.String("deleted_by").Optional().Comment("删除者的用户 ID，作者只能恢复自己删除的内容"),
+	}
+}
+
+// Indexes of the Topic.
+func (Topic) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("visibility", "category", "updated_at"),
+		index.Fields("deleted_at"),
+	}
+}
+
+// Edges of the Topic.
+func (Topic) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("topics").
+			Unique().
+			Required().
+			Comment("The author of the topic").
+			StructTag(`json:"user,omitempty"`),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // User holds the schema definition for the User entity.
@@ -20,8 +21,38 @@ func (User) Fields() []ent.Field {
 		field.String("name").Optional(),
 		field.String("email").NotEmpty().StructTag(`updatedAt:"email"`),
 		field.String("image").Optional(),
+		field.String("password_hash").
+			Optional().
+			Sensitive().
+			Comment("本地账号的 bcrypt 密码哈希，只通过 ChatGPT 登录的用户为空"),
+		field.String("session_stamp").
+			DefaultFunc(uuid.NewString).
+			Sensitive().
+			Comment("签名会话令牌的随机值，重置密码、退出所有会话、封禁和修改角色时更换，之前签发的会话全部失效"),
+		field.Time("email_verified_at").Optional().Nillable(),
+		field.String("openai_id").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("关联的 ChatGPT 账号 ID，ChatGPT 会话是本地账号可选的上游凭据"),
+		field.String("oidc_id").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("通过 OpenID Connect 登录的用户在身份提供方中的 ID，格式为 issuer#sub"),
 		field.Strings("groups").Optional(),
 		field.Strings("features").Optional(),
+		field.Enum("role").
+			Values("member", "trusted", "moderator", "admin").
+			Optional().
+			Nillable().
+			Comment("论坛授予的角色，为空时使用配置的用户组映射，默认为 member"),
+		field.Time("banned_at").Optional().Nillable(),
+		field.String("ban_reason").Optional(),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("注销的时间，注销的账号不能登录，个人信息已经被清除"),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -33,5 +64,32 @@ func (User) Edges() []ent.Edge {
 		edge.To("messages", Message.Type).
 			StorageKey(edge.Column("user_id")).
 			StructTag(`json:"messages,omitempty"`),
+		edge.To("conversations", Conversation.Type).
+			StorageKey(edge.Column("user_id")).
+			StructTag(`json:"conversations,omitempty"`),
+		edge.To("topics", Topic.Type).
+			StorageKey(edge.Column("user_id")).
+			StructTag(`json:"topics,omitempty"`),
+		edge.To("shares", Share.Type).
+			StorageKey(edge.Column("user_id")).
+			StructTag(`json:"shares,omitempty"`),
+		edge.To("moderations", Moderation.Type).
+			StorageKey(edge.Column("user_id")).
+			StructTag(`json:"moderations,omitempty"`),
+		edge.To("reports", Report.Type).
+			StorageKey(edge.Column("user_id")).
+			StructTag(`json:"reports,omitempty"`),
+		edge.To("api_keys", APIKey.Type).
+			StorageKey(edge.Column("user_id")).
+			StructTag(`json:"api_keys,omitempty"`),
+		edge.To("credentials", Credential.Type).
+			StorageKey(edge.Column("user_id")).
+			StructTag(`json:"-"`),
+		edge.To("data_exports", DataExport.Type).
+			StorageKey(edge.Column("user_id")).
+			StructTag(`json:"-"`),
+		edge.To("audit_events", AuditEvent.Type).
+			StorageKey(edge.Column("actor_id")).
+			StructTag(`json:"audit_events,omitempty"`),
 	}
 }
@@ -0,0 +1,202 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"community.threetenth.chatgpt/ent/schema"
+	"community.threetenth.chatgpt/ent/share"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Share is the model entity for the Share schema.
+type Share struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID string `json:"conversation_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Summary holds the value of the "summary" field.
+	// 创建分享时会话的摘要
+	Summary string `json:"summary,omitempty"`
+	// Messages holds the value of the "messages" field.
+	Messages []*schema.SnapshotMessage `json:"messages,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShareQuery when eager-loading is set.
+	Edges   ShareEdges `json:"edges"`
+	user_id *string
+}
+
+// ShareEdges holds the relations/edges for other nodes in the graph.
+type ShareEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Share) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case share.FieldMessages:
+			values[i] = new([]byte)
+		case share.FieldID, share.FieldConversationID, share.FieldTitle, share.FieldSummary:
+			values[i] = new(sql.NullString)
+		case share.FieldRevokedAt, share.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case share.ForeignKeys[0]: // user_id
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Share", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Share fields.
+func (s *Share) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case share.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				s.ID = value.String
+			}
+		case share.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				s.ConversationID = value.String
+			}
+		case share.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				s.Title = value.String
+			}
+		case share.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				s.Summary = value.String
+			}
+		case share.FieldMessages:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field messages", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Messages); err != nil {
+					return fmt.Errorf("unmarshal field messages: %w", err)
+				}
+			}
+		case share.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				s.RevokedAt = new(time.Time)
+				*s.RevokedAt = value.Time
+			}
+		case share.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case share.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				s.user_id = new(string)
+				*s.user_id = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the Share entity.
+func (s *Share) QueryUser() *UserQuery {
+	return (&ShareClient{config: s.config}).QueryUser(s)
+}
+
+// Update returns a builder for updating this Share.
+// Note that you need to call Share.Unwrap() before calling this method if this Share
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Share) Update() *ShareUpdateOne {
+	return (&ShareClient{config: s.config}).UpdateOne(s)
+}
+
+// Unwrap unwraps the Share entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Share) Unwrap() *Share {
+	tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Share is not a transactional entity")
+	}
+	s.config.driver = tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Share) String() string {
+	var builder strings.Builder
+	builder.WriteString("Share(")
+	builder.WriteString(fmt.Sprintf("id=%v", s.ID))
+	builder.WriteString(", conversation_id=")
+	builder.WriteString(s.ConversationID)
+	builder.WriteString(", title=")
+	builder.WriteString(s.Title)
+	builder.WriteString(", summary=")
+	builder.WriteString(s.Summary)
+	builder.WriteString(", messages=")
+	builder.WriteString(fmt.Sprintf("%v", s.Messages))
+	if v := s.RevokedAt; v != nil {
+		builder.WriteString(", revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Shares is a parsable slice of Share.
+type Shares []*Share
+
+func (s Shares) config(cfg config) {
+	for _i := range s {
+		s[_i].config = cfg
+	}
+}
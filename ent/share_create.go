@@ -0,0 +1,954 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"community.threetenth.chatgpt/ent/schema"
+	"community.threetenth.chatgpt/ent/share"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShareCreate is the builder for creating a Share entity.
+type ShareCreate struct {
+	config
+	mutation *ShareMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (sc *ShareCreate) SetConversationID(s string) *ShareCreate {
+	sc.mutation.SetConversationID(s)
+	return sc
+}
+
+// SetTitle sets the "title" field.
+func (sc *ShareCreate) SetTitle(s string) *ShareCreate {
+	sc.mutation.SetTitle(s)
+	return sc
+}
+
+// SetSummary sets the "summary" field.
+func (sc *ShareCreate) SetSummary(s string) *ShareCreate {
+	sc.mutation.SetSummary(s)
+	return sc
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableSummary(s *string) *ShareCreate {
+	if s != nil {
+		sc.SetSummary(*s)
+	}
+	return sc
+}
+
+// SetMessages sets the "messages" field.
+func (sc *ShareCreate) SetMessages(sm []*schema.SnapshotMessage) *ShareCreate {
+	sc.mutation.SetMessages(sm)
+	return sc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (sc *ShareCreate) SetRevokedAt(t time.Time) *ShareCreate {
+	sc.mutation.SetRevokedAt(t)
+	return sc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableRevokedAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetRevokedAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ShareCreate) SetCreatedAt(t time.Time) *ShareCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableCreatedAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *ShareCreate) SetID(s string) *ShareCreate {
+	sc.mutation.SetID(s)
+	return sc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (sc *ShareCreate) SetUserID(id string) *ShareCreate {
+	sc.mutation.SetUserID(id)
+	return sc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sc *ShareCreate) SetUser(u *User) *ShareCreate {
+	return sc.SetUserID(u.ID)
+}
+
+// Mutation returns the ShareMutation object of the builder.
+func (sc *ShareCreate) Mutation() *ShareMutation {
+	return sc.mutation
+}
+
+// Save creates the Share in the database.
+func (sc *ShareCreate) Save(ctx context.Context) (*Share, error) {
+	var (
+		err  error
+		node *Share
+	)
+	sc.defaults()
+	if len(sc.hooks) == 0 {
+		if err = sc.check(); err != nil {
+			return nil, err
+		}
+		node, err = sc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sc.check(); err != nil {
+				return nil, err
+			}
+			sc.mutation = mutation
+			if node, err = sc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sc.hooks) - 1; i >= 0; i-- {
+			if sc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ShareCreate) SaveX(ctx context.Context) *Share {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ShareCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ShareCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ShareCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := share.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ShareCreate) check() error {
+	if _, ok := sc.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "Share.conversation_id"`)}
+	}
+	if v, ok := sc.mutation.ConversationID(); ok {
+		if err := share.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Share.conversation_id": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Share.title"`)}
+	}
+	if _, ok := sc.mutation.Messages(); !ok {
+		return &ValidationError{Name: "messages", err: errors.New(`ent: missing required field "Share.messages"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Share.created_at"`)}
+	}
+	if v, ok := sc.mutation.ID(); ok {
+		if err := share.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Share.id": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Share.user"`)}
+	}
+	return nil
+}
+
+func (sc *ShareCreate) sqlSave(ctx context.Context) (*Share, error) {
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Share.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (sc *ShareCreate) createSpec() (*Share, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Share{config: sc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: share.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: share.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = sc.conflict
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.ConversationID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: share.FieldConversationID,
+		})
+		_node.ConversationID = value
+	}
+	if value, ok := sc.mutation.Title(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: share.FieldTitle,
+		})
+		_node.Title = value
+	}
+	if value, ok := sc.mutation.Summary(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: share.FieldSummary,
+		})
+		_node.Summary = value
+	}
+	if value, ok := sc.mutation.Messages(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: share.FieldMessages,
+		})
+		_node.Messages = value
+	}
+	if value, ok := sc.mutation.RevokedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: share.FieldRevokedAt,
+		})
+		_node.RevokedAt = &value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: share.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.UserTable,
+			Columns: []string{share.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Share.Create().
+//		SetConversationID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShareUpsert) {
+//			SetConversationID(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *ShareCreate) OnConflict(opts ...sql.ConflictOption) *ShareUpsertOne {
+	sc.conflict = opts
+	return &ShareUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *ShareCreate) OnConflictColumns(columns ...string) *ShareUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &ShareUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// ShareUpsertOne is the builder for "upsert"-ing
+	//  one Share node.
+	ShareUpsertOne struct {
+		create *ShareCreate
+	}
+
+	// ShareUpsert is the "OnConflict" setter.
+	ShareUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ShareUpsert) SetConversationID(v string) *ShareUpsert {
+	u.Set(share.FieldConversationID, v)
+	return u
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateConversationID() *ShareUpsert {
+	u.SetExcluded(share.FieldConversationID)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ShareUpsert) SetTitle(v string) *ShareUpsert {
+	u.Set(share.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateTitle() *ShareUpsert {
+	u.SetExcluded(share.FieldTitle)
+	return u
+}
+
+// SetSummary sets the "summary" field.
+func (u *ShareUpsert) SetSummary(v string) *ShareUpsert {
+	u.Set(share.FieldSummary, v)
+	return u
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateSummary() *ShareUpsert {
+	u.SetExcluded(share.FieldSummary)
+	return u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *ShareUpsert) ClearSummary() *ShareUpsert {
+	u.SetNull(share.FieldSummary)
+	return u
+}
+
+// SetMessages sets the "messages" field.
+func (u *ShareUpsert) SetMessages(v []*schema.SnapshotMessage) *ShareUpsert {
+	u.Set(share.FieldMessages, v)
+	return u
+}
+
+// UpdateMessages sets the "messages" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateMessages() *ShareUpsert {
+	u.SetExcluded(share.FieldMessages)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *ShareUpsert) SetRevokedAt(v time.Time) *ShareUpsert {
+	u.Set(share.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateRevokedAt() *ShareUpsert {
+	u.SetExcluded(share.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *ShareUpsert) ClearRevokedAt() *ShareUpsert {
+	u.SetNull(share.FieldRevokedAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShareUpsert) SetCreatedAt(v time.Time) *ShareUpsert {
+	u.Set(share.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateCreatedAt() *ShareUpsert {
+	u.SetExcluded(share.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(share.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShareUpsertOne) UpdateNewValues() *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(share.FieldID)
+		}
+		if _, exists := u.create.mutation.ConversationID(); exists {
+			s.SetIgnore(share.FieldConversationID)
+		}
+		if _, exists := u.create.mutation.Title(); exists {
+			s.SetIgnore(share.FieldTitle)
+		}
+		if _, exists := u.create.mutation.Summary(); exists {
+			s.SetIgnore(share.FieldSummary)
+		}
+		if _, exists := u.create.mutation.Messages(); exists {
+			s.SetIgnore(share.FieldMessages)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(share.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ShareUpsertOne) Ignore() *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShareUpsertOne) DoNothing() *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShareCreate.OnConflict
+// documentation for more info.
+func (u *ShareUpsertOne) Update(set func(*ShareUpsert)) *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ShareUpsertOne) SetConversationID(v string) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateConversationID() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ShareUpsertOne) SetTitle(v string) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateTitle() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *ShareUpsertOne) SetSummary(v string) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateSummary() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *ShareUpsertOne) ClearSummary() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearSummary()
+	})
+}
+
+// SetMessages sets the "messages" field.
+func (u *ShareUpsertOne) SetMessages(v []*schema.SnapshotMessage) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetMessages(v)
+	})
+}
+
+// UpdateMessages sets the "messages" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateMessages() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateMessages()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *ShareUpsertOne) SetRevokedAt(v time.Time) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateRevokedAt() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *ShareUpsertOne) ClearRevokedAt() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShareUpsertOne) SetCreatedAt(v time.Time) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateCreatedAt() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ShareUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShareCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShareUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ShareUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ShareUpsertOne.ID is not supported by MySQL driver. Use ShareUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ShareUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ShareCreateBulk is the builder for creating many Share entities in bulk.
+type ShareCreateBulk struct {
+	config
+	builders []*ShareCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Share entities in the database.
+func (scb *ShareCreateBulk) Save(ctx context.Context) ([]*Share, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Share, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ShareCreateBulk) SaveX(ctx context.Context) []*Share {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ShareCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ShareCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Share.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShareUpsert) {
+//			SetConversationID(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *ShareCreateBulk) OnConflict(opts ...sql.ConflictOption) *ShareUpsertBulk {
+	scb.conflict = opts
+	return &ShareUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *ShareCreateBulk) OnConflictColumns(columns ...string) *ShareUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &ShareUpsertBulk{
+		create: scb,
+	}
+}
+
+// ShareUpsertBulk is the builder for "upsert"-ing
+// a bulk of Share nodes.
+type ShareUpsertBulk struct {
+	create *ShareCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(share.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShareUpsertBulk) UpdateNewValues() *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(share.FieldID)
+				return
+			}
+			if _, exists := b.mutation.ConversationID(); exists {
+				s.SetIgnore(share.FieldConversationID)
+			}
+			if _, exists := b.mutation.Title(); exists {
+				s.SetIgnore(share.FieldTitle)
+			}
+			if _, exists := b.mutation.Summary(); exists {
+				s.SetIgnore(share.FieldSummary)
+			}
+			if _, exists := b.mutation.Messages(); exists {
+				s.SetIgnore(share.FieldMessages)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(share.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ShareUpsertBulk) Ignore() *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShareUpsertBulk) DoNothing() *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShareCreateBulk.OnConflict
+// documentation for more info.
+func (u *ShareUpsertBulk) Update(set func(*ShareUpsert)) *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ShareUpsertBulk) SetConversationID(v string) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateConversationID() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ShareUpsertBulk) SetTitle(v string) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateTitle() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *ShareUpsertBulk) SetSummary(v string) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateSummary() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *ShareUpsertBulk) ClearSummary() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearSummary()
+	})
+}
+
+// SetMessages sets the "messages" field.
+func (u *ShareUpsertBulk) SetMessages(v []*schema.SnapshotMessage) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetMessages(v)
+	})
+}
+
+// UpdateMessages sets the "messages" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateMessages() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateMessages()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *ShareUpsertBulk) SetRevokedAt(v time.Time) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateRevokedAt() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *ShareUpsertBulk) ClearRevokedAt() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShareUpsertBulk) SetCreatedAt(v time.Time) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateCreatedAt() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ShareUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ShareCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShareCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShareUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
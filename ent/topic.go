@@ -0,0 +1,253 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Topic is the model entity for the Topic schema.
+type Topic struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID string `json:"conversation_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Summary holds the value of the "summary" field.
+	// 会话自动生成的摘要，用于列表和 feed
+	Summary string `json:"summary,omitempty"`
+	// Category holds the value of the "category" field.
+	Category string `json:"category,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	// private 仅作者可见，unlisted 持有链接可见，public 公开并出现在列表中
+	Visibility topic.Visibility `json:"visibility,omitempty"`
+	// Hidden holds the value of the "hidden" field.
+	// 被管理员隐藏的主题不会公开显示
+	Hidden bool `json:"hidden,omitempty"`
+	// Locked holds the value of the "locked" field.
+	// 被锁定的主题不能继续提问
+	Locked bool `json:"locked,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	// 软删除的时间，被删除的主题不再公开显示，超过保留期限后彻底删除
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	// 删除者的用户 ID，作者只能恢复自己删除的内容
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TopicQuery when eager-loading is set.
+	Edges   TopicEdges `json:"edges"`
+	user_id *string
+}
+
+// TopicEdges holds the relations/edges for other nodes in the graph.
+type TopicEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TopicEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Topic) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case topic.FieldHidden, topic.FieldLocked:
+			values[i] = new(sql.NullBool)
+		case topic.FieldID, topic.FieldConversationID, topic.FieldTitle, topic.FieldSummary, topic.FieldCategory, topic.FieldVisibility, topic.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case topic.FieldCreatedAt, topic.FieldUpdatedAt, topic.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case topic.ForeignKeys[0]: // user_id
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Topic", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Topic fields.
+func (t *Topic) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case topic.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				t.ID = value.String
+			}
+		case topic.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				t.ConversationID = value.String
+			}
+		case topic.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				t.Title = value.String
+			}
+		case topic.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				t.Summary = value.String
+			}
+		case topic.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				t.Category = value.String
+			}
+		case topic.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				t.Visibility = topic.Visibility(value.String)
+			}
+		case topic.FieldHidden:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hidden", values[i])
+			} else if value.Valid {
+				t.Hidden = value.Bool
+			}
+		case topic.FieldLocked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field locked", values[i])
+			} else if value.Valid {
+				t.Locked = value.Bool
+			}
+		case topic.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				t.CreatedAt = value.Time
+			}
+		case topic.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				t.UpdatedAt = value.Time
+			}
+		case topic.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				t.DeletedAt = new(time.Time)
+				*t.DeletedAt = value.Time
+			}
+		case topic.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				t.DeletedBy = value.String
+			}
+		case topic.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				t.user_id = new(string)
+				*t.user_id = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the Topic entity.
+func (t *Topic) QueryUser() *UserQuery {
+	return (&TopicClient{config: t.config}).QueryUser(t)
+}
+
+// Update returns a builder for updating this Topic.
+// Note that you need to call Topic.Unwrap() before calling this method if this Topic
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Topic) Update() *TopicUpdateOne {
+	return (&TopicClient{config: t.config}).UpdateOne(t)
+}
+
+// Unwrap unwraps the Topic entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Topic) Unwrap() *Topic {
+	tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Topic is not a transactional entity")
+	}
+	t.config.driver = tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Topic) String() string {
+	var builder strings.Builder
+	builder.WriteString("Topic(")
+	builder.WriteString(fmt.Sprintf("id=%v", t.ID))
+	builder.WriteString(", conversation_id=")
+	builder.WriteString(t.ConversationID)
+	builder.WriteString(", title=")
+	builder.WriteString(t.Title)
+	builder.WriteString(", summary=")
+	builder.WriteString(t.Summary)
+	builder.WriteString(", category=")
+	builder.WriteString(t.Category)
+	builder.WriteString(", visibility=")
+	builder.WriteString(fmt.Sprintf("%v", t.Visibility))
+	builder.WriteString(", hidden=")
+	builder.WriteString(fmt.Sprintf("%v", t.Hidden))
+	builder.WriteString(", locked=")
+	builder.WriteString(fmt.Sprintf("%v", t.Locked))
+	builder.WriteString(", created_at=")
+	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(t.UpdatedAt.Format(time.ANSIC))
+	if v := t.DeletedAt; v != nil {
+		builder.WriteString(", deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", deleted_by=")
+	builder.WriteString(t.DeletedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Topics is a parsable slice of Topic.
+type Topics []*Topic
+
+func (t Topics) config(cfg config) {
+	for _i := range t {
+		t[_i].config = cfg
+	}
+}
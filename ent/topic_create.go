@@ -0,0 +1,1308 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TopicCreate is the builder for creating a Topic entity.
+type TopicCreate struct {
+	config
+	mutation *TopicMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (tc *TopicCreate) SetConversationID(s string) *TopicCreate {
+	tc.mutation.SetConversationID(s)
+	return tc
+}
+
+// SetTitle sets the "title" field.
+func (tc *TopicCreate) SetTitle(s string) *TopicCreate {
+	tc.mutation.SetTitle(s)
+	return tc
+}
+
+// SetSummary sets the "summary" field.
+func (tc *TopicCreate) SetSummary(s string) *TopicCreate {
+	tc.mutation.SetSummary(s)
+	return tc
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (tc *TopicCreate) SetNillableSummary(s *string) *TopicCreate {
+	if s != nil {
+		tc.SetSummary(*s)
+	}
+	return tc
+}
+
+// SetCategory sets the "category" field.
+func (tc *TopicCreate) SetCategory(s string) *TopicCreate {
+	tc.mutation.SetCategory(s)
+	return tc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (tc *TopicCreate) SetNillableCategory(s *string) *TopicCreate {
+	if s != nil {
+		tc.SetCategory(*s)
+	}
+	return tc
+}
+
+// SetVisibility sets the "visibility" field.
+func (tc *TopicCreate) SetVisibility(t topic.Visibility) *TopicCreate {
+	tc.mutation.SetVisibility(t)
+	return tc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (tc *TopicCreate) SetNillableVisibility(t *topic.Visibility) *TopicCreate {
+	if t != nil {
+		tc.SetVisibility(*t)
+	}
+	return tc
+}
+
+// SetHidden sets the "hidden" field.
+func (tc *TopicCreate) SetHidden(b bool) *TopicCreate {
+	tc.mutation.SetHidden(b)
+	return tc
+}
+
+// SetNillableHidden sets the "hidden" field if the given value is not nil.
+func (tc *TopicCreate) SetNillableHidden(b *bool) *TopicCreate {
+	if b != nil {
+		tc.SetHidden(*b)
+	}
+	return tc
+}
+
+// SetLocked sets the "locked" field.
+func (tc *TopicCreate) SetLocked(b bool) *TopicCreate {
+	tc.mutation.SetLocked(b)
+	return tc
+}
+
+// SetNillableLocked sets the "locked" field if the given value is not nil.
+func (tc *TopicCreate) SetNillableLocked(b *bool) *TopicCreate {
+	if b != nil {
+		tc.SetLocked(*b)
+	}
+	return tc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tc *TopicCreate) SetCreatedAt(t time.Time) *TopicCreate {
+	tc.mutation.SetCreatedAt(t)
+	return tc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tc *TopicCreate) SetNillableCreatedAt(t *time.Time) *TopicCreate {
+	if t != nil {
+		tc.SetCreatedAt(*t)
+	}
+	return tc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tc *TopicCreate) SetUpdatedAt(t time.Time) *TopicCreate {
+	tc.mutation.SetUpdatedAt(t)
+	return tc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tc *TopicCreate) SetNillableUpdatedAt(t *time.Time) *TopicCreate {
+	if t != nil {
+		tc.SetUpdatedAt(*t)
+	}
+	return tc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tc *TopicCreate) SetDeletedAt(t time.Time) *TopicCreate {
+	tc.mutation.SetDeletedAt(t)
+	return tc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tc *TopicCreate) SetNillableDeletedAt(t *time.Time) *TopicCreate {
+	if t != nil {
+		tc.SetDeletedAt(*t)
+	}
+	return tc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (tc *TopicCreate) SetDeletedBy(s string) *TopicCreate {
+	tc.mutation.SetDeletedBy(s)
+	return tc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (tc *TopicCreate) SetNillableDeletedBy(s *string) *TopicCreate {
+	if s != nil {
+		tc.SetDeletedBy(*s)
+	}
+	return tc
+}
+
+// SetID sets the "id" field.
+func (tc *TopicCreate) SetID(s string) *TopicCreate {
+	tc.mutation.SetID(s)
+	return tc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (tc *TopicCreate) SetUserID(id string) *TopicCreate {
+	tc.mutation.SetUserID(id)
+	return tc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tc *TopicCreate) SetUser(u *User) *TopicCreate {
+	return tc.SetUserID(u.ID)
+}
+
+// Mutation returns the TopicMutation object of the builder.
+func (tc *TopicCreate) Mutation() *TopicMutation {
+	return tc.mutation
+}
+
+// Save creates the Topic in the database.
+func (tc *TopicCreate) Save(ctx context.Context) (*Topic, error) {
+	var (
+		err  error
+		node *Topic
+	)
+	tc.defaults()
+	if len(tc.hooks) == 0 {
+		if err = tc.check(); err != nil {
+			return nil, err
+		}
+		node, err = tc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TopicMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tc.check(); err != nil {
+				return nil, err
+			}
+			tc.mutation = mutation
+			if node, err = tc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tc.hooks) - 1; i >= 0; i-- {
+			if tc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TopicCreate) SaveX(ctx context.Context) *Topic {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TopicCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TopicCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TopicCreate) defaults() {
+	if _, ok := tc.mutation.Category(); !ok {
+		v := topic.DefaultCategory
+		tc.mutation.SetCategory(v)
+	}
+	if _, ok := tc.mutation.Visibility(); !ok {
+		v := topic.DefaultVisibility
+		tc.mutation.SetVisibility(v)
+	}
+	if _, ok := tc.mutation.Hidden(); !ok {
+		v := topic.DefaultHidden
+		tc.mutation.SetHidden(v)
+	}
+	if _, ok := tc.mutation.Locked(); !ok {
+		v := topic.DefaultLocked
+		tc.mutation.SetLocked(v)
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		v := topic.DefaultCreatedAt()
+		tc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tc.mutation.UpdatedAt(); !ok {
+		v := topic.DefaultUpdatedAt()
+		tc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TopicCreate) check() error {
+	if _, ok := tc.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "Topic.conversation_id"`)}
+	}
+	if v, ok := tc.mutation.ConversationID(); ok {
+		if err := topic.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Topic.conversation_id": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Topic.title"`)}
+	}
+	if v, ok := tc.mutation.Title(); ok {
+		if err := topic.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Topic.title": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "Topic.category"`)}
+	}
+	if v, ok := tc.mutation.Category(); ok {
+		if err := topic.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Topic.category": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Topic.visibility"`)}
+	}
+	if v, ok := tc.mutation.Visibility(); ok {
+		if err := topic.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Topic.visibility": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Hidden(); !ok {
+		return &ValidationError{Name: "hidden", err: errors.New(`ent: missing required field "Topic.hidden"`)}
+	}
+	if _, ok := tc.mutation.Locked(); !ok {
+		return &ValidationError{Name: "locked", err: errors.New(`ent: missing required field "Topic.locked"`)}
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Topic.created_at"`)}
+	}
+	if _, ok := tc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Topic.updated_at"`)}
+	}
+	if v, ok := tc.mutation.ID(); ok {
+		if err := topic.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Topic.id": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Topic.user"`)}
+	}
+	return nil
+}
+
+func (tc *TopicCreate) sqlSave(ctx context.Context) (*Topic, error) {
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Topic.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (tc *TopicCreate) createSpec() (*Topic, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Topic{config: tc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: topic.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: topic.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = tc.conflict
+	if id, ok := tc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tc.mutation.ConversationID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldConversationID,
+		})
+		_node.ConversationID = value
+	}
+	if value, ok := tc.mutation.Title(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldTitle,
+		})
+		_node.Title = value
+	}
+	if value, ok := tc.mutation.Summary(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldSummary,
+		})
+		_node.Summary = value
+	}
+	if value, ok := tc.mutation.Category(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldCategory,
+		})
+		_node.Category = value
+	}
+	if value, ok := tc.mutation.Visibility(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: topic.FieldVisibility,
+		})
+		_node.Visibility = value
+	}
+	if value, ok := tc.mutation.Hidden(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: topic.FieldHidden,
+		})
+		_node.Hidden = value
+	}
+	if value, ok := tc.mutation.Locked(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: topic.FieldLocked,
+		})
+		_node.Locked = value
+	}
+	if value, ok := tc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: topic.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := tc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: topic.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := tc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: topic.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
+	if value, ok := tc.mutation.DeletedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldDeletedBy,
+		})
+		_node.DeletedBy = value
+	}
+	if nodes := tc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   topic.UserTable,
+			Columns: []string{topic.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Topic.Create().
+//		SetConversationID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TopicUpsert) {
+//			SetConversationID(v+v).
+//		}).
+//		Exec(ctx)
+func (tc *TopicCreate) OnConflict(opts ...sql.ConflictOption) *TopicUpsertOne {
+	tc.conflict = opts
+	return &TopicUpsertOne{
+		create: tc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Topic.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tc *TopicCreate) OnConflictColumns(columns ...string) *TopicUpsertOne {
+	tc.conflict = append(tc.conflict, sql.ConflictColumns(columns...))
+	return &TopicUpsertOne{
+		create: tc,
+	}
+}
+
+type (
+	// TopicUpsertOne is the builder for "upsert"-ing
+	//  one Topic node.
+	TopicUpsertOne struct {
+		create *TopicCreate
+	}
+
+	// TopicUpsert is the "OnConflict" setter.
+	TopicUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConversationID sets the "conversation_id" field.
+func (u *TopicUpsert) SetConversationID(v string) *TopicUpsert {
+	u.Set(topic.FieldConversationID, v)
+	return u
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *TopicUpsert) UpdateConversationID() *TopicUpsert {
+	u.SetExcluded(topic.FieldConversationID)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *TopicUpsert) SetTitle(v string) *TopicUpsert {
+	u.Set(topic.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TopicUpsert) UpdateTitle() *TopicUpsert {
+	u.SetExcluded(topic.FieldTitle)
+	return u
+}
+
+// SetSummary sets the "summary" field.
+func (u *TopicUpsert) SetSummary(v string) *TopicUpsert {
+	u.Set(topic.FieldSummary, v)
+	return u
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *TopicUpsert) UpdateSummary() *TopicUpsert {
+	u.SetExcluded(topic.FieldSummary)
+	return u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *TopicUpsert) ClearSummary() *TopicUpsert {
+	u.SetNull(topic.FieldSummary)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *TopicUpsert) SetCategory(v string) *TopicUpsert {
+	u.Set(topic.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *TopicUpsert) UpdateCategory() *TopicUpsert {
+	u.SetExcluded(topic.FieldCategory)
+	return u
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *TopicUpsert) SetVisibility(v topic.Visibility) *TopicUpsert {
+	u.Set(topic.FieldVisibility, v)
+	return u
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *TopicUpsert) UpdateVisibility() *TopicUpsert {
+	u.SetExcluded(topic.FieldVisibility)
+	return u
+}
+
+// SetHidden sets the "hidden" field.
+func (u *TopicUpsert) SetHidden(v bool) *TopicUpsert {
+	u.Set(topic.FieldHidden, v)
+	return u
+}
+
+// UpdateHidden sets the "hidden" field to the value that was provided on create.
+func (u *TopicUpsert) UpdateHidden() *TopicUpsert {
+	u.SetExcluded(topic.FieldHidden)
+	return u
+}
+
+// SetLocked sets the "locked" field.
+func (u *TopicUpsert) SetLocked(v bool) *TopicUpsert {
+	u.Set(topic.FieldLocked, v)
+	return u
+}
+
+// UpdateLocked sets the "locked" field to the value that was provided on create.
+func (u *TopicUpsert) UpdateLocked() *TopicUpsert {
+	u.SetExcluded(topic.FieldLocked)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TopicUpsert) SetCreatedAt(v time.Time) *TopicUpsert {
+	u.Set(topic.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TopicUpsert) UpdateCreatedAt() *TopicUpsert {
+	u.SetExcluded(topic.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TopicUpsert) SetUpdatedAt(v time.Time) *TopicUpsert {
+	u.Set(topic.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TopicUpsert) UpdateUpdatedAt() *TopicUpsert {
+	u.SetExcluded(topic.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TopicUpsert) SetDeletedAt(v time.Time) *TopicUpsert {
+	u.Set(topic.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TopicUpsert) UpdateDeletedAt() *TopicUpsert {
+	u.SetExcluded(topic.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TopicUpsert) ClearDeletedAt() *TopicUpsert {
+	u.SetNull(topic.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TopicUpsert) SetDeletedBy(v string) *TopicUpsert {
+	u.Set(topic.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TopicUpsert) UpdateDeletedBy() *TopicUpsert {
+	u.SetExcluded(topic.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TopicUpsert) ClearDeletedBy() *TopicUpsert {
+	u.SetNull(topic.FieldDeletedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Topic.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(topic.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TopicUpsertOne) UpdateNewValues() *TopicUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(topic.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Topic.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TopicUpsertOne) Ignore() *TopicUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TopicUpsertOne) DoNothing() *TopicUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TopicCreate.OnConflict
+// documentation for more info.
+func (u *TopicUpsertOne) Update(set func(*TopicUpsert)) *TopicUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TopicUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *TopicUpsertOne) SetConversationID(v string) *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *TopicUpsertOne) UpdateConversationID() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *TopicUpsertOne) SetTitle(v string) *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TopicUpsertOne) UpdateTitle() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *TopicUpsertOne) SetSummary(v string) *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *TopicUpsertOne) UpdateSummary() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *TopicUpsertOne) ClearSummary() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.ClearSummary()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *TopicUpsertOne) SetCategory(v string) *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *TopicUpsertOne) UpdateCategory() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *TopicUpsertOne) SetVisibility(v topic.Visibility) *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *TopicUpsertOne) UpdateVisibility() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
+// SetHidden sets the "hidden" field.
+func (u *TopicUpsertOne) SetHidden(v bool) *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetHidden(v)
+	})
+}
+
+// UpdateHidden sets the "hidden" field to the value that was provided on create.
+func (u *TopicUpsertOne) UpdateHidden() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateHidden()
+	})
+}
+
+// SetLocked sets the "locked" field.
+func (u *TopicUpsertOne) SetLocked(v bool) *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetLocked(v)
+	})
+}
+
+// UpdateLocked sets the "locked" field to the value that was provided on create.
+func (u *TopicUpsertOne) UpdateLocked() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateLocked()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TopicUpsertOne) SetCreatedAt(v time.Time) *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TopicUpsertOne) UpdateCreatedAt() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TopicUpsertOne) SetUpdatedAt(v time.Time) *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TopicUpsertOne) UpdateUpdatedAt() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TopicUpsertOne) SetDeletedAt(v time.Time) *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TopicUpsertOne) UpdateDeletedAt() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TopicUpsertOne) ClearDeletedAt() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TopicUpsertOne) SetDeletedBy(v string) *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TopicUpsertOne) UpdateDeletedBy() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TopicUpsertOne) ClearDeletedBy() *TopicUpsertOne {
+	return u.Update(func(s *TopicUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *TopicUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TopicCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TopicUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TopicUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TopicUpsertOne.ID is not supported by MySQL driver. Use TopicUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TopicUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TopicCreateBulk is the builder for creating many Topic entities in bulk.
+type TopicCreateBulk struct {
+	config
+	builders []*TopicCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Topic entities in the database.
+func (tcb *TopicCreateBulk) Save(ctx context.Context) ([]*Topic, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Topic, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TopicMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TopicCreateBulk) SaveX(ctx context.Context) []*Topic {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TopicCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TopicCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Topic.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TopicUpsert) {
+//			SetConversationID(v+v).
+//		}).
+//		Exec(ctx)
+func (tcb *TopicCreateBulk) OnConflict(opts ...sql.ConflictOption) *TopicUpsertBulk {
+	tcb.conflict = opts
+	return &TopicUpsertBulk{
+		create: tcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Topic.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcb *TopicCreateBulk) OnConflictColumns(columns ...string) *TopicUpsertBulk {
+	tcb.conflict = append(tcb.conflict, sql.ConflictColumns(columns...))
+	return &TopicUpsertBulk{
+		create: tcb,
+	}
+}
+
+// TopicUpsertBulk is the builder for "upsert"-ing
+// a bulk of Topic nodes.
+type TopicUpsertBulk struct {
+	create *TopicCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Topic.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(topic.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TopicUpsertBulk) UpdateNewValues() *TopicUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(topic.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Topic.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TopicUpsertBulk) Ignore() *TopicUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TopicUpsertBulk) DoNothing() *TopicUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TopicCreateBulk.OnConflict
+// documentation for more info.
+func (u *TopicUpsertBulk) Update(set func(*TopicUpsert)) *TopicUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TopicUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *TopicUpsertBulk) SetConversationID(v string) *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *TopicUpsertBulk) UpdateConversationID() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *TopicUpsertBulk) SetTitle(v string) *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TopicUpsertBulk) UpdateTitle() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *TopicUpsertBulk) SetSummary(v string) *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *TopicUpsertBulk) UpdateSummary() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (u *TopicUpsertBulk) ClearSummary() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.ClearSummary()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *TopicUpsertBulk) SetCategory(v string) *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *TopicUpsertBulk) UpdateCategory() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *TopicUpsertBulk) SetVisibility(v topic.Visibility) *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *TopicUpsertBulk) UpdateVisibility() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
+// SetHidden sets the "hidden" field.
+func (u *TopicUpsertBulk) SetHidden(v bool) *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetHidden(v)
+	})
+}
+
+// UpdateHidden sets the "hidden" field to the value that was provided on create.
+func (u *TopicUpsertBulk) UpdateHidden() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateHidden()
+	})
+}
+
+// SetLocked sets the "locked" field.
+func (u *TopicUpsertBulk) SetLocked(v bool) *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetLocked(v)
+	})
+}
+
+// UpdateLocked sets the "locked" field to the value that was provided on create.
+func (u *TopicUpsertBulk) UpdateLocked() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateLocked()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TopicUpsertBulk) SetCreatedAt(v time.Time) *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TopicUpsertBulk) UpdateCreatedAt() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TopicUpsertBulk) SetUpdatedAt(v time.Time) *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TopicUpsertBulk) UpdateUpdatedAt() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TopicUpsertBulk) SetDeletedAt(v time.Time) *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TopicUpsertBulk) UpdateDeletedAt() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TopicUpsertBulk) ClearDeletedAt() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TopicUpsertBulk) SetDeletedBy(v string) *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TopicUpsertBulk) UpdateDeletedBy() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TopicUpsertBulk) ClearDeletedBy() *TopicUpsertBulk {
+	return u.Update(func(s *TopicUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *TopicUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TopicCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TopicCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TopicUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
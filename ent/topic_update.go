@@ -0,0 +1,917 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"community.threetenth.chatgpt/ent/predicate"
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TopicUpdate is the builder for updating Topic entities.
+type TopicUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TopicMutation
+}
+
+// Where appends a list predicates to the TopicUpdate builder.
+func (tu *TopicUpdate) Where(ps ...predicate.Topic) *TopicUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (tu *TopicUpdate) SetConversationID(s string) *TopicUpdate {
+	tu.mutation.SetConversationID(s)
+	return tu
+}
+
+// SetTitle sets the "title" field.
+func (tu *TopicUpdate) SetTitle(s string) *TopicUpdate {
+	tu.mutation.SetTitle(s)
+	return tu
+}
+
+// SetSummary sets the "summary" field.
+func (tu *TopicUpdate) SetSummary(s string) *TopicUpdate {
+	tu.mutation.SetSummary(s)
+	return tu
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (tu *TopicUpdate) SetNillableSummary(s *string) *TopicUpdate {
+	if s != nil {
+		tu.SetSummary(*s)
+	}
+	return tu
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (tu *TopicUpdate) ClearSummary() *TopicUpdate {
+	tu.mutation.ClearSummary()
+	return tu
+}
+
+// SetCategory sets the "category" field.
+func (tu *TopicUpdate) SetCategory(s string) *TopicUpdate {
+	tu.mutation.SetCategory(s)
+	return tu
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (tu *TopicUpdate) SetNillableCategory(s *string) *TopicUpdate {
+	if s != nil {
+		tu.SetCategory(*s)
+	}
+	return tu
+}
+
+// SetVisibility sets the "visibility" field.
+func (tu *TopicUpdate) SetVisibility(t topic.Visibility) *TopicUpdate {
+	tu.mutation.SetVisibility(t)
+	return tu
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (tu *TopicUpdate) SetNillableVisibility(t *topic.Visibility) *TopicUpdate {
+	if t != nil {
+		tu.SetVisibility(*t)
+	}
+	return tu
+}
+
+// SetHidden sets the "hidden" field.
+func (tu *TopicUpdate) SetHidden(b bool) *TopicUpdate {
+	tu.mutation.SetHidden(b)
+	return tu
+}
+
+// SetNillableHidden sets the "hidden" field if the given value is not nil.
+func (tu *TopicUpdate) SetNillableHidden(b *bool) *TopicUpdate {
+	if b != nil {
+		tu.SetHidden(*b)
+	}
+	return tu
+}
+
+// SetLocked sets the "locked" field.
+func (tu *TopicUpdate) SetLocked(b bool) *TopicUpdate {
+	tu.mutation.SetLocked(b)
+	return tu
+}
+
+// SetNillableLocked sets the "locked" field if the given value is not nil.
+func (tu *TopicUpdate) SetNillableLocked(b *bool) *TopicUpdate {
+	if b != nil {
+		tu.SetLocked(*b)
+	}
+	return tu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tu *TopicUpdate) SetCreatedAt(t time.Time) *TopicUpdate {
+	tu.mutation.SetCreatedAt(t)
+	return tu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tu *TopicUpdate) SetNillableCreatedAt(t *time.Time) *TopicUpdate {
+	if t != nil {
+		tu.SetCreatedAt(*t)
+	}
+	return tu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tu *TopicUpdate) SetUpdatedAt(t time.Time) *TopicUpdate {
+	tu.mutation.SetUpdatedAt(t)
+	return tu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tu *TopicUpdate) SetDeletedAt(t time.Time) *TopicUpdate {
+	tu.mutation.SetDeletedAt(t)
+	return tu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tu *TopicUpdate) SetNillableDeletedAt(t *time.Time) *TopicUpdate {
+	if t != nil {
+		tu.SetDeletedAt(*t)
+	}
+	return tu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tu *TopicUpdate) ClearDeletedAt() *TopicUpdate {
+	tu.mutation.ClearDeletedAt()
+	return tu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (tu *TopicUpdate) SetDeletedBy(s string) *TopicUpdate {
+	tu.mutation.SetDeletedBy(s)
+	return tu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (tu *TopicUpdate) SetNillableDeletedBy(s *string) *TopicUpdate {
+	if s != nil {
+		tu.SetDeletedBy(*s)
+	}
+	return tu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (tu *TopicUpdate) ClearDeletedBy() *TopicUpdate {
+	tu.mutation.ClearDeletedBy()
+	return tu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (tu *TopicUpdate) SetUserID(id string) *TopicUpdate {
+	tu.mutation.SetUserID(id)
+	return tu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tu *TopicUpdate) SetUser(u *User) *TopicUpdate {
+	return tu.SetUserID(u.ID)
+}
+
+// Mutation returns the TopicMutation object of the builder.
+func (tu *TopicUpdate) Mutation() *TopicMutation {
+	return tu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (tu *TopicUpdate) ClearUser() *TopicUpdate {
+	tu.mutation.ClearUser()
+	return tu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TopicUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	tu.defaults()
+	if len(tu.hooks) == 0 {
+		if err = tu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = tu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TopicMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tu.check(); err != nil {
+				return 0, err
+			}
+			tu.mutation = mutation
+			affected, err = tu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tu.hooks) - 1; i >= 0; i-- {
+			if tu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TopicUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TopicUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TopicUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tu *TopicUpdate) defaults() {
+	if _, ok := tu.mutation.UpdatedAt(); !ok {
+		v := topic.UpdateDefaultUpdatedAt()
+		tu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TopicUpdate) check() error {
+	if v, ok := tu.mutation.ConversationID(); ok {
+		if err := topic.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Topic.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Title(); ok {
+		if err := topic.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Topic.title": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Category(); ok {
+		if err := topic.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Topic.category": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Visibility(); ok {
+		if err := topic.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Topic.visibility": %w`, err)}
+		}
+	}
+	if _, ok := tu.mutation.UserID(); tu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Topic.user"`)
+	}
+	return nil
+}
+
+func (tu *TopicUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   topic.Table,
+			Columns: topic.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: topic.FieldID,
+			},
+		},
+	}
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.ConversationID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldConversationID,
+		})
+	}
+	if value, ok := tu.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldTitle,
+		})
+	}
+	if value, ok := tu.mutation.Summary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldSummary,
+		})
+	}
+	if tu.mutation.SummaryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: topic.FieldSummary,
+		})
+	}
+	if value, ok := tu.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldCategory,
+		})
+	}
+	if value, ok := tu.mutation.Visibility(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: topic.FieldVisibility,
+		})
+	}
+	if value, ok := tu.mutation.Hidden(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: topic.FieldHidden,
+		})
+	}
+	if value, ok := tu.mutation.Locked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: topic.FieldLocked,
+		})
+	}
+	if value, ok := tu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: topic.FieldCreatedAt,
+		})
+	}
+	if value, ok := tu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: topic.FieldUpdatedAt,
+		})
+	}
+	if value, ok := tu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: topic.FieldDeletedAt,
+		})
+	}
+	if tu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: topic.FieldDeletedAt,
+		})
+	}
+	if value, ok := tu.mutation.DeletedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldDeletedBy,
+		})
+	}
+	if tu.mutation.DeletedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: topic.FieldDeletedBy,
+		})
+	}
+	if tu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   topic.UserTable,
+			Columns: []string{topic.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   topic.UserTable,
+			Columns: []string{topic.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{topic.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TopicUpdateOne is the builder for updating a single Topic entity.
+type TopicUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TopicMutation
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (tuo *TopicUpdateOne) SetConversationID(s string) *TopicUpdateOne {
+	tuo.mutation.SetConversationID(s)
+	return tuo
+}
+
+// SetTitle sets the "title" field.
+func (tuo *TopicUpdateOne) SetTitle(s string) *TopicUpdateOne {
+	tuo.mutation.SetTitle(s)
+	return tuo
+}
+
+// SetSummary sets the "summary" field.
+func (tuo *TopicUpdateOne) SetSummary(s string) *TopicUpdateOne {
+	tuo.mutation.SetSummary(s)
+	return tuo
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (tuo *TopicUpdateOne) SetNillableSummary(s *string) *TopicUpdateOne {
+	if s != nil {
+		tuo.SetSummary(*s)
+	}
+	return tuo
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (tuo *TopicUpdateOne) ClearSummary() *TopicUpdateOne {
+	tuo.mutation.ClearSummary()
+	return tuo
+}
+
+// SetCategory sets the "category" field.
+func (tuo *TopicUpdateOne) SetCategory(s string) *TopicUpdateOne {
+	tuo.mutation.SetCategory(s)
+	return tuo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (tuo *TopicUpdateOne) SetNillableCategory(s *string) *TopicUpdateOne {
+	if s != nil {
+		tuo.SetCategory(*s)
+	}
+	return tuo
+}
+
+// SetVisibility sets the "visibility" field.
+func (tuo *TopicUpdateOne) SetVisibility(t topic.Visibility) *TopicUpdateOne {
+	tuo.mutation.SetVisibility(t)
+	return tuo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (tuo *TopicUpdateOne) SetNillableVisibility(t *topic.Visibility) *TopicUpdateOne {
+	if t != nil {
+		tuo.SetVisibility(*t)
+	}
+	return tuo
+}
+
+// SetHidden sets the "hidden" field.
+func (tuo *TopicUpdateOne) SetHidden(b bool) *TopicUpdateOne {
+	tuo.mutation.SetHidden(b)
+	return tuo
+}
+
+// SetNillableHidden sets the "hidden" field if the given value is not nil.
+func (tuo *TopicUpdateOne) SetNillableHidden(b *bool) *TopicUpdateOne {
+	if b != nil {
+		tuo.SetHidden(*b)
+	}
+	return tuo
+}
+
+// SetLocked sets the "locked" field.
+func (tuo *TopicUpdateOne) SetLocked(b bool) *TopicUpdateOne {
+	tuo.mutation.SetLocked(b)
+	return tuo
+}
+
+// SetNillableLocked sets the "locked" field if the given value is not nil.
+func (tuo *TopicUpdateOne) SetNillableLocked(b *bool) *TopicUpdateOne {
+	if b != nil {
+		tuo.SetLocked(*b)
+	}
+	return tuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tuo *TopicUpdateOne) SetCreatedAt(t time.Time) *TopicUpdateOne {
+	tuo.mutation.SetCreatedAt(t)
+	return tuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tuo *TopicUpdateOne) SetNillableCreatedAt(t *time.Time) *TopicUpdateOne {
+	if t != nil {
+		tuo.SetCreatedAt(*t)
+	}
+	return tuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tuo *TopicUpdateOne) SetUpdatedAt(t time.Time) *TopicUpdateOne {
+	tuo.mutation.SetUpdatedAt(t)
+	return tuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tuo *TopicUpdateOne) SetDeletedAt(t time.Time) *TopicUpdateOne {
+	tuo.mutation.SetDeletedAt(t)
+	return tuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tuo *TopicUpdateOne) SetNillableDeletedAt(t *time.Time) *TopicUpdateOne {
+	if t != nil {
+		tuo.SetDeletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tuo *TopicUpdateOne) ClearDeletedAt() *TopicUpdateOne {
+	tuo.mutation.ClearDeletedAt()
+	return tuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (tuo *TopicUpdateOne) SetDeletedBy(s string) *TopicUpdateOne {
+	tuo.mutation.SetDeletedBy(s)
+	return tuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (tuo *TopicUpdateOne) SetNillableDeletedBy(s *string) *TopicUpdateOne {
+	if s != nil {
+		tuo.SetDeletedBy(*s)
+	}
+	return tuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (tuo *TopicUpdateOne) ClearDeletedBy() *TopicUpdateOne {
+	tuo.mutation.ClearDeletedBy()
+	return tuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (tuo *TopicUpdateOne) SetUserID(id string) *TopicUpdateOne {
+	tuo.mutation.SetUserID(id)
+	return tuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tuo *TopicUpdateOne) SetUser(u *User) *TopicUpdateOne {
+	return tuo.SetUserID(u.ID)
+}
+
+// Mutation returns the TopicMutation object of the builder.
+func (tuo *TopicUpdateOne) Mutation() *TopicMutation {
+	return tuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (tuo *TopicUpdateOne) ClearUser() *TopicUpdateOne {
+	tuo.mutation.ClearUser()
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TopicUpdateOne) Select(field string, fields ...string) *TopicUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Topic entity.
+func (tuo *TopicUpdateOne) Save(ctx context.Context) (*Topic, error) {
+	var (
+		err  error
+		node *Topic
+	)
+	tuo.defaults()
+	if len(tuo.hooks) == 0 {
+		if err = tuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = tuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TopicMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tuo.check(); err != nil {
+				return nil, err
+			}
+			tuo.mutation = mutation
+			node, err = tuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tuo.hooks) - 1; i >= 0; i-- {
+			if tuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TopicUpdateOne) SaveX(ctx context.Context) *Topic {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TopicUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TopicUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tuo *TopicUpdateOne) defaults() {
+	if _, ok := tuo.mutation.UpdatedAt(); !ok {
+		v := topic.UpdateDefaultUpdatedAt()
+		tuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TopicUpdateOne) check() error {
+	if v, ok := tuo.mutation.ConversationID(); ok {
+		if err := topic.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Topic.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Title(); ok {
+		if err := topic.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Topic.title": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Category(); ok {
+		if err := topic.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Topic.category": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Visibility(); ok {
+		if err := topic.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Topic.visibility": %w`, err)}
+		}
+	}
+	if _, ok := tuo.mutation.UserID(); tuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Topic.user"`)
+	}
+	return nil
+}
+
+func (tuo *TopicUpdateOne) sqlSave(ctx context.Context) (_node *Topic, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   topic.Table,
+			Columns: topic.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: topic.FieldID,
+			},
+		},
+	}
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Topic.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, topic.FieldID)
+		for _, f := range fields {
+			if !topic.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != topic.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.ConversationID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldConversationID,
+		})
+	}
+	if value, ok := tuo.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldTitle,
+		})
+	}
+	if value, ok := tuo.mutation.Summary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldSummary,
+		})
+	}
+	if tuo.mutation.SummaryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: topic.FieldSummary,
+		})
+	}
+	if value, ok := tuo.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldCategory,
+		})
+	}
+	if value, ok := tuo.mutation.Visibility(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: topic.FieldVisibility,
+		})
+	}
+	if value, ok := tuo.mutation.Hidden(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: topic.FieldHidden,
+		})
+	}
+	if value, ok := tuo.mutation.Locked(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: topic.FieldLocked,
+		})
+	}
+	if value, ok := tuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: topic.FieldCreatedAt,
+		})
+	}
+	if value, ok := tuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: topic.FieldUpdatedAt,
+		})
+	}
+	if value, ok := tuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: topic.FieldDeletedAt,
+		})
+	}
+	if tuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: topic.FieldDeletedAt,
+		})
+	}
+	if value, ok := tuo.mutation.DeletedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: topic.FieldDeletedBy,
+		})
+	}
+	if tuo.mutation.DeletedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: topic.FieldDeletedBy,
+		})
+	}
+	if tuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   topic.UserTable,
+			Columns: []string{topic.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   topic.UserTable,
+			Columns: []string{topic.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Topic{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{topic.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,449 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"community.threetenth.chatgpt/ent/user"
+	"entgo.io/ent/dialect/sql"
+)
+
+// User is the model entity for the User schema.
+type User struct {
+	config `json:"-" updatedAt:"-"`
+	// ID of the ent.
+	ID string `json:"id"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty" updatedAt:"email"`
+	// Image holds the value of the "image" field.
+	Image string `json:"image,omitempty"`
+	// PasswordHash holds the value of the "password_hash" field.
+	// 本地账号的 bcrypt 密码哈希，只通过 ChatGPT 登录的用户为空
+	PasswordHash string `json:"-" updatedAt:"-"`
+	// SessionStamp holds the value of the "session_stamp" field.
+	// 签名会话令牌的随机值，重置密码、退出所有会话、封禁和修改角色时更换，之前签发的会话全部失效
+	SessionStamp string `json:"-" updatedAt:"-"`
+	// EmailVerifiedAt holds the value of the "email_verified_at" field.
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// OpenaiID holds the value of the "openai_id" field.
+	// 关联的 ChatGPT 账号 ID，ChatGPT 会话是本地账号可选的上游凭据
+	OpenaiID *string `json:"openai_id,omitempty"`
+	// OidcID holds the value of the "oidc_id" field.
+	// 通过 OpenID Connect 登录的用户在身份提供方中的 ID，格式为 issuer#sub
+	OidcID *string `json:"oidc_id,omitempty"`
+	// Groups holds the value of the "groups" field.
+	Groups []string `json:"groups,omitempty"`
+	// Features holds the value of the "features" field.
+	Features []string `json:"features,omitempty"`
+	// Role holds the value of the "role" field.
+	// 论坛授予的角色，为空时使用配置的用户组映射，默认为 member
+	Role *user.Role `json:"role,omitempty"`
+	// BannedAt holds the value of the "banned_at" field.
+	BannedAt *time.Time `json:"banned_at,omitempty"`
+	// BanReason holds the value of the "ban_reason" field.
+	BanReason string `json:"ban_reason,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	// 注销的时间，注销的账号不能登录，个人信息已经被清除
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges UserEdges `json:"edges"`
+}
+
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// Messages holds the value of the messages edge.
+	Messages []*Message `json:"messages,omitempty"`
+	// Conversations holds the value of the conversations edge.
+	Conversations []*Conversation `json:"conversations,omitempty"`
+	// Topics holds the value of the topics edge.
+	Topics []*Topic `json:"topics,omitempty"`
+	// Shares holds the value of the shares edge.
+	Shares []*Share `json:"shares,omitempty"`
+	// Moderations holds the value of the moderations edge.
+	Moderations []*Moderation `json:"moderations,omitempty"`
+	// Reports holds the value of the reports edge.
+	Reports []*Report `json:"reports,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
+	// Credentials holds the value of the credentials edge.
+	Credentials []*Credential `json:"-"`
+	// DataExports holds the value of the data_exports edge.
+	DataExports []*DataExport `json:"-"`
+	// AuditEvents holds the value of the audit_events edge.
+	AuditEvents []*AuditEvent `json:"audit_events,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [10]bool
+}
+
+// MessagesOrErr returns the Messages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MessagesOrErr() ([]*Message, error) {
+	if e.loadedTypes[0] {
+		return e.Messages, nil
+	}
+	return nil, &NotLoadedError{edge: "messages"}
+}
+
+// ConversationsOrErr returns the Conversations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ConversationsOrErr() ([]*Conversation, error) {
+	if e.loadedTypes[1] {
+		return e.Conversations, nil
+	}
+	return nil, &NotLoadedError{edge: "conversations"}
+}
+
+// TopicsOrErr returns the Topics value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TopicsOrErr() ([]*Topic, error) {
+	if e.loadedTypes[2] {
+		return e.Topics, nil
+	}
+	return nil, &NotLoadedError{edge: "topics"}
+}
+
+// SharesOrErr returns the Shares value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SharesOrErr() ([]*Share, error) {
+	if e.loadedTypes[3] {
+		return e.Shares, nil
+	}
+	return nil, &NotLoadedError{edge: "shares"}
+}
+
+// ModerationsOrErr returns the Moderations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ModerationsOrErr() ([]*Moderation, error) {
+	if e.loadedTypes[4] {
+		return e.Moderations, nil
+	}
+	return nil, &NotLoadedError{edge: "moderations"}
+}
+
+// ReportsOrErr returns the Reports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReportsOrErr() ([]*Report, error) {
+	if e.loadedTypes[5] {
+		return e.Reports, nil
+	}
+	return nil, &NotLoadedError{edge: "reports"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[6] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// CredentialsOrErr returns the Credentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CredentialsOrErr() ([]*Credential, error) {
+	if e.loadedTypes[7] {
+		return e.Credentials, nil
+	}
+	return nil, &NotLoadedError{edge: "credentials"}
+}
+
+// DataExportsOrErr returns the DataExports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DataExportsOrErr() ([]*DataExport, error) {
+	if e.loadedTypes[8] {
+		return e.DataExports, nil
+	}
+	return nil, &NotLoadedError{edge: "data_exports"}
+}
+
+// AuditEventsOrErr returns the AuditEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AuditEventsOrErr() ([]*AuditEvent, error) {
+	if e.loadedTypes[9] {
+		return e.AuditEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_events"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*User) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldGroups, user.FieldFeatures:
+			values[i] = new([]byte)
+		case user.FieldID, user.FieldName, user.FieldEmail, user.FieldImage, user.FieldPasswordHash, user.FieldSessionStamp, user.FieldOpenaiID, user.FieldOidcID, user.FieldRole, user.FieldBanReason:
+			values[i] = new(sql.NullString)
+		case user.FieldEmailVerifiedAt, user.FieldBannedAt, user.FieldDeletedAt, user.FieldCreatedAt, user.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type User", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the User fields.
+func (u *User) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				u.ID = value.String
+			}
+		case user.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				u.Name = value.String
+			}
+		case user.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				u.Email = value.String
+			}
+		case user.FieldImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image", values[i])
+			} else if value.Valid {
+				u.Image = value.String
+			}
+		case user.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				u.PasswordHash = value.String
+			}
+		case user.FieldSessionStamp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_stamp", values[i])
+			} else if value.Valid {
+				u.SessionStamp = value.String
+			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				u.EmailVerifiedAt = new(time.Time)
+				*u.EmailVerifiedAt = value.Time
+			}
+		case user.FieldOpenaiID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field openai_id", values[i])
+			} else if value.Valid {
+				u.OpenaiID = new(string)
+				*u.OpenaiID = value.String
+			}
+		case user.FieldOidcID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field oidc_id", values[i])
+			} else if value.Valid {
+				u.OidcID = new(string)
+				*u.OidcID = value.String
+			}
+		case user.FieldGroups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field groups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Groups); err != nil {
+					return fmt.Errorf("unmarshal field groups: %w", err)
+				}
+			}
+		case user.FieldFeatures:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field features", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Features); err != nil {
+					return fmt.Errorf("unmarshal field features: %w", err)
+				}
+			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				u.Role = new(user.Role)
+				*u.Role = user.Role(value.String)
+			}
+		case user.FieldBannedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field banned_at", values[i])
+			} else if value.Valid {
+				u.BannedAt = new(time.Time)
+				*u.BannedAt = value.Time
+			}
+		case user.FieldBanReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ban_reason", values[i])
+			} else if value.Valid {
+				u.BanReason = value.String
+			}
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				u.DeletedAt = new(time.Time)
+				*u.DeletedAt = value.Time
+			}
+		case user.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				u.CreatedAt = value.Time
+			}
+		case user.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				u.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryMessages queries the "messages" edge of the User entity.
+func (u *User) QueryMessages() *MessageQuery {
+	return (&UserClient{config: u.config}).QueryMessages(u)
+}
+
+// QueryConversations queries the "conversations" edge of the User entity.
+func (u *User) QueryConversations() *ConversationQuery {
+	return (&UserClient{config: u.config}).QueryConversations(u)
+}
+
+// QueryTopics queries the "topics" edge of the User entity.
+func (u *User) QueryTopics() *TopicQuery {
+	return (&UserClient{config: u.config}).QueryTopics(u)
+}
+
+// QueryShares queries the "shares" edge of the User entity.
+func (u *User) QueryShares() *ShareQuery {
+	return (&UserClient{config: u.config}).QueryShares(u)
+}
+
+// QueryModerations queries the "moderations" edge of the User entity.
+func (u *User) QueryModerations() *ModerationQuery {
+	return (&UserClient{config: u.config}).QueryModerations(u)
+}
+
+// QueryReports queries the "reports" edge of the User entity.
+func (u *User) QueryReports() *ReportQuery {
+	return (&UserClient{config: u.config}).QueryReports(u)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the User entity.
+func (u *User) QueryAPIKeys() *APIKeyQuery {
+	return (&UserClient{config: u.config}).QueryAPIKeys(u)
+}
+
+// QueryCredentials queries the "credentials" edge of the User entity.
+func (u *User) QueryCredentials() *CredentialQuery {
+	return (&UserClient{config: u.config}).QueryCredentials(u)
+}
+
+// QueryDataExports queries the "data_exports" edge of the User entity.
+func (u *User) QueryDataExports() *DataExportQuery {
+	return (&UserClient{config: u.config}).QueryDataExports(u)
+}
+
+// QueryAuditEvents queries the "audit_events" edge of the User entity.
+func (u *User) QueryAuditEvents() *AuditEventQuery {
+	return (&UserClient{config: u.config}).QueryAuditEvents(u)
+}
+
+// Update returns a builder for updating this User.
+// Note that you need to call User.Unwrap() before calling this method if this User
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (u *User) Update() *UserUpdateOne {
+	return (&UserClient{config: u.config}).UpdateOne(u)
+}
+
+// Unwrap unwraps the User entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (u *User) Unwrap() *User {
+	tx, ok := u.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: User is not a transactional entity")
+	}
+	u.config.driver = tx.drv
+	return u
+}
+
+// String implements the fmt.Stringer.
+func (u *User) String() string {
+	var builder strings.Builder
+	builder.WriteString("User(")
+	builder.WriteString(fmt.Sprintf("id=%v", u.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(u.Name)
+	builder.WriteString(", email=")
+	builder.WriteString(u.Email)
+	builder.WriteString(", image=")
+	builder.WriteString(u.Image)
+	builder.WriteString(", password_hash=<sensitive>")
+	builder.WriteString(", session_stamp=<sensitive>")
+	if v := u.EmailVerifiedAt; v != nil {
+		builder.WriteString(", email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := u.OpenaiID; v != nil {
+		builder.WriteString(", openai_id=")
+		builder.WriteString(*v)
+	}
+	if v := u.OidcID; v != nil {
+		builder.WriteString(", oidc_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", groups=")
+	builder.WriteString(fmt.Sprintf("%v", u.Groups))
+	builder.WriteString(", features=")
+	builder.WriteString(fmt.Sprintf("%v", u.Features))
+	if v := u.Role; v != nil {
+		builder.WriteString(", role=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := u.BannedAt; v != nil {
+		builder.WriteString(", banned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ban_reason=")
+	builder.WriteString(u.BanReason)
+	if v := u.DeletedAt; v != nil {
+		builder.WriteString(", deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", created_at=")
+	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(u.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Users is a parsable slice of User.
+type Users []*User
+
+func (u Users) config(cfg config) {
+	for _i := range u {
+		u[_i].config = cfg
+	}
+}
@@ -0,0 +1,116 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// untitled 是没有标题的会话导出时使用的标题
+const untitled = "Untitled conversation"
+
+// timeLayout 是导出文件中时间的格式
+const timeLayout = "2006-01-02 15:04:05 MST"
+
+// deletedContent 是被删除的消息导出时显示的内容
+const deletedContent = "[deleted]"
+
+// Message 是导出的会话中的一条消息
+type Message struct {
+	ID              string    `json:"id"`
+	Role            string    `json:"role"`
+	Content         string    `json:"content"`
+	ParentMessageID string    `json:"parent_message_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	// Deleted 为 true 时消息已经被删除，只导出占位
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// text 返回消息导出的文本，被删除的消息返回占位
+func (m *Message) text() string {
+	if m.Deleted {
+		return deletedContent
+	}
+	return strings.TrimSpace(m.Content)
+}
+
+// Conversation 是导出的一个会话，消息按时间排序
+type Conversation struct {
+	ID        string     `json:"id"`
+	Title     string     `json:"title"`
+	Model     string     `json:"model,omitempty"`
+	Summary   string     `json:"summary,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	Messages  []*Message `json:"messages"`
+}
+
+// title 返回会话的标题，没有标题时使用 untitled
+func (c *Conversation) title() string {
+	if t := strings.TrimSpace(c.Title); t != "" {
+		return t
+	}
+	return untitled
+}
+
+// RoleName 返回消息角色显示的名称
+func RoleName(role string) string {
+	switch role {
+	case "user":
+		return "User"
+	case "assistant":
+		return "ChatGPT"
+	case "system":
+		return "System"
+	}
+	return role
+}
+
+// Markdown 将会话写为 Markdown，消息的内容本身就是 Markdown，原样输出
+func Markdown(w io.Writer, c *Conversation) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", c.title())
+	if c.Summary != "" {
+		fmt.Fprintf(&b, "> %s\n\n", strings.ReplaceAll(c.Summary, "\n", "\n> "))
+	}
+	if c.Model != "" {
+		fmt.Fprintf(&b, "- Model: %s\n", c.Model)
+	}
+	fmt.Fprintf(&b, "- Created: %s\n", c.CreatedAt.UTC().Format(timeLayout))
+	for _, m := range c.Messages {
+		fmt.Fprintf(&b, "\n---\n\n### %s\n\n*%s*\n\n%s\n", RoleName(m.Role), m.CreatedAt.UTC().Format(timeLayout), m.text())
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteArchive 将用户的数据写为 zip 归档
+//
+// 归档包含完整数据的 data.json，以及每个会话一个便于阅读的 conversations/<id>.md，
+// conversations 由调用方选择每个会话要写入 Markdown 的消息。
+func WriteArchive(w io.Writer, data interface{}, conversations []*Conversation, exportedAt time.Time) error {
+	zw := zip.NewWriter(w)
+
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: "data.json", Method: zip.Deflate, Modified: exportedAt})
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(data); err != nil {
+		return err
+	}
+
+	for _, c := range conversations {
+		f, err = zw.CreateHeader(&zip.FileHeader{Name: "conversations/" + c.ID + ".md", Method: zip.Deflate, Modified: exportedAt})
+		if err != nil {
+			return err
+		}
+		if err = Markdown(f, c); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
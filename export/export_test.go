@@ -0,0 +1,111 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testConversation() *Conversation {
+	created := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &Conversation{
+		ID:        "c1",
+		Model:     "gpt",
+		Summary:   "A greeting.",
+		CreatedAt: created,
+		Messages: []*Message{
+			{ID: "q1", Role: "user", Content: "hello", CreatedAt: created},
+			{ID: "a1", Role: "assistant", Content: "**hi**\n", ParentMessageID: "q1", CreatedAt: created},
+		},
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	var b strings.Builder
+	if err := Markdown(&b, testConversation()); err != nil {
+		t.Fatal(err)
+	}
+	want := "# Untitled conversation\n\n> A greeting.\n\n- Model: gpt\n- Created: 2023-01-02 03:04:05 UTC\n" +
+		"\n---\n\n### User\n\n*2023-01-02 03:04:05 UTC*\n\nhello\n" +
+		"\n---\n\n### ChatGPT\n\n*2023-01-02 03:04:05 UTC*\n\n**hi**\n"
+	if b.String() != want {
+		t.Errorf("Markdown() = %q, want %q", b.String(), want)
+	}
+}
+
+func TestFormats(t *testing.T) {
+	c := testConversation()
+	c.Messages = append(c.Messages, &Message{ID: "q2", Role: "user", ParentMessageID: "a1", CreatedAt: c.CreatedAt, Deleted: true})
+
+	var text strings.Builder
+	if err := FormatText.Write(&text, c); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(text.String(), "\n[ChatGPT] 2023-01-02 03:04:05 UTC\n**hi**\n") ||
+		!strings.HasSuffix(text.String(), "\n[User] 2023-01-02 03:04:05 UTC\n[deleted]\n") {
+		t.Errorf("Text() = %q", text.String())
+	}
+
+	var b bytes.Buffer
+	if err := FormatJSON.Write(&b, c); err != nil {
+		t.Fatal(err)
+	}
+	var got Conversation
+	if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Messages) != 3 || got.Messages[1].ParentMessageID != "q1" || !got.Messages[2].Deleted {
+		t.Errorf("JSON() = %s", b.String())
+	}
+
+	var html strings.Builder
+	if err := FormatHTML.Write(&html, c); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"<strong>hi</strong>", `class="content deleted"`, "2023-01-02 03:04:05 UTC"} {
+		if !strings.Contains(html.String(), s) {
+			t.Errorf("HTML() does not contain %q", s)
+		}
+	}
+
+	if _, ok := ParseFormat("pdf"); ok {
+		t.Error("ParseFormat(pdf) = true")
+	}
+}
+
+func TestWriteArchive(t *testing.T) {
+	var buf bytes.Buffer
+	data := map[string]string{"user": "alice"}
+	if err := WriteArchive(&buf, data, []*Conversation{testConversation()}, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	for _, f := range r.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bs, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = string(bs)
+	}
+	var got map[string]string
+	if err = json.Unmarshal([]byte(files["data.json"]), &got); err != nil || got["user"] != "alice" {
+		t.Errorf("data.json = %q, %v", files["data.json"], err)
+	}
+	if !strings.HasPrefix(files["conversations/c1.md"], "# Untitled conversation") {
+		t.Errorf("conversations/c1.md = %q", files["conversations/c1.md"])
+	}
+}
@@ -0,0 +1,173 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"community.threetenth.chatgpt/render"
+	"community.threetenth.chatgpt/webapp"
+)
+
+// Format 是单个会话导出的格式，值是导出文件的扩展名
+type Format string
+
+const (
+	// FormatMarkdown 导出为 Markdown
+	FormatMarkdown Format = "md"
+	// FormatHTML 导出为样式与主题页面一致的独立 HTML 页面
+	FormatHTML Format = "html"
+	// FormatJSON 导出为 JSON
+	FormatJSON Format = "json"
+	// FormatText 导出为纯文本
+	FormatText Format = "txt"
+)
+
+// Formats 是支持的导出格式
+var Formats = []Format{FormatMarkdown, FormatHTML, FormatJSON, FormatText}
+
+// ParseFormat 解析导出格式，不支持的格式返回 false
+func ParseFormat(s string) (Format, bool) {
+	for _, f := range Formats {
+		if string(f) == s {
+			return f, true
+		}
+	}
+	return "", false
+}
+
+// ContentType 返回导出文件的 Content-Type
+func (f Format) ContentType() string {
+	switch f {
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	case FormatJSON:
+		return "application/json; charset=utf-8"
+	case FormatText:
+		return "text/plain; charset=utf-8"
+	}
+	return "text/markdown; charset=utf-8"
+}
+
+// Write 将会话写为指定的格式
+func (f Format) Write(w io.Writer, c *Conversation) error {
+	switch f {
+	case FormatHTML:
+		return HTML(w, c)
+	case FormatJSON:
+		return JSON(w, c)
+	case FormatText:
+		return Text(w, c)
+	}
+	return Markdown(w, c)
+}
+
+// Text 将会话写为纯文本，消息的内容原样输出，代码块保留 Markdown 的围栏
+func Text(w io.Writer, c *Conversation) error {
+	var b strings.Builder
+	title := c.title()
+	fmt.Fprintf(&b, "%s\n%s\n\n", title, strings.Repeat("=", len([]rune(title))))
+	if c.Summary != "" {
+		fmt.Fprintf(&b, "%s\n\n", c.Summary)
+	}
+	if c.Model != "" {
+		fmt.Fprintf(&b, "Model: %s\n", c.Model)
+	}
+	fmt.Fprintf(&b, "Created: %s\n", c.CreatedAt.UTC().Format(timeLayout))
+	for _, m := range c.Messages {
+		fmt.Fprintf(&b, "\n[%s] %s\n%s\n", RoleName(m.Role), m.CreatedAt.UTC().Format(timeLayout), m.text())
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// JSON 将会话写为缩进的 JSON
+func JSON(w io.Writer, c *Conversation) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(c)
+}
+
+// htmlMessage 是 HTML 页面中的一条消息
+type htmlMessage struct {
+	ID        string
+	Role      string
+	Content   string
+	CreatedAt time.Time
+	Deleted   bool
+}
+
+// htmlView 是 HTML 页面的数据，代码高亮的样式内联在页面中，公式使用 CDN 上的 KaTeX 渲染，离线时显示原始的 TeX
+type htmlView struct {
+	Title     string
+	Summary   string
+	Model     string
+	CreatedAt time.Time
+	Messages  []*htmlMessage
+	CSS       template.CSS
+}
+
+// HTML 将会话写为独立的 HTML 页面，样式与论坛的主题页面一致
+func HTML(w io.Writer, c *Conversation) error {
+	tmpl, err := webapp.Webapp(webapp.ExportHTML, false)
+	if err != nil {
+		return err
+	}
+	var css strings.Builder
+	if err = render.WriteCSS(&css); err != nil {
+		return err
+	}
+
+	view := &htmlView{
+		Title:     c.title(),
+		Summary:   c.Summary,
+		Model:     c.Model,
+		CreatedAt: c.CreatedAt.UTC(),
+		Messages:  make([]*htmlMessage, len(c.Messages)),
+		CSS:       template.CSS(css.String()),
+	}
+	for i, m := range c.Messages {
+		view.Messages[i] = &htmlMessage{
+			ID:        m.ID,
+			Role:      RoleName(m.Role),
+			Content:   m.Content,
+			CreatedAt: m.CreatedAt.UTC(),
+			Deleted:   m.Deleted,
+		}
+	}
+	return tmpl.Execute(w, view)
+}
+
+// Bundle 将多个会话以同一个格式写入 zip 归档，每个会话一个文件
+type Bundle struct {
+	zw       *zip.Writer
+	format   Format
+	modified time.Time
+}
+
+// NewBundle 创建一个写入 w 的归档，modified 是归档中文件的修改时间
+func NewBundle(w io.Writer, format Format, modified time.Time) *Bundle {
+	return &Bundle{zw: zip.NewWriter(w), format: format, modified: modified}
+}
+
+// Add 将会话写入归档中的 <name>.<format> 文件
+func (b *Bundle) Add(name string, c *Conversation) error {
+	f, err := b.zw.CreateHeader(&zip.FileHeader{
+		Name:     name + "." + string(b.format),
+		Method:   zip.Deflate,
+		Modified: b.modified,
+	})
+	if err != nil {
+		return err
+	}
+	return b.format.Write(f, c)
+}
+
+// Close 写入归档的目录，不会关闭 w
+func (b *Bundle) Close() error {
+	return b.zw.Close()
+}
@@ -0,0 +1,75 @@
+package mail
+
+import (
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Config 是 SMTP 发信配置
+type Config struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	From     string `json:"from"`
+}
+
+// Mailer 发送纯文本邮件
+//
+// 没有配置 SMTP 时只输出日志。邮件正文包含验证和重置密码的链接，
+// 只在调试模式下输出，方便在开发环境中获取。
+type Mailer struct {
+	config *Config
+	debug  bool
+}
+
+// New 创建一个 Mailer，config 可以为 nil，debug 为 true 时未发送的邮件正文会输出到日志
+func New(config *Config, debug bool) *Mailer {
+	return &Mailer{config: config, debug: debug}
+}
+
+// Enabled 判断是否配置了 SMTP，没有配置时邮件只输出到日志
+func (m *Mailer) Enabled() bool {
+	return m != nil && m.config != nil && m.config.Host != ""
+}
+
+// Send 发送一封纯文本邮件
+func (m *Mailer) Send(to, subject, body string) error {
+	if !m.Enabled() {
+		logger := log.WithFields(log.Fields{
+			"method":  "mail.Send",
+			"event":   "mail.unsent",
+			"to":      to,
+			"subject": subject,
+		})
+		logger.Warn("smtp is not configured")
+		if m.debug {
+			logger.Debug(body)
+		}
+		return nil
+	}
+
+	if strings.ContainsAny(to+subject, "\r\n") {
+		return fmt.Errorf("mail: invalid header value")
+	}
+
+	msg := strings.Join([]string{
+		"From: " + m.config.From,
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	var auth smtp.Auth
+	if m.config.Username != "" {
+		auth = smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+	}
+	addr := fmt.Sprintf("%s:%d", m.config.Host, m.config.Port)
+	return smtp.SendMail(addr, auth, m.config.From, []string{to}, []byte(msg))
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"community.threetenth.chatgpt/openai"
+	"community.threetenth.chatgpt/restapi"
+)
+
+const importUsage = `usage: main -config config.json import -user <id> [options] conversations.json
+
+为用户导入 ChatGPT 导出数据中的 conversations.json，重复导入只增加新的消息。
+命令行导入由运营者执行，导入的消息不经过审核。`
+
+// runImport 执行 import 子命令
+func runImport(ctx context.Context, api *restapi.API, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	userID := flags.String("user", "", "导入到的用户 ID")
+	publish := flags.String("publish", "", "导入后发布为公开主题的 ChatGPT 会话 ID，用逗号分隔，all 发布全部")
+	category := flags.String("category", "general", "发布的主题的分类")
+	dryRun := flags.Bool("dry-run", false, "只输出每个会话导入的结果，不保存")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), importUsage)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *userID == "" || flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("user and conversations.json are required")
+	}
+
+	f, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	conversations, err := openai.ReadConversations(f)
+	if err != nil {
+		return fmt.Errorf("invalid conversations.json: %w", err)
+	}
+
+	options := &restapi.ImportOptions{Category: *category, DryRun: *dryRun}
+	for _, id := range strings.Split(*publish, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			options.Publish = append(options.Publish, id)
+		}
+	}
+	results, err := api.ImportConversations(ctx, *userID, conversations, options, nil)
+	for _, r := range results {
+		line := fmt.Sprintf("%-9s %s %q, %d new messages", r.Status, r.UpstreamID, r.Title, r.Added)
+		if r.TopicID != "" {
+			line += ", published as topic " + r.TopicID
+		}
+		if r.Error != "" {
+			line += ", not published: " + r.Error
+		}
+		fmt.Println(line)
+	}
+	return err
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"community.threetenth.chatgpt/db"
+	"community.threetenth.chatgpt/restapi"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultAuditRetentionDays 是没有配置时审计记录保留的天数
+const defaultAuditRetentionDays = 365
+
+// defaultDeletedRetentionDays 是没有配置时删除的内容保留的天数
+const defaultDeletedRetentionDays = 30
+
+// turnTimeout 是提问等待回复的最长时间，超过后标记为 failed
+const turnTimeout = 10 * time.Minute
+
+// startJobs 启动后台定时任务
+func startJobs(ctx context.Context, store db.Store) {
+	if config.AuditRetentionDays == 0 {
+		config.AuditRetentionDays = defaultAuditRetentionDays
+	}
+	if config.AuditRetentionDays > 0 {
+		go every(ctx, "purgeAuditEvents", 24*time.Hour, func(ctx context.Context) error {
+			return purgeAuditEvents(ctx, store)
+		})
+	}
+	if config.DeletedRetentionDays == 0 {
+		config.DeletedRetentionDays = defaultDeletedRetentionDays
+	}
+	if config.DeletedRetentionDays > 0 {
+		go every(ctx, "purgeDeleted", time.Hour, func(ctx context.Context) error {
+			return purgeDeleted(ctx, store)
+		})
+	}
+	go every(ctx, "purgeExports", time.Hour, func(ctx context.Context) error {
+		return purgeExports(ctx, store)
+	})
+	go every(ctx, "sweepTurns", time.Minute, func(ctx context.Context) error {
+		return sweepTurns(ctx, store)
+	})
+}
+
+// every 立即执行一次任务，之后每隔 interval 执行一次，直到 ctx 取消，任务出错只输出日志
+func every(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := job(ctx); err != nil {
+			log.WithFields(log.Fields{
+				"method": "main.every",
+				"event":  name,
+			}).Warn(err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeAuditEvents 删除超过保留期限的审计记录
+func purgeAuditEvents(ctx context.Context, store db.Store) error {
+	before := time.Now().AddDate(0, 0, -config.AuditRetentionDays)
+	n, err := store.PurgeAuditEvents(ctx, before)
+	if err == nil && n > 0 {
+		log.WithFields(log.Fields{
+			"method": "main.purgeAuditEvents",
+			"before": before,
+		}).Infof("purged %d audit events", n)
+	}
+	return err
+}
+
+// purgeDeleted 彻底删除超过保留期限的会话、主题和消息
+func purgeDeleted(ctx context.Context, store db.Store) error {
+	before := time.Now().AddDate(0, 0, -config.DeletedRetentionDays)
+	n, err := store.PurgeDeleted(ctx, before)
+	if err == nil && n > 0 {
+		log.WithFields(log.Fields{
+			"method": "main.purgeDeleted",
+			"before": before,
+		}).Infof("purged %d deleted items", n)
+	}
+	return err
+}
+
+// purgeExports 删除过期的用户数据导出任务和归档文件
+func purgeExports(ctx context.Context, store db.Store) error {
+	ids, err := store.PurgeDataExports(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err = os.Remove(restapi.ExportPath(id)); err != nil && !os.IsNotExist(err) {
+			log.WithFields(log.Fields{
+				"method": "main.purgeExports",
+				"event":  "os.Remove",
+			}).Warn(err.Error())
+		}
+	}
+	if len(ids) > 0 {
+		log.WithFields(log.Fields{
+			"method": "main.purgeExports",
+		}).Infof("purged %d expired exports", len(ids))
+	}
+	return nil
+}
+
+// sweepTurns 将超时没有回复的提问标记为 failed
+func sweepTurns(ctx context.Context, store db.Store) error {
+	n, err := store.SweepTurns(ctx, time.Now().Add(-turnTimeout))
+	if err == nil && n > 0 {
+		log.WithFields(log.Fields{
+			"method": "main.sweepTurns",
+		}).Infof("marked %d stuck turns as failed", n)
+	}
+	return err
+}
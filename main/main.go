@@ -1,14 +1,21 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
-	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
+	"community.threetenth.chatgpt/auth"
 	"community.threetenth.chatgpt/db"
+	"community.threetenth.chatgpt/mail"
+	"community.threetenth.chatgpt/moderation"
+	"community.threetenth.chatgpt/openai"
 	"community.threetenth.chatgpt/restapi"
 	"community.threetenth.chatgpt/webapp"
 	"github.com/gin-gonic/gin"
@@ -17,11 +24,53 @@ import (
 
 // Config is 服务启动配置文件
 type Config struct {
+	// DSN 是数据库的连接地址，根据 scheme 选择 PostgreSQL 或 SQLite，例如 sqlite://chatgpt-community.db，dsn 和 pg 必须设置一个
+	DSN string `json:"dsn"`
+	// Pg 是没有 scheme 的 PostgreSQL 连接地址，DSN 为空时使用
 	Pg    string `json:"pg"`
 	Port  int    `json:"port"`
 	Mode  int    `json:"mode"`
 	Log   string `json:"log"`
 	Debug bool   `json:"debug"`
+	// BaseURL 是站点对外的根地址，例如 https://example.com，用于 canonical、sitemap、feed 和邮件中的链接，必须设置
+	BaseURL string `json:"base_url"`
+	// Moderation 是提问和回复的审核配置，为空时不审核
+	Moderation *moderation.Config `json:"moderation"`
+	// SessionSecret 是签发会话 cookie 和邮件链接的密钥，为空时随机生成，重启后需要重新登录
+	SessionSecret string `json:"session_secret"`
+	// SessionMaxAge 是本地账号会话的有效期，单位为小时，默认 30 天
+	SessionMaxAge int `json:"session_max_age"`
+	// Mail 是发送验证邮箱和重置密码邮件的 SMTP 配置，为空时只输出到日志
+	Mail *mail.Config `json:"mail"`
+	// OIDC 是 OpenID Connect 登录的配置，为空时不支持 OpenID Connect 登录
+	OIDC *auth.OIDCConfig `json:"oidc"`
+	// GroupRoles 是用户组到论坛角色的映射，例如 {"forum-admins": "admin"}，没有配置的用户组不授予角色
+	GroupRoles map[string]string `json:"group_roles"`
+	// Credentials 是加密保存 ChatGPT 凭据的密钥配置，为空时使用随机密钥，重启后需要重新更新 ChatGPT 会话
+	Credentials *openai.KeyringConfig `json:"credentials"`
+	// AuditRetentionDays 是审计记录保留的天数，默认 365 天，小于 0 时永久保留
+	AuditRetentionDays int `json:"audit_retention_days"`
+	// DeletedRetentionDays 是删除的会话、主题和消息可以恢复的天数，超过后彻底删除，默认 30 天，小于 0 时永久保留
+	DeletedRetentionDays int `json:"deleted_retention_days"`
+	// MaxPromptLength 是提问的最大字符数，默认 4000
+	MaxPromptLength int `json:"max_prompt_length"`
+	// Models 是允许使用的 ChatGPT 模型，第一个是默认模型
+	Models []string `json:"models"`
+	// DisableSummaries 为 true 时不在回复后使用提问者的 ChatGPT 凭据自动生成会话的标题和摘要
+	DisableSummaries bool `json:"disable_summaries"`
+	// ExportDir 是保存用户数据导出归档的目录，默认 ../exports，不存在时以 0700 权限创建，
+	// 归档包含用户的全部数据，目录不能被其他用户访问
+	ExportDir string `json:"export_dir"`
+	// ExportMaxAge 是导出的归档可以下载的时间，单位为小时，默认 7 天
+	ExportMaxAge int `json:"export_max_age"`
+	// AccountDeletion 是注销账号时处理用户内容的策略，anonymize 保留发布的主题并匿名化，purge 删除全部内容，默认 anonymize
+	AccountDeletion string `json:"account_deletion"`
+	// TrustedPlatform 是 CDN 或反向代理传递客户端 IP 的 Header，例如 X-Real-IP 或 CF-Connecting-IP，为空时不使用。
+	// 客户端可以伪造这个 Header，只能在所有请求都经过会覆盖它的代理、服务不能被直接访问时设置
+	TrustedPlatform string `json:"trusted_platform"`
+	// TrustedProxies 是可信的反向代理的 IP 或 CIDR，只有来自这些地址的请求才使用 X-Forwarded-For 中的客户端 IP，
+	// 为空时不信任任何代理，审计记录使用连接的远程地址
+	TrustedProxies []string `json:"trusted_proxies"`
 }
 
 var config *Config
@@ -35,13 +84,20 @@ func main() {
 		bs, err := os.ReadFile(configFilepath)
 		if err == nil {
 			if err = json.Unmarshal(bs, &config); err != nil {
-				log.Panicln("json unmarshal config file failed: %v", err)
+				log.Panicf("json unmarshal config file failed: %v", err)
 			}
 		}
 	}
 
 	if config == nil {
-		config = &Config{"postgres:123456@localhost:5432/chatgpt-community", 30039, int(log.WarnLevel), "../logcat.log", true}
+		config = &Config{
+			DSN:     "sqlite://../chatgpt-community.db",
+			BaseURL: "http://localhost:30039",
+			Port:    30039,
+			Mode:    int(log.WarnLevel),
+			Log:     "../logcat.log",
+			Debug:   true,
+		}
 	}
 
 	log.SetLevel(log.Level(config.Mode))
@@ -60,10 +116,117 @@ func main() {
 		log.Panicln("Failed to log to file, using default stderr", err)
 	}
 
-	if config.Pg != "" {
-		db.OpenPostgreSQL(config.Pg, config.Debug)
+	if flag.NArg() > 0 && flag.Arg(0) != "migrate" && flag.Arg(0) != "import" {
+		exitOnError(fmt.Errorf("unknown command %q", flag.Arg(0)))
+	}
+
+	if config.DSN == "" {
+		config.DSN = config.Pg
+	}
+
+	// 没有数据库时页面和接口都无法使用，不能使用空的 store 启动
+	if config.DSN == "" {
+		exitOnError(fmt.Errorf("dsn or pg is required, for example sqlite://chatgpt-community.db"))
+	}
+
+	ctx := context.Background()
+	sqlStore, err := db.Open(ctx, config.DSN, config.Debug)
+	if err != nil {
+		log.Panicln(err.Error())
+	}
+	defer sqlStore.Close()
+	if flag.Arg(0) == "migrate" {
+		exitOnError(runMigrate(ctx, sqlStore, flag.Args()[1:]))
+		return
+	}
+	migrate(ctx, sqlStore)
+	if flag.Arg(0) == "import" {
+		exitOnError(runImport(ctx, restapi.New(sqlStore), flag.Args()[1:]))
+		return
+	}
+	var store db.Store = sqlStore
+
+	// 页面、feed 和邮件中的链接只使用配置的根地址，不根据请求的 Host 推断，避免伪造的 Host header 生成指向其他站点的链接
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+	if !absoluteURL(config.BaseURL) {
+		log.Panicln("base_url must be an absolute http or https URL")
 	}
 
+	api := restapi.New(store)
+
+	moderator, err := moderation.New(config.Moderation)
+	if err != nil {
+		log.Panicln("failed to create moderation pipeline: ", err.Error())
+	}
+	restapi.SetModerator(moderator)
+	restapi.SetConversationConfig(&restapi.ConversationConfig{
+		MaxPromptLength:  config.MaxPromptLength,
+		Models:           config.Models,
+		DisableSummaries: config.DisableSummaries,
+	})
+
+	if config.ExportDir == "" {
+		config.ExportDir = "../exports"
+	}
+	if err = checkExportDir(config.ExportDir); err != nil {
+		log.Panicln("invalid export_dir: ", err.Error())
+	}
+	deletion := restapi.DeletionPolicy(config.AccountDeletion)
+	if deletion != "" && deletion != restapi.DeletionAnonymize && deletion != restapi.DeletionPurge {
+		log.Panicf("unknown account_deletion %q", config.AccountDeletion)
+	}
+	restapi.SetPrivacyConfig(&restapi.PrivacyConfig{
+		ExportDir:      config.ExportDir,
+		ExportMaxAge:   time.Duration(config.ExportMaxAge) * time.Hour,
+		DeletionPolicy: deletion,
+	})
+
+	if config.Credentials != nil {
+		keyring, err := openai.LoadKeyring(config.Credentials)
+		if err != nil {
+			log.Panicln("failed to load credentials keyring: ", err.Error())
+		}
+		openai.SetKeyring(keyring)
+	} else {
+		log.Warnln("credentials is empty, a random key is used and saved ChatGPT sessions will be lost after restart")
+	}
+	api.LoadCredentials(ctx)
+	startJobs(ctx, store)
+
+	if config.SessionSecret == "" {
+		log.Warnln("session_secret is empty, a random secret is used and sessions will be lost after restart")
+	}
+	signer, err := auth.NewSigner([]byte(config.SessionSecret))
+	if err != nil {
+		log.Panicln("failed to create session signer: ", err.Error())
+	}
+	if config.SessionMaxAge <= 0 {
+		config.SessionMaxAge = 30 * 24
+	}
+	accountConfig := &restapi.AccountConfig{
+		Signer:        signer,
+		SessionMaxAge: time.Duration(config.SessionMaxAge) * time.Hour,
+		BaseURL:       config.BaseURL,
+		Mailer:        mail.New(config.Mail, config.Debug),
+	}
+	if config.OIDC != nil {
+		if config.OIDC.RedirectURL == "" {
+			config.OIDC.RedirectURL = accountConfig.BaseURL + "/api/v1/account/oidc/callback"
+		}
+		accountConfig.OIDC = auth.NewOIDCProvider(config.OIDC)
+	}
+	restapi.SetAccountConfig(accountConfig)
+
+	groupRoles := make(map[string]restapi.Role, len(config.GroupRoles))
+	for group, name := range config.GroupRoles {
+		role, ok := restapi.ParseRole(name)
+		if !ok {
+			log.Panicf("unknown role %q for group %q", name, group)
+		}
+		groupRoles[group] = role
+	}
+	restapi.SetGroupRoles(groupRoles)
+
 	if config.Debug {
 		gin.SetMode(gin.DebugMode)
 	} else {
@@ -71,24 +234,50 @@ func main() {
 	}
 
 	router := gin.Default()
-	// 直接获取 "X-Real-IP" 的 Header 值为 Client IP
-	router.TrustedPlatform = "X-Real-IP"
-	// 当 remote ip 为 ::1 时，获取 "X-Forwarded-For" 和 "X-Real-IP" 的 Header 值为 Client IP
-	router.SetTrustedProxies([]string{"::1"})
+	// 默认不信任任何代理设置的 Header，Client IP 为连接的远程地址
+	router.TrustedPlatform = config.TrustedPlatform
+	if err = router.SetTrustedProxies(config.TrustedProxies); err != nil {
+		log.Panicf("invalid trusted_proxies: %v", err)
+	}
 	router.Use(func(ctx *gin.Context) {
 		ctx.Set("Debug", config.Debug)
 	})
-	router.GET("/", web)
-	router.GET("/:pagename", web)
-	router.POST("/api/v1/captcha", restapi.PostCaptcha)
-	router.GET("/api/v1/session", restapi.UpdateChatGPTSession)
-	router.POST("/api/v1/conversation", restapi.PostChatGPTConversation)
-	router.GET("/api/v1/conversation", restapi.GetChatGPTConversation)
-	router.GET("/api/v1/message", restapi.GetChatGPTMessage)
+	// 接口的错误统一转换为 JSON 格式返回
+	router.Use(restapi.ErrorHandler())
+	routes(router, api, &pages{store: store})
 
 	router.Run(fmt.Sprint(":", config.Port))
 }
 
+// checkExportDir 创建导出目录，检查目录可以写入并且其他用户不能访问
+func checkExportDir(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return fmt.Errorf("%s is accessible by other users (mode %o), use chmod 700", dir, info.Mode().Perm())
+	}
+	f, err := os.CreateTemp(dir, ".check-*")
+	if err != nil {
+		return err
+	}
+	f.Close()
+	return os.Remove(f.Name())
+}
+
+// absoluteURL 判断是否为 http 或 https 的绝对地址
+func absoluteURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
 func web(c *gin.Context) {
 	if !config.Debug {
 		c.Header("Cache-Control", "public, max-age=31536000")
@@ -96,7 +285,7 @@ func web(c *gin.Context) {
 	name := c.Param("pagename")
 	tmpl, err := webapp.Webapp(name, config.Debug)
 	if err != nil {
-		c.String(http.StatusNotFound, err.Error())
+		restapi.Fail(c, err)
 		return
 	}
 	tmpl.Execute(c.Writer, nil)
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAbsoluteURL(t *testing.T) {
+	for s, want := range map[string]bool{
+		"https://example.com":        true,
+		"http://localhost:8080/sub":  true,
+		"":                           false,
+		"/api/v1":                    false,
+		"example.com":                false,
+		"ftp://example.com":          false,
+		"https://":                   false,
+		"javascript:alert(1)//x.com": false,
+	} {
+		if got := absoluteURL(s); got != want {
+			t.Errorf("absoluteURL(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestCheckExportDir(t *testing.T) {
+	root := t.TempDir()
+	created := filepath.Join(root, "exports")
+	if err := checkExportDir(created); err != nil {
+		t.Fatalf("checkExportDir(new dir) = %v", err)
+	}
+	if info, err := os.Stat(created); err != nil || info.Mode().Perm() != 0700 {
+		t.Errorf("new export dir mode = %v, %v, want 0700", info, err)
+	}
+
+	shared := filepath.Join(root, "shared")
+	if err := os.Mkdir(shared, 0700); err != nil {
+		t.Fatal(err)
+	}
+	// 避免 umask 影响创建的权限
+	if err := os.Chmod(shared, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkExportDir(shared); err == nil {
+		t.Errorf("checkExportDir(0755 dir) = nil, want an error")
+	}
+
+	file := filepath.Join(root, "file")
+	if err := os.WriteFile(file, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkExportDir(file); err == nil {
+		t.Errorf("checkExportDir(file) = nil, want an error")
+	}
+}
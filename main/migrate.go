@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"community.threetenth.chatgpt/db"
+	log "github.com/sirupsen/logrus"
+)
+
+const migrateUsage = `usage: main -config config.json migrate <command>
+
+commands:
+  status        查看每个版本的迁移状态
+  up            执行所有未执行的迁移，包括破坏性的迁移
+  down [n]      回滚最近执行的 n 个迁移，默认为 1
+  diff <name>   根据 ent schema 和数据库的差异，在源码目录中生成新的迁移文件`
+
+// runMigrate 执行 migrate 子命令
+func runMigrate(ctx context.Context, store *db.SQLStore, args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	dir := flags.String("dir", db.MigrationsDir, "migrate diff 生成迁移文件的目录")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), migrateUsage)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	args = flags.Args()
+	if len(args) == 0 {
+		flags.Usage()
+		return errors.New("migrate command is required")
+	}
+
+	migrator, err := store.Migrator()
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			state := "pending"
+			if s.AppliedAt != nil {
+				state = "applied at " + s.AppliedAt.Local().Format("2006-01-02 15:04:05")
+			}
+			if s.Unknown {
+				state += ", unknown to this binary"
+			}
+			if s.Destructive {
+				state += ", destructive"
+			}
+			fmt.Printf("%04d_%s\t%s\n", s.Version, s.Name, state)
+		}
+		return nil
+
+	case "up":
+		done, err := migrator.Up(ctx)
+		for _, m := range done {
+			fmt.Println("applied", m)
+		}
+		return err
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid steps %q", args[1])
+			}
+		}
+		done, err := migrator.Down(ctx, steps)
+		for _, m := range done {
+			fmt.Println("reverted", m)
+		}
+		return err
+
+	case "diff":
+		if len(args) < 2 {
+			return errors.New("migration name is required")
+		}
+		// 数据库需要已经执行所有迁移，否则生成的差异会包含未执行的迁移
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			if s.AppliedAt == nil || s.Unknown {
+				return fmt.Errorf("database is not at version %04d, run `migrate up` first", migrator.Latest())
+			}
+		}
+		var up strings.Builder
+		if err = store.SchemaDiff(ctx, &up, true); err != nil {
+			return err
+		}
+		if up.Len() == 0 {
+			fmt.Println("schema is up to date")
+			return nil
+		}
+		files, err := migrator.Create(*dir, args[1], up.String())
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			fmt.Println("created", file)
+		}
+		return nil
+	}
+
+	flags.Usage()
+	return fmt.Errorf("unknown migrate command %q", args[0])
+}
+
+// migrate 在启动时检查数据库版本并执行未执行的迁移
+//
+// 数据库比程序新或者有未执行的破坏性迁移时拒绝启动，ent schema 和迁移文件不一致时输出警告。
+func migrate(ctx context.Context, store *db.SQLStore) {
+	migrator, err := store.Migrator()
+	if err != nil {
+		log.Panicln(err.Error())
+	}
+	done, err := migrator.Migrate(ctx)
+	for _, m := range done {
+		log.WithFields(log.Fields{
+			"method":    "main.migrate",
+			"migration": m.String(),
+		}).Info("migration applied")
+	}
+	if err != nil {
+		log.Panicln("failed to migrate database: ", err.Error())
+	}
+
+	var diff strings.Builder
+	if err = store.SchemaDiff(ctx, &diff, false); err != nil {
+		log.WithFields(log.Fields{
+			"method": "main.migrate",
+			"event":  "db.SchemaDiff",
+		}).Warn(err.Error())
+	} else if diff.Len() > 0 {
+		log.WithFields(log.Fields{
+			"method": "main.migrate",
+			"diff":   diff.String(),
+		}).Warn("ent schema differs from the migrations, run `migrate diff` to create a migration")
+	}
+}
+
+// exitOnError 输出子命令的错误并退出
+func exitOnError(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
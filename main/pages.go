@@ -0,0 +1,309 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"community.threetenth.chatgpt/db"
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/render"
+	"community.threetenth.chatgpt/restapi"
+	"community.threetenth.chatgpt/webapp"
+	"github.com/gin-gonic/gin"
+)
+
+// pageSize 是服务端渲染的列表页每页的主题数量
+const pageSize = 20
+
+// feedSize 是 RSS/Atom 中的条目数量
+const feedSize = 50
+
+// sitemapSize 是 sitemap.xml 中最多包含的主题数量
+const sitemapSize = 50000
+
+// pages 是服务端渲染的页面，通过 store 读取公开的内容
+type pages struct {
+	store db.Store
+}
+
+// pageView 是服务端渲染页面模板的通用数据
+type pageView struct {
+	Title       string
+	Description string
+	URL         string
+	BaseURL     string
+	Category    string
+	Page        int
+	PrevURL     string
+	NextURL     string
+	Categories  []string
+	Topics      []*ent.Topic
+	Topic       *ent.Topic
+	Messages    []*ent.Message
+	User        *ent.User
+	Share       *ent.Share
+	Updated     time.Time
+}
+
+// baseURL 获取配置的站点对外的根地址，启动时已经检查过是绝对地址
+func baseURL() string {
+	return config.BaseURL
+}
+
+// renderPage 使用 webapp 中的模板渲染页面
+func renderPage(c *gin.Context, name, contentType string, data interface{}) {
+	tmpl, err := webapp.Webapp(name, config.Debug)
+	if err != nil {
+		restapi.Fail(c, err)
+		return
+	}
+	c.Header("Content-Type", contentType)
+	c.Status(http.StatusOK)
+	tmpl.Execute(c.Writer, data)
+}
+
+// renderError 和接口使用相同的错误码返回错误，不存在时为 404，原始错误只输出到日志
+func renderError(c *gin.Context, err error) {
+	restapi.Fail(c, err)
+}
+
+// getPage 获取 page 查询参数，从 1 开始
+func getPage(c *gin.Context) int {
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil || page < 1 {
+		return 1
+	}
+	return page
+}
+
+// excerpt 截取文本的开头作为摘要
+func excerpt(s string, n int) string {
+	rs := []rune(strings.TrimSpace(s))
+	if len(rs) <= n {
+		return string(rs)
+	}
+	return string(rs[:n]) + "…"
+}
+
+// sharePage 渲染一个只读的会话分享页面
+func (p *pages) sharePage(c *gin.Context) {
+	s, err := p.store.GetShare(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		renderError(c, err)
+		return
+	}
+
+	view := pageView{
+		Title:       s.Title,
+		Description: excerpt(s.Summary, 160),
+		URL:         baseURL() + "/share/" + s.ID,
+		BaseURL:     baseURL(),
+		Share:       s,
+	}
+	for _, m := range s.Messages {
+		if view.Description != "" {
+			break
+		}
+		if m.Role != "user" {
+			view.Description = excerpt(m.Content, 160)
+		}
+	}
+
+	renderPage(c, webapp.ShareHTML, "text/html; charset=utf-8", &view)
+}
+
+// topicsPage 渲染公开主题列表，可以按分类过滤
+func (p *pages) topicsPage(c *gin.Context) {
+	category := c.Param("category")
+	page := getPage(c)
+	topics, err := p.store.ListPublicTopics(c.Request.Context(), category, (page-1)*pageSize, pageSize+1)
+	if err != nil {
+		renderError(c, err)
+		return
+	}
+
+	path := "/topics"
+	title := "Topics"
+	if category != "" {
+		path = "/c/" + category
+		title = category
+	}
+	view := pageView{
+		Title:       title,
+		Description: "ChatGPT Community " + title,
+		URL:         baseURL() + path,
+		BaseURL:     baseURL(),
+		Category:    category,
+		Page:        page,
+		Topics:      topics,
+	}
+	if page > 1 {
+		view.URL += fmt.Sprint("?page=", page)
+		view.PrevURL = fmt.Sprint(path, "?page=", page-1)
+	}
+	if len(topics) > pageSize {
+		view.Topics = topics[:pageSize]
+		view.NextURL = fmt.Sprint(path, "?page=", page+1)
+	}
+	view.Categories, err = p.store.ListPublicCategories(c.Request.Context())
+	if err != nil {
+		renderError(c, err)
+		return
+	}
+
+	renderPage(c, webapp.TopicsHTML, "text/html; charset=utf-8", &view)
+}
+
+// topicPage 渲染一个主题及其会话内容
+func (p *pages) topicPage(c *gin.Context) {
+	t, err := p.store.GetReadableTopic(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		renderError(c, err)
+		return
+	}
+	messages, err := p.store.GetConversationMessages(c.Request.Context(), t.ConversationID, false)
+	if err != nil {
+		renderError(c, err)
+		return
+	}
+
+	view := pageView{
+		Title:       t.Title,
+		Description: excerpt(t.Summary, 160),
+		URL:         baseURL() + "/topics/" + t.ID,
+		BaseURL:     baseURL(),
+		Category:    t.Category,
+		Topic:       t,
+		Messages:    messages,
+		User:        t.Edges.User,
+	}
+	for _, m := range messages {
+		if view.Description != "" {
+			break
+		}
+		if m.Role != "user" && m.DeletedAt == nil {
+			view.Description = excerpt(m.Content, 160)
+		}
+	}
+
+	renderPage(c, webapp.TopicHTML, "text/html; charset=utf-8", &view)
+}
+
+// userPage 渲染用户的公开资料和公开主题
+func (p *pages) userPage(c *gin.Context) {
+	u, err := p.store.GetUser(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		renderError(c, err)
+		return
+	}
+	page := getPage(c)
+	topics, err := p.store.ListUserPublicTopics(c.Request.Context(), u.ID, (page-1)*pageSize, pageSize+1)
+	if err != nil {
+		renderError(c, err)
+		return
+	}
+
+	path := "/u/" + u.ID
+	view := pageView{
+		Title:       u.Name,
+		Description: "ChatGPT Community " + u.Name,
+		URL:         baseURL() + path,
+		BaseURL:     baseURL(),
+		Page:        page,
+		Topics:      topics,
+		User:        u,
+	}
+	if page > 1 {
+		view.URL += fmt.Sprint("?page=", page)
+		view.PrevURL = fmt.Sprint(path, "?page=", page-1)
+	}
+	if len(topics) > pageSize {
+		view.Topics = topics[:pageSize]
+		view.NextURL = fmt.Sprint(path, "?page=", page+1)
+	}
+
+	renderPage(c, webapp.UserHTML, "text/html; charset=utf-8", &view)
+}
+
+// feedView 获取分类的 feed 数据，分类为 all 时包含所有分类
+func (p *pages) feedView(c *gin.Context) (*pageView, bool) {
+	category := c.Param("category")
+	if category == "all" {
+		category = ""
+	}
+	topics, err := p.store.ListPublicTopics(c.Request.Context(), category, 0, feedSize)
+	if err != nil {
+		renderError(c, err)
+		return nil, false
+	}
+
+	view := pageView{
+		Title:    "ChatGPT Community",
+		URL:      baseURL() + "/topics",
+		BaseURL:  baseURL(),
+		Category: c.Param("category"),
+		Topics:   topics,
+		Updated:  time.Now(),
+	}
+	if category != "" {
+		view.Title += " - " + category
+		view.URL = baseURL() + "/c/" + category
+	}
+	if len(topics) > 0 {
+		view.Updated = topics[0].UpdatedAt
+	}
+	return &view, true
+}
+
+// rssFeed 输出分类的 RSS 2.0 feed
+func (p *pages) rssFeed(c *gin.Context) {
+	if view, ok := p.feedView(c); ok {
+		renderPage(c, webapp.RSSXML, "application/rss+xml; charset=utf-8", view)
+	}
+}
+
+// atomFeed 输出分类的 Atom feed
+func (p *pages) atomFeed(c *gin.Context) {
+	if view, ok := p.feedView(c); ok {
+		renderPage(c, webapp.AtomXML, "application/atom+xml; charset=utf-8", view)
+	}
+}
+
+// sitemap 输出所有公开主题和分类的 sitemap.xml
+func (p *pages) sitemap(c *gin.Context) {
+	topics, err := p.store.ListPublicTopics(c.Request.Context(), "", 0, sitemapSize)
+	if err != nil {
+		renderError(c, err)
+		return
+	}
+	categories, err := p.store.ListPublicCategories(c.Request.Context())
+	if err != nil {
+		renderError(c, err)
+		return
+	}
+
+	view := pageView{
+		BaseURL:    baseURL(),
+		Topics:     topics,
+		Categories: categories,
+	}
+	renderPage(c, webapp.SitemapXML, "application/xml; charset=utf-8", &view)
+}
+
+// markdownCSS 输出 Markdown 代码高亮的样式表
+func markdownCSS(c *gin.Context) {
+	if !config.Debug {
+		c.Header("Cache-Control", "public, max-age=31536000")
+	}
+	c.Header("Content-Type", "text/css; charset=utf-8")
+	c.Status(http.StatusOK)
+	render.WriteCSS(c.Writer)
+}
+
+// robots 输出 robots.txt，禁止抓取 API，并声明 sitemap 的位置
+func robots(c *gin.Context) {
+	c.String(http.StatusOK, "User-agent: *\nDisallow: /api/\nAllow: /\n\nSitemap: %s/sitemap.xml\n", baseURL())
+}
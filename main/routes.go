@@ -0,0 +1,78 @@
+package main
+
+import (
+	"community.threetenth.chatgpt/restapi"
+	"github.com/gin-gonic/gin"
+)
+
+// routes 注册页面和接口的路由
+//
+// /api/v1 下的接口需要和 restapi/openapi.json 保持一致，routes_test.go 会检查两者是否一致。
+func routes(router *gin.Engine, api *restapi.API, p *pages) {
+	router.GET("/", web)
+	router.GET("/:pagename", web)
+	router.GET("/share/:slug", p.sharePage)
+	router.GET("/topics", p.topicsPage)
+	router.GET("/topics/:id", p.topicPage)
+	router.GET("/c/:category", p.topicsPage)
+	router.GET("/u/:id", p.userPage)
+	router.GET("/feed/rss/:category", p.rssFeed)
+	router.GET("/feed/atom/:category", p.atomFeed)
+	router.GET("/sitemap.xml", p.sitemap)
+	router.GET("/robots.txt", robots)
+	router.GET("/markdown.css", markdownCSS)
+	router.GET("/api/v1/openapi.json", api.GetOpenAPI)
+	router.POST("/api/v1/captcha", api.Require(restapi.PermModerate), api.PostCaptcha)
+	router.POST("/api/v1/account/register", api.PostAccountRegister)
+	router.GET("/api/v1/account/verify", api.GetAccountVerify)
+	router.POST("/api/v1/account/login", api.PostAccountLogin)
+	router.POST("/api/v1/account/logout", api.PostAccountLogout)
+	router.POST("/api/v1/account/password/forgot", api.PostAccountPasswordForgot)
+	router.POST("/api/v1/account/password/reset", api.PostAccountPasswordReset)
+	router.GET("/api/v1/account/oidc/login", api.GetOIDCLogin)
+	router.GET("/api/v1/account/oidc/callback", api.GetOIDCCallback)
+	router.POST("/api/v1/account/exports", api.Require(restapi.PermAccount), api.PostAccountExport)
+	router.GET("/api/v1/account/exports", api.Require(restapi.PermAccount), api.GetAccountExports)
+	router.GET("/api/v1/account/exports/:id/download", api.Require(restapi.PermAccount), api.GetAccountExportDownload)
+	router.POST("/api/v1/account/deletion", api.Require(restapi.PermAccount), api.PostAccountDeletion)
+	router.DELETE("/api/v1/account", api.Require(restapi.PermAccount), api.DeleteAccount)
+	router.GET("/api/v1/session", api.UpdateChatGPTSession)
+	router.POST("/api/v1/conversation", api.Require(restapi.PermConversation), api.PostChatGPTConversation)
+	router.GET("/api/v1/conversation", api.Require(restapi.PermRead), api.GetChatGPTConversation)
+	router.GET("/api/v1/conversation/:id/export", api.Require(restapi.PermRead), api.GetConversationExport)
+	router.GET("/api/v1/conversations", api.Require(restapi.PermRead), api.GetConversations)
+	router.POST("/api/v1/conversations/import", api.Require(restapi.PermConversation), api.PostConversationImport)
+	router.PATCH("/api/v1/conversations/:id", api.Require(restapi.PermConversation), api.PatchConversation)
+	router.DELETE("/api/v1/conversations/:id", api.Require(restapi.PermConversation), api.DeleteConversation)
+	router.POST("/api/v1/conversations/:id/restore", api.Require(restapi.PermConversation), api.PostConversationRestore)
+	router.GET("/api/v1/message", api.Require(restapi.PermRead), api.GetChatGPTMessage)
+	router.DELETE("/api/v1/messages/:id", api.Require(restapi.PermConversation), api.DeleteMessage)
+	router.POST("/api/v1/messages/:id/restore", api.Require(restapi.PermConversation), api.PostMessageRestore)
+	router.POST("/api/v1/topic", api.Require(restapi.PermTopic), api.PostTopic)
+	router.DELETE("/api/v1/topics/:id", api.Require(restapi.PermTopic), api.DeleteTopic)
+	router.POST("/api/v1/topics/:id/restore", api.Require(restapi.PermTopic), api.PostTopicRestore)
+	router.POST("/api/v1/share", api.Require(restapi.PermShare), api.PostShare)
+	router.DELETE("/api/v1/share", api.Require(restapi.PermShare), api.DeleteShare)
+	router.POST("/api/v1/report", api.Require(restapi.PermReport), api.PostReport)
+	router.GET("/api/v1/keys", api.Require(restapi.PermAPIKey), api.GetAPIKeys)
+	router.POST("/api/v1/keys", api.Require(restapi.PermAPIKey), api.PostAPIKey)
+	router.POST("/api/v1/keys/:id/rotate", api.Require(restapi.PermAPIKey), api.PostAPIKeyRotate)
+	router.DELETE("/api/v1/keys/:id", api.Require(restapi.PermAPIKey), api.DeleteAPIKey)
+
+	moderate := router.Group("/api/v1/admin", api.Require(restapi.PermModerate))
+	moderate.GET("/moderations", api.GetModerations)
+	moderate.POST("/moderations/:id", api.PostModerationReview)
+	moderate.GET("/reports", api.GetReports)
+	moderate.POST("/reports/:id", api.PostReportTriage)
+	moderate.POST("/messages/:id/hidden", api.PostAdminMessageHidden)
+	moderate.DELETE("/messages/:id", api.DeleteAdminMessage)
+	moderate.POST("/messages/:id/restore", api.PostAdminMessageRestore)
+	moderate.POST("/topics/:id/hidden", api.PostAdminTopicHidden)
+	moderate.POST("/topics/:id/locked", api.PostAdminTopicLocked)
+	moderate.DELETE("/topics/:id", api.DeleteAdminTopic)
+	moderate.POST("/topics/:id/restore", api.PostAdminTopicRestore)
+	router.GET("/api/v1/admin/audit", api.Require(restapi.PermAudit), api.GetAuditEvents)
+	router.GET("/api/v1/admin/export", api.Require(restapi.PermBulkExport), api.GetAdminExport)
+	router.POST("/api/v1/admin/users/:id/ban", api.Require(restapi.PermBanUser), api.PostAdminUserBan)
+	router.POST("/api/v1/admin/users/:id/role", api.Require(restapi.PermGrantRole), api.PostAdminUserRole)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"community.threetenth.chatgpt/restapi"
+	"github.com/gin-gonic/gin"
+)
+
+// TestRoutesMatchOpenAPI 检查 /api/v1 的路由和 OpenAPI 文档是否一致
+func TestRoutesMatchOpenAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes(router, restapi.New(nil), &pages{})
+
+	registered := make(map[string]bool)
+	for _, route := range router.Routes() {
+		if !strings.HasPrefix(route.Path, "/api/v1/") {
+			continue
+		}
+		// gin 的 :id 参数在 OpenAPI 中为 {id}
+		segments := strings.Split(route.Path, "/")
+		for i, segment := range segments {
+			if strings.HasPrefix(segment, ":") {
+				segments[i] = "{" + segment[1:] + "}"
+			}
+		}
+		registered[route.Method+" "+strings.Join(segments, "/")] = true
+	}
+
+	var spec struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(restapi.OpenAPI, &spec); err != nil {
+		t.Fatal(err)
+	}
+	documented := make(map[string]bool)
+	for path, operations := range spec.Paths {
+		for method := range operations {
+			documented[strings.ToUpper(method)+" "+path] = true
+		}
+	}
+
+	var missing, stale []string
+	for route := range registered {
+		if !documented[route] {
+			missing = append(missing, route)
+		}
+	}
+	for route := range documented {
+		if !registered[route] {
+			stale = append(stale, route)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(stale)
+	for _, route := range missing {
+		t.Errorf("route %s is not documented in restapi/openapi.json", route)
+	}
+	for _, route := range stale {
+		t.Errorf("restapi/openapi.json documents %s, but the route is not registered", route)
+	}
+}
+
+func TestCaptchaRequiresModerator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(restapi.ErrorHandler())
+	routes(router, restapi.New(nil), &pages{})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/captcha", strings.NewReader(`{"cfClearance":"x","userAgent":"y"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("anonymous POST /api/v1/captcha = %d %s, want 401", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,48 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"community.threetenth.chatgpt/regex"
+)
+
+// Blocklist 使用关键词和正则表达式审核文本
+type Blocklist struct {
+	keywords *regexp.Regexp
+	patterns []*regexp.Regexp
+	decision Decision
+}
+
+// NewBlocklist 创建一个关键词和正则表达式黑名单，命中时返回 decision
+func NewBlocklist(keywords, patterns []string, decision Decision) (*Blocklist, error) {
+	b := &Blocklist{decision: decision}
+	var err error
+	if b.keywords, err = regex.Keywords(keywords); err != nil {
+		return nil, err
+	}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("moderation: compile pattern %q: %w", pattern, err)
+		}
+		b.patterns = append(b.patterns, re)
+	}
+	return b, nil
+}
+
+// Moderate 实现 Moderator 接口
+func (b *Blocklist) Moderate(ctx context.Context, stage Stage, text string) (*Result, error) {
+	if b.keywords != nil {
+		if match := b.keywords.FindString(text); match != "" {
+			return &Result{Decision: b.decision, Reason: fmt.Sprintf("keyword %q", match), Provider: "blocklist"}, nil
+		}
+	}
+	for _, re := range b.patterns {
+		if re.MatchString(text) {
+			return &Result{Decision: b.decision, Reason: fmt.Sprintf("pattern %q", re.String()), Provider: "blocklist"}, nil
+		}
+	}
+	return nil, nil
+}
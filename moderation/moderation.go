@@ -0,0 +1,139 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+)
+
+// Stage 是内容被审核的阶段
+type Stage string
+
+const (
+	// StagePrompt 是用户的提问，在发送给 ChatGPT 之前审核
+	StagePrompt Stage = "prompt"
+	// StageAnswer 是 ChatGPT 的回复，在保存和发布之前审核
+	StageAnswer Stage = "answer"
+)
+
+// Decision 是审核的结果
+type Decision string
+
+const (
+	// Allow 允许发布
+	Allow Decision = "allow"
+	// Hold 暂不发布，等待管理员审核
+	Hold Decision = "hold"
+	// Reject 拒绝发布
+	Reject Decision = "reject"
+)
+
+// severity 用于比较多个结果的严格程度
+func (d Decision) severity() int {
+	switch d {
+	case Reject:
+		return 2
+	case Hold:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ParseDecision 解析配置中的审核结果，空字符串返回 def
+func ParseDecision(s string, def Decision) (Decision, error) {
+	switch d := Decision(s); d {
+	case "":
+		return def, nil
+	case Allow, Hold, Reject:
+		return d, nil
+	default:
+		return "", fmt.Errorf("moderation: unknown decision %q", s)
+	}
+}
+
+// Result 是一次审核的结果
+type Result struct {
+	Decision Decision `json:"decision"`
+	Reason   string   `json:"reason,omitempty"`
+	Provider string   `json:"provider,omitempty"`
+}
+
+// Moderator 审核一段文本
+//
+// 返回 nil 表示该审核者没有意见，即允许。
+type Moderator interface {
+	Moderate(ctx context.Context, stage Stage, text string) (*Result, error)
+}
+
+// Pipeline 依次执行多个审核者，返回最严格的结果
+//
+// 任何一个审核者拒绝时立即返回；审核者出错时，结果为等待人工审核。
+type Pipeline struct {
+	moderators []Moderator
+}
+
+// NewPipeline 创建一个审核流水线
+func NewPipeline(moderators ...Moderator) *Pipeline {
+	return &Pipeline{moderators: moderators}
+}
+
+// Moderate 审核一段文本，结果不会为 nil
+func (p *Pipeline) Moderate(ctx context.Context, stage Stage, text string) (*Result, error) {
+	result := &Result{Decision: Allow}
+	if p == nil {
+		return result, nil
+	}
+	for _, m := range p.moderators {
+		r, err := m.Moderate(ctx, stage, text)
+		if err != nil {
+			r = &Result{Decision: Hold, Reason: err.Error(), Provider: fmt.Sprintf("%T", m)}
+		}
+		if r == nil {
+			continue
+		}
+		if r.Decision.severity() > result.Decision.severity() {
+			result = r
+		}
+		if result.Decision == Reject {
+			break
+		}
+	}
+	return result, nil
+}
+
+// Config 是审核流水线的配置
+type Config struct {
+	Keywords          []string `json:"keywords"`           // 关键词黑名单，忽略大小写
+	Patterns          []string `json:"patterns"`           // 正则表达式黑名单
+	BlocklistDecision string   `json:"blocklist_decision"` // 命中黑名单时的结果，默认为 reject
+	OpenAIKey         string   `json:"openai_key"`         // OpenAI API key，为空时不使用 moderation API
+	OpenAIDecision    string   `json:"openai_decision"`    // 被 moderation API 标记时的结果，默认为 hold
+}
+
+// New 根据配置创建审核流水线，config 为 nil 时允许所有内容
+func New(config *Config) (*Pipeline, error) {
+	if config == nil {
+		return NewPipeline(), nil
+	}
+
+	var moderators []Moderator
+	if len(config.Keywords) > 0 || len(config.Patterns) > 0 {
+		decision, err := ParseDecision(config.BlocklistDecision, Reject)
+		if err != nil {
+			return nil, err
+		}
+		blocklist, err := NewBlocklist(config.Keywords, config.Patterns, decision)
+		if err != nil {
+			return nil, err
+		}
+		moderators = append(moderators, blocklist)
+	}
+	if config.OpenAIKey != "" {
+		decision, err := ParseDecision(config.OpenAIDecision, Hold)
+		if err != nil {
+			return nil, err
+		}
+		moderators = append(moderators, NewOpenAI(config.OpenAIKey, decision))
+	}
+	return NewPipeline(moderators...), nil
+}
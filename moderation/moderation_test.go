@@ -0,0 +1,67 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type moderatorFunc func(ctx context.Context, stage Stage, text string) (*Result, error)
+
+func (f moderatorFunc) Moderate(ctx context.Context, stage Stage, text string) (*Result, error) {
+	return f(ctx, stage, text)
+}
+
+func TestBlocklist(t *testing.T) {
+	p, err := New(&Config{
+		Keywords: []string{"Forbidden", "a.b"},
+		Patterns: []string{`\d{4}-\d{4}-\d{4}-\d{4}`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]Decision{
+		"hello":                    Allow,
+		"this is FORBIDDEN":        Reject,
+		"axb":                      Allow,
+		"a.b":                      Reject,
+		"card 1234-5678-9012-3456": Reject,
+	}
+	for text, want := range cases {
+		r, err := p.Moderate(context.Background(), StagePrompt, text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Decision != want {
+			t.Errorf("Moderate(%q) = %v, want %v", text, r.Decision, want)
+		}
+	}
+}
+
+func TestPipelineStrictest(t *testing.T) {
+	hold := moderatorFunc(func(ctx context.Context, stage Stage, text string) (*Result, error) {
+		return &Result{Decision: Hold, Reason: "hold"}, nil
+	})
+	failed := moderatorFunc(func(ctx context.Context, stage Stage, text string) (*Result, error) {
+		return nil, errors.New("unavailable")
+	})
+	reject := moderatorFunc(func(ctx context.Context, stage Stage, text string) (*Result, error) {
+		return &Result{Decision: Reject, Reason: "reject"}, nil
+	})
+
+	r, _ := NewPipeline(hold, reject, hold).Moderate(context.Background(), StageAnswer, "x")
+	if r.Decision != Reject || r.Reason != "reject" {
+		t.Errorf("got %+v, want reject", r)
+	}
+
+	r, _ = NewPipeline(failed).Moderate(context.Background(), StageAnswer, "x")
+	if r.Decision != Hold {
+		t.Errorf("got %+v, want hold on provider error", r)
+	}
+
+	r, _ = NewPipeline().Moderate(context.Background(), StageAnswer, "x")
+	if r.Decision != Allow {
+		t.Errorf("got %+v, want allow", r)
+	}
+}
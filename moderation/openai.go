@@ -0,0 +1,50 @@
+package moderation
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"community.threetenth.chatgpt/openai"
+)
+
+// OpenAI 使用 OpenAI 的 moderation API 审核文本
+type OpenAI struct {
+	apiKey   string
+	decision Decision
+	timeout  time.Duration
+}
+
+// NewOpenAI 创建一个 OpenAI moderation API 审核者，文本被标记时返回 decision
+func NewOpenAI(apiKey string, decision Decision) *OpenAI {
+	return &OpenAI{apiKey: apiKey, decision: decision, timeout: 10 * time.Second}
+}
+
+// Moderate 实现 Moderator 接口
+func (o *OpenAI) Moderate(ctx context.Context, stage Stage, text string) (*Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	response, err := openai.PostModeration(ctx, o.apiKey, text)
+	if err != nil {
+		return nil, err
+	}
+
+	var categories []string
+	for _, result := range response.Results {
+		if !result.Flagged {
+			continue
+		}
+		for category, flagged := range result.Categories {
+			if flagged {
+				categories = append(categories, category)
+			}
+		}
+	}
+	if len(categories) == 0 {
+		return nil, nil
+	}
+	sort.Strings(categories)
+	return &Result{Decision: o.decision, Reason: strings.Join(categories, ","), Provider: "openai"}, nil
+}
@@ -2,6 +2,7 @@ package openai
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -24,11 +25,20 @@ type User struct {
 }
 
 // Token OpenAI 的用户访问令牌及身份信息
+//
+// 访问令牌和会话令牌是加密的凭据，不会序列化到 JSON 中。
 type Token struct {
-	User         *User     `json:"user"`         // User 包含有关用户的信息。
-	Expires      time.Time `json:"expires"`      // Expires 是访问令牌的过期时间。
-	AccessToken  string    `json:"accessToken"`  // AccessToken 是访问令牌。
-	SessionToken string    `json:"sessionToken"` // SessionToken 是会话令牌。
+	User         *User      `json:"user"`    // User 包含有关用户的信息。
+	Expires      time.Time  `json:"expires"` // Expires 是访问令牌的过期时间。
+	AccessToken  Credential `json:"-"`       // AccessToken 是加密的访问令牌。
+	SessionToken Credential `json:"-"`       // SessionToken 是加密的会话令牌。
+}
+
+// sessionResponse 是 /api/auth/session 返回的明文数据，只在 openai 包中使用
+type sessionResponse struct {
+	User        *User     `json:"user"`
+	Expires     time.Time `json:"expires"`
+	AccessToken string    `json:"accessToken"`
 }
 
 // ChatMessage 表示会话中的单条消息的 JSON 数据。
@@ -93,8 +103,12 @@ func (err *HTTPStatusError) Error() string {
 
 var chatGPTClient = &http.Client{}
 
-func getChatGPTConversationRespnose(accessToken string, chatRequestBody *ChatRequestBody, contentType string) (*http.Response, error) {
+func getChatGPTConversationRespnose(ctx context.Context, accessToken Credential, chatRequestBody *ChatRequestBody, contentType string) (*http.Response, error) {
 	postURL := "https://chat.openai.com/backend-api/conversation"
+	token, err := open(accessToken)
+	if err != nil {
+		return nil, err
+	}
 	requestBody := chatRequestBody
 	requestBodyJSON, err := json.Marshal(&requestBody)
 	if err != nil {
@@ -103,11 +117,11 @@ func getChatGPTConversationRespnose(accessToken string, chatRequestBody *ChatReq
 	body := string(requestBodyJSON)
 	// fmt.Println(body)
 
-	req, err := http.NewRequest("POST", postURL, strings.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", postURL, strings.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("authorization", "Bearer "+accessToken)
+	req.Header.Set("authorization", "Bearer "+token)
 	req.Header.Set("accept", contentType)
 	req.Header.Set("content-type", "application/json")
 	// req.Header.Set("Host", "ask.openai.com")
@@ -122,9 +136,9 @@ func getChatGPTConversationRespnose(accessToken string, chatRequestBody *ChatReq
 
 // PostChatGPTStream 提交一个 https://chat.openai.com/backend-api/conversation 请求
 // 并获取一个 "text/event-stream" 格式的回复
-func PostChatGPTStream(accessToken string, chatRequestBody *ChatRequestBody, onConnectioned func(), stream func(msg *ChatResponseBody) (bool, error)) (*ChatResponseBody, error) {
+func PostChatGPTStream(accessToken Credential, chatRequestBody *ChatRequestBody, onConnectioned func(), stream func(msg *ChatResponseBody) (bool, error)) (*ChatResponseBody, error) {
 	// 发起请求
-	response, err := getChatGPTConversationRespnose(accessToken, chatRequestBody, "text/event-stream")
+	response, err := getChatGPTConversationRespnose(context.Background(), accessToken, chatRequestBody, "text/event-stream")
 	if err != nil {
 		// 处理错误
 		return nil, err
@@ -175,9 +189,9 @@ func PostChatGPTStream(accessToken string, chatRequestBody *ChatRequestBody, onC
 }
 
 // PostChatGPTText 提交一个 https://chat.openai.com/backend-api/conversation 请求
-// 并获取一个 "application/json" 格式的回复
-func PostChatGPTText(accessToken string, chatRequestBody *ChatRequestBody) (*ChatResponseBody, error) {
-	response, err := getChatGPTConversationRespnose(accessToken, chatRequestBody, "application/json")
+// 并获取一个 "application/json" 格式的回复，ctx 取消时中断请求
+func PostChatGPTText(ctx context.Context, accessToken Credential, chatRequestBody *ChatRequestBody) (*ChatResponseBody, error) {
+	response, err := getChatGPTConversationRespnose(ctx, accessToken, chatRequestBody, "application/json")
 	if err != nil {
 		return nil, err
 	}
@@ -213,6 +227,36 @@ func PostChatGPTText(accessToken string, chatRequestBody *ChatRequestBody) (*Cha
 	return &msg, nil
 }
 
+// HideChatGPTConversation 在 ChatGPT 的会话列表中隐藏一个会话
+//
+// 与 ChatGPT 页面中删除会话的效果相同，用于清理服务端发起的辅助会话。
+func HideChatGPTConversation(ctx context.Context, accessToken Credential, conversationID string) error {
+	token, err := open(accessToken)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", "https://chat.openai.com/backend-api/conversation/"+conversationID, strings.NewReader(`{"is_visible":false}`))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("authorization", "Bearer "+token)
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/16.1 Safari/605.1.15")
+
+	response, err := chatGPTClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		resBodyBytes, _ := ioutil.ReadAll(response.Body)
+		return &HTTPStatusError{response.StatusCode, response.Status, string(resBodyBytes)}
+	}
+	return nil
+}
+
 var sessionRequestHeader = map[string]string{
 	"Host":            "ask.openai.com",
 	"Connection":      "keep-alive",
@@ -224,14 +268,28 @@ var sessionRequestHeader = map[string]string{
 	"Accept-Encoding": "gzip, deflate, br",
 }
 
-var cloudflareClearance, captchaUserAgent string
+var cloudflareClearance Credential
+var captchaUserAgent string
+
+// RestoreCloudflareCaptcha 恢复之前保存的 cf 验证码数据
+func RestoreCloudflareCaptcha(cfClearance Credential, userAgent string) {
+	cloudflareClearance = cfClearance
+	captchaUserAgent = userAgent
+}
 
 // UpdateCloudflareCaptcha is 更新 cf 的验证码数据
-func UpdateCloudflareCaptcha(cfClearance, userAgent string) error {
+//
+// 验证成功后返回加密的 cf_clearance，用于持久化保存。
+func UpdateCloudflareCaptcha(cfClearance, userAgent string) (Credential, error) {
+	sealed, err := Seal(cfClearance)
+	if err != nil {
+		return "", err
+	}
+
 	// 创建一个带 cookie 的 HTTP GET 请求
 	req, err := http.NewRequest("GET", "https://chat.openai.com/chat", nil)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	req.Header.Set("user-agent", userAgent)
@@ -242,29 +300,47 @@ func UpdateCloudflareCaptcha(cfClearance, userAgent string) error {
 	// 发送请求
 	response, err := chatGPTClient.Do(req)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer response.Body.Close()
 
 	resBodyBytes, err := ioutil.ReadAll(response.Body)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return &HTTPStatusError{response.StatusCode, response.Status, string(resBodyBytes)}
+		return "", &HTTPStatusError{response.StatusCode, response.Status, string(resBodyBytes)}
 	}
 
-	cloudflareClearance = cfClearance
-	captchaUserAgent = userAgent
+	RestoreCloudflareCaptcha(sealed, userAgent)
 
-	return nil
+	return sealed, nil
+}
+
+// RefreshChatGPTSession 使用保存的会话令牌重新获取访问令牌
+func RefreshChatGPTSession(sessionToken Credential) (*Token, error) {
+	token, err := open(sessionToken)
+	if err != nil {
+		return nil, err
+	}
+	return UpdateChatGPTSession(token)
 }
 
 // UpdateChatGPTSession 更新 chat gpt 认证信息
+//
+// 返回的访问令牌和会话令牌都已经加密。
 func UpdateChatGPTSession(sessionToken string) (*Token, error) {
 	sessionURL := "https://chat.openai.com/api/auth/session"
 
+	cfClearance := ""
+	if cloudflareClearance != "" {
+		var err error
+		if cfClearance, err = open(cloudflareClearance); err != nil {
+			return nil, err
+		}
+	}
+
 	// 创建一个带 cookie 的 HTTP GET 请求
 	req, err := http.NewRequest("GET", sessionURL, nil)
 	if err != nil {
@@ -279,7 +355,7 @@ func UpdateChatGPTSession(sessionToken string) (*Token, error) {
 
 	// 设置请求的 cookie
 	req.AddCookie(&http.Cookie{Name: "__Secure-next-auth.session-token", Value: sessionToken})
-	req.AddCookie(&http.Cookie{Name: "cf_clearance", Value: cloudflareClearance})
+	req.AddCookie(&http.Cookie{Name: "cf_clearance", Value: cfClearance})
 
 	// 发送请求
 	response, err := chatGPTClient.Do(req)
@@ -297,20 +373,27 @@ func UpdateChatGPTSession(sessionToken string) (*Token, error) {
 		return nil, &HTTPStatusError{response.StatusCode, response.Status, string(resBodyBytes)}
 	}
 
-	session := Token{}
+	session := sessionResponse{}
 	if err = json.Unmarshal(resBodyBytes, &session); err != nil {
 		return nil, err
 	}
+	if session.User == nil || session.AccessToken == "" {
+		return nil, &HTTPStatusError{http.StatusUnauthorized, "401 Unauthorized", "session token is invalid or expired"}
+	}
 
 	cookies := response.Cookies()
 	for _, cookie := range cookies {
 		if cookie.Name == "__Secure-next-auth.session-token" {
-			session.SessionToken = cookie.Value
+			sessionToken = cookie.Value
 		}
 	}
-	if session.SessionToken == "" {
-		session.SessionToken = sessionToken
-	}
 
-	return &session, nil
+	token := Token{User: session.User, Expires: session.Expires}
+	if token.AccessToken, err = Seal(session.AccessToken); err != nil {
+		return nil, err
+	}
+	if token.SessionToken, err = Seal(sessionToken); err != nil {
+		return nil, err
+	}
+	return &token, nil
 }
@@ -1,8 +1,12 @@
 package openai
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -21,7 +25,7 @@ func TestChatGPT(t *testing.T) {
 		t.Log(err)
 		return
 	}
-	result, err := PostChatGPTText(token.AccessToken, getTestChatRequestJSON("", testUUID(), "请你简单的说一下植物对气候的贡献。"))
+	result, err := PostChatGPTText(context.Background(), token.AccessToken, getTestChatRequestJSON("", testUUID(), "请你简单的说一下植物对气候的贡献。"))
 	if err != nil {
 		t.Log(err)
 	} else {
@@ -85,3 +89,40 @@ func getTestChatRequestJSON(conversationID, parentID, text string) *ChatRequestB
 		Model:           "text-davinci-002-render",
 	}
 }
+
+// roundTripFunc 是测试使用的 http.RoundTripper
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestPostChatGPTTextContext(t *testing.T) {
+	client := chatGPTClient
+	t.Cleanup(func() { chatGPTClient = client })
+	// 上游一直没有回复，直到请求的 ctx 取消
+	chatGPTClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})}
+	token, err := Seal("access-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		_, err := PostChatGPTText(ctx, token, getTestChatRequestJSON("", testUUID(), "hello"))
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("PostChatGPTText() error = %v, want context.DeadlineExceeded", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("PostChatGPTText() did not return after the context expired")
+	}
+}
@@ -0,0 +1,205 @@
+package openai
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidCredential 加密的凭据格式错误、密钥不存在或者解密失败
+var ErrInvalidCredential = errors.New("openai: invalid credential")
+
+// Credential 是使用 AES-GCM 加密的上游凭据，例如 sessionToken、accessToken 和 cf_clearance
+//
+// 格式为 "密钥 ID:base64(nonce + 密文)"，只有 openai 包在发送请求时解密，
+// 其他包只能保存和传递密文，不会在日志和接口返回的 JSON 中出现明文。
+type Credential string
+
+// KeyID 返回加密凭据使用的密钥 ID
+func (c Credential) KeyID() string {
+	i := strings.IndexByte(string(c), ':')
+	if i < 0 {
+		return ""
+	}
+	return string(c[:i])
+}
+
+// KeyringConfig 是加密凭据的密钥配置
+//
+// Keys 是密钥 ID 到 base64 编码的 32 字节 AES-256 密钥，ActiveKey 是加密新凭据使用的密钥 ID。
+// 轮换密钥时添加新密钥并修改 ActiveKey，保留旧密钥直到所有凭据都重新加密。
+// KeyFile 是保存相同 JSON 结构的密钥文件，其中的密钥会合并到 Keys 中。
+type KeyringConfig struct {
+	ActiveKey string            `json:"active_key"`
+	Keys      map[string]string `json:"keys"`
+	KeyFile   string            `json:"key_file"`
+}
+
+// Keyring 保存加密凭据使用的密钥
+type Keyring struct {
+	active string
+	aeads  map[string]cipher.AEAD
+}
+
+// NewKeyring 使用 AES-256 密钥创建 Keyring
+func NewKeyring(active string, keys map[string][]byte) (*Keyring, error) {
+	k := &Keyring{active: active, aeads: make(map[string]cipher.AEAD, len(keys))}
+	for id, key := range keys {
+		if id == "" || strings.ContainsRune(id, ':') {
+			return nil, fmt.Errorf("openai: invalid key id %q", id)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("openai: key %q must be 32 bytes", id)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		if k.aeads[id], err = cipher.NewGCM(block); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := k.aeads[active]; !ok {
+		return nil, fmt.Errorf("openai: active key %q not found", active)
+	}
+	return k, nil
+}
+
+// LoadKeyring 根据配置创建 Keyring
+func LoadKeyring(config *KeyringConfig) (*Keyring, error) {
+	keys := make(map[string]string)
+	active := config.ActiveKey
+	if config.KeyFile != "" {
+		bs, err := os.ReadFile(config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		var file KeyringConfig
+		if err = json.Unmarshal(bs, &file); err != nil {
+			return nil, err
+		}
+		for id, key := range file.Keys {
+			keys[id] = key
+		}
+		if active == "" {
+			active = file.ActiveKey
+		}
+	}
+	for id, key := range config.Keys {
+		keys[id] = key
+	}
+
+	decoded := make(map[string][]byte, len(keys))
+	for id, key := range keys {
+		bs, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("openai: key %q: %v", id, err)
+		}
+		decoded[id] = bs
+	}
+	return NewKeyring(active, decoded)
+}
+
+// RandomKeyring 创建一个使用随机密钥的 Keyring，重启后之前加密的凭据无法解密
+func RandomKeyring() (*Keyring, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return NewKeyring("random", map[string][]byte{"random": key})
+}
+
+// ActiveKey 返回加密新凭据使用的密钥 ID
+func (k *Keyring) ActiveKey() string {
+	return k.active
+}
+
+// Seal 使用当前密钥加密凭据
+func (k *Keyring) Seal(plaintext string) (Credential, error) {
+	aead := k.aeads[k.active]
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	// 密钥 ID 作为附加数据，密文不能被替换到其他密钥下
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(k.active))
+	return Credential(k.active + ":" + base64.RawStdEncoding.EncodeToString(sealed)), nil
+}
+
+// open 解密凭据，只在 openai 包中发送请求时使用
+func (k *Keyring) open(c Credential) (string, error) {
+	id := c.KeyID()
+	aead, ok := k.aeads[id]
+	if !ok {
+		return "", ErrInvalidCredential
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(string(c[len(id)+1:]))
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrInvalidCredential
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(id))
+	if err != nil {
+		return "", ErrInvalidCredential
+	}
+	return string(plaintext), nil
+}
+
+// Reseal 使用当前密钥重新加密凭据，已经使用当前密钥时原样返回
+func (k *Keyring) Reseal(c Credential) (Credential, error) {
+	if c.KeyID() == k.active {
+		return c, nil
+	}
+	plaintext, err := k.open(c)
+	if err != nil {
+		return "", err
+	}
+	return k.Seal(plaintext)
+}
+
+var keyring *Keyring
+var keyringMutex sync.RWMutex
+
+// SetKeyring 设置加密凭据使用的 Keyring
+func SetKeyring(k *Keyring) {
+	keyringMutex.Lock()
+	defer keyringMutex.Unlock()
+	keyring = k
+}
+
+// CurrentKeyring 返回当前的 Keyring，没有设置时使用随机密钥
+func CurrentKeyring() *Keyring {
+	keyringMutex.RLock()
+	k := keyring
+	keyringMutex.RUnlock()
+	if k != nil {
+		return k
+	}
+
+	keyringMutex.Lock()
+	defer keyringMutex.Unlock()
+	if keyring == nil {
+		var err error
+		if keyring, err = RandomKeyring(); err != nil {
+			panic(err)
+		}
+	}
+	return keyring
+}
+
+// Seal 使用当前的 Keyring 加密凭据
+func Seal(plaintext string) (Credential, error) {
+	return CurrentKeyring().Seal(plaintext)
+}
+
+// open 使用当前的 Keyring 解密凭据
+func open(c Credential) (string, error) {
+	return CurrentKeyring().open(c)
+}
@@ -0,0 +1,53 @@
+package openai
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestKeyring(t *testing.T) {
+	old, err := NewKeyring("k1", map[string][]byte{"k1": bytes.Repeat([]byte{1}, 32)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := old.Seal("secret-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sealed.KeyID() != "k1" || strings.Contains(string(sealed), "secret-token") {
+		t.Errorf("Seal() = %q", sealed)
+	}
+	if plaintext, err := old.open(sealed); err != nil || plaintext != "secret-token" {
+		t.Errorf("open() = %q, %v", plaintext, err)
+	}
+
+	// 轮换密钥后，旧密钥加密的凭据仍然可以解密，并且可以使用新密钥重新加密
+	rotated, err := NewKeyring("k2", map[string][]byte{
+		"k1": bytes.Repeat([]byte{1}, 32),
+		"k2": bytes.Repeat([]byte{2}, 32),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resealed, err := rotated.Reseal(sealed)
+	if err != nil || resealed.KeyID() != "k2" {
+		t.Fatalf("Reseal() = %q, %v", resealed, err)
+	}
+	if plaintext, err := rotated.open(resealed); err != nil || plaintext != "secret-token" {
+		t.Errorf("open() = %q, %v", plaintext, err)
+	}
+	if _, err := old.open(resealed); err != ErrInvalidCredential {
+		t.Errorf("unknown key: %v", err)
+	}
+
+	// 密钥 ID 是附加数据，修改密钥 ID 后无法解密
+	tampered := Credential("k2" + string(sealed[2:]))
+	if _, err := rotated.open(tampered); err != ErrInvalidCredential {
+		t.Errorf("tampered key id: %v", err)
+	}
+
+	if _, err := NewKeyring("k1", map[string][]byte{"k1": []byte("short")}); err == nil {
+		t.Error("short key must fail")
+	}
+}
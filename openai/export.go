@@ -0,0 +1,193 @@
+package openai
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ExportedConversation 是 ChatGPT 导出数据 conversations.json 中的一个会话
+//
+// mapping 中的节点和 ChatResponseMessage 一样通过 parent 组成一棵树，每次重新生成回复都会产生一个新的分支。
+type ExportedConversation struct {
+	ID             string                   `json:"id"`
+	ConversationID string                   `json:"conversation_id"`
+	Title          string                   `json:"title"`
+	CreateTime     float64                  `json:"create_time"`
+	UpdateTime     float64                  `json:"update_time"`
+	Mapping        map[string]*ExportedNode `json:"mapping"`
+	CurrentNode    string                   `json:"current_node"`
+}
+
+// ExportedNode 是会话树中的一个节点，根节点和部分系统节点没有消息
+type ExportedNode struct {
+	ID       string           `json:"id"`
+	Message  *ExportedMessage `json:"message"`
+	Parent   string           `json:"parent"`
+	Children []string         `json:"children"`
+}
+
+// ExportedMessage 是导出数据中的一条消息
+type ExportedMessage struct {
+	ID     string `json:"id"`
+	Author struct {
+		Role string `json:"role"`
+	} `json:"author"`
+	CreateTime float64 `json:"create_time"`
+	Content    struct {
+		ContentType string `json:"content_type"`
+		// Parts 中除了文本还可能有图片等对象，只导入文本
+		Parts []interface{} `json:"parts"`
+	} `json:"content"`
+	// Recipient 不是 all 的消息是发给插件或代码解释器的调用
+	Recipient string `json:"recipient"`
+	Metadata  struct {
+		ModelSlug                        string `json:"model_slug"`
+		IsVisuallyHiddenFromConversation bool   `json:"is_visually_hidden_from_conversation"`
+	} `json:"metadata"`
+}
+
+// TreeMessage 是从会话树中还原的一条消息，ParentMessageID 指向树中最近的一条保留的祖先消息
+type TreeMessage struct {
+	ID              string
+	Role            string
+	Content         string
+	ParentMessageID string
+	CreatedAt       time.Time
+}
+
+// ReadConversations 读取 ChatGPT 导出数据中的 conversations.json
+func ReadConversations(r io.Reader) ([]*ExportedConversation, error) {
+	var conversations []*ExportedConversation
+	if err := json.NewDecoder(r).Decode(&conversations); err != nil {
+		return nil, err
+	}
+	return conversations, nil
+}
+
+// UpstreamID 返回会话在 ChatGPT 中的 ID，早期的导出数据只有 id
+func (c *ExportedConversation) UpstreamID() string {
+	if c.ConversationID != "" {
+		return c.ConversationID
+	}
+	return c.ID
+}
+
+// Created 返回会话的创建时间
+func (c *ExportedConversation) Created() time.Time {
+	return unixTime(c.CreateTime)
+}
+
+// Updated 返回会话最后更新的时间，没有时使用创建时间
+func (c *ExportedConversation) Updated() time.Time {
+	if c.UpdateTime == 0 {
+		return c.Created()
+	}
+	return unixTime(c.UpdateTime)
+}
+
+// Model 返回当前分支最后一条回复使用的模型
+func (c *ExportedConversation) Model() string {
+	seen := map[string]bool{}
+	for id := c.CurrentNode; id != "" && !seen[id]; {
+		seen[id] = true
+		node := c.Mapping[id]
+		if node == nil {
+			break
+		}
+		if m := node.Message; m != nil && m.Author.Role == "assistant" && m.Metadata.ModelSlug != "" {
+			return m.Metadata.ModelSlug
+		}
+		id = node.Parent
+	}
+	return ""
+}
+
+// Messages 按父消息在前的顺序返回会话树中用户的提问和 ChatGPT 的回复，包括所有分支
+//
+// 系统消息、插件调用和没有文本的消息不会返回，它们的子消息改为回复最近的一条保留的祖先消息。
+func (c *ExportedConversation) Messages() []*TreeMessage {
+	var roots []string
+	for id, node := range c.Mapping {
+		if node.Parent == "" || c.Mapping[node.Parent] == nil {
+			roots = append(roots, id)
+		}
+	}
+	sort.Strings(roots)
+
+	type item struct {
+		id       string
+		parentID string
+	}
+	var messages []*TreeMessage
+	visited := map[string]bool{}
+	queue := make([]item, 0, len(c.Mapping))
+	for _, id := range roots {
+		queue = append(queue, item{id: id})
+	}
+	for len(queue) > 0 {
+		it := queue[0]
+		queue = queue[1:]
+		node := c.Mapping[it.id]
+		if node == nil || visited[it.id] {
+			continue
+		}
+		visited[it.id] = true
+
+		parentID := it.parentID
+		if m := c.treeMessage(node, parentID); m != nil {
+			messages = append(messages, m)
+			parentID = m.ID
+		}
+		for _, child := range node.Children {
+			queue = append(queue, item{id: child, parentID: parentID})
+		}
+	}
+	return messages
+}
+
+// treeMessage 将节点转换为 TreeMessage，不需要导入的节点返回 nil
+func (c *ExportedConversation) treeMessage(node *ExportedNode, parentID string) *TreeMessage {
+	m := node.Message
+	if m == nil || (m.Author.Role != "user" && m.Author.Role != "assistant") {
+		return nil
+	}
+	if m.Metadata.IsVisuallyHiddenFromConversation || (m.Recipient != "" && m.Recipient != "all") {
+		return nil
+	}
+	var parts []string
+	for _, part := range m.Content.Parts {
+		if s, ok := part.(string); ok && s != "" {
+			parts = append(parts, s)
+		}
+	}
+	content := strings.Join(parts, "\n")
+	if strings.TrimSpace(content) == "" {
+		return nil
+	}
+
+	id := m.ID
+	if id == "" {
+		id = node.ID
+	}
+	createdAt := c.Created()
+	if m.CreateTime != 0 {
+		createdAt = unixTime(m.CreateTime)
+	}
+	return &TreeMessage{
+		ID:              id,
+		Role:            m.Author.Role,
+		Content:         content,
+		ParentMessageID: parentID,
+		CreatedAt:       createdAt,
+	}
+}
+
+// unixTime 将导出数据中带小数的 Unix 时间戳转换为 time.Time
+func unixTime(seconds float64) time.Time {
+	sec, frac := math.Modf(seconds)
+	return time.Unix(int64(sec), int64(frac*1e9)).Truncate(time.Microsecond)
+}
@@ -0,0 +1,47 @@
+package openai
+
+import (
+	"strings"
+	"testing"
+)
+
+const testConversations = `[{
+	"title": "植物与气候",
+	"create_time": 1680000000.5,
+	"update_time": 1680000100,
+	"conversation_id": "c1",
+	"current_node": "a2",
+	"mapping": {
+		"root": {"id": "root", "message": null, "parent": null, "children": ["sys"]},
+		"sys": {"id": "sys", "message": {"id": "sys", "author": {"role": "system"}, "content": {"content_type": "text", "parts": [""]}}, "parent": "root", "children": ["q1"]},
+		"q1": {"id": "q1", "message": {"id": "q1", "author": {"role": "user"}, "create_time": 1680000001, "content": {"content_type": "text", "parts": ["植物对气候有什么贡献？"]}, "recipient": "all"}, "parent": "sys", "children": ["a1", "tool"]},
+		"a1": {"id": "a1", "message": {"id": "a1", "author": {"role": "assistant"}, "create_time": 1680000002, "content": {"content_type": "text", "parts": ["吸收二氧化碳。"]}, "recipient": "all", "metadata": {"model_slug": "text-davinci-002-render-sha"}}, "parent": "q1", "children": []},
+		"tool": {"id": "tool", "message": {"id": "tool", "author": {"role": "assistant"}, "content": {"content_type": "code", "text": "search()"}, "recipient": "browser"}, "parent": "q1", "children": ["a2"]},
+		"a2": {"id": "a2", "message": {"id": "a2", "author": {"role": "assistant"}, "create_time": 1680000003, "content": {"content_type": "multimodal_text", "parts": [{"asset_pointer": "file-1"}, "还能调节降水。"]}, "recipient": "all", "metadata": {"model_slug": "gpt-4"}}, "parent": "tool", "children": []}
+	}
+}]`
+
+func TestReadConversations(t *testing.T) {
+	conversations, err := ReadConversations(strings.NewReader(testConversations))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conversations) != 1 {
+		t.Fatalf("conversations = %d", len(conversations))
+	}
+	c := conversations[0]
+	if c.UpstreamID() != "c1" || c.Model() != "gpt-4" || c.Created().Unix() != 1680000000 {
+		t.Errorf("conversation = %s, %s, %s", c.UpstreamID(), c.Model(), c.Created())
+	}
+
+	// 系统消息和插件调用不导入，它们的子消息回复最近的祖先
+	messages := c.Messages()
+	got := make([]string, len(messages))
+	for i, m := range messages {
+		got[i] = m.ID + "<" + m.ParentMessageID + ":" + m.Content
+	}
+	want := []string{"q1<:植物对气候有什么贡献？", "a1<q1:吸收二氧化碳。", "a2<q1:还能调节降水。"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("messages = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,59 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// ModerationResult 是 /v1/moderations 对单条输入的审核结果
+type ModerationResult struct {
+	Flagged        bool               `json:"flagged"`         // 是否违反使用政策
+	Categories     map[string]bool    `json:"categories"`      // 各个分类是否违规
+	CategoryScores map[string]float64 `json:"category_scores"` // 各个分类的分数
+}
+
+// ModerationResponse 是 /v1/moderations 的回复结构体
+type ModerationResponse struct {
+	ID      string              `json:"id"`
+	Model   string              `json:"model"`
+	Results []*ModerationResult `json:"results"`
+}
+
+// PostModeration 调用 https://api.openai.com/v1/moderations 审核一段文本
+func PostModeration(ctx context.Context, apiKey, input string) (*ModerationResponse, error) {
+	requestBodyJSON, err := json.Marshal(map[string]string{"input": input})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.openai.com/v1/moderations", strings.NewReader(string(requestBodyJSON)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("authorization", "Bearer "+apiKey)
+	req.Header.Set("content-type", "application/json")
+
+	response, err := chatGPTClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	resBodyBytes, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, &HTTPStatusError{response.StatusCode, response.Status, string(resBodyBytes)}
+	}
+
+	moderation := ModerationResponse{}
+	if err = json.Unmarshal(resBodyBytes, &moderation); err != nil {
+		return nil, err
+	}
+	return &moderation, nil
+}
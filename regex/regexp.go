@@ -1,6 +1,25 @@
 package regex
 
-import "regexp"
+import (
+	"regexp"
+	"strings"
+)
 
 // MultBlankLines 是多个空行的正则表达式
 var MultBlankLines = regexp.MustCompile(`\n+`)
+
+// Keywords 将关键词列表编译为一个忽略大小写的正则表达式
+//
+// 关键词中的正则特殊字符会被转义，空的关键词会被忽略。没有关键词时返回 nil。
+func Keywords(words []string) (*regexp.Regexp, error) {
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil, nil
+	}
+	return regexp.Compile(`(?i)` + strings.Join(quoted, "|"))
+}
@@ -0,0 +1,103 @@
+package render
+
+import (
+	"bytes"
+	"html/template"
+	"io"
+	"regexp"
+
+	"github.com/alecthomas/chroma"
+	chromahtml "github.com/alecthomas/chroma/formatters/html"
+	"github.com/alecthomas/chroma/lexers"
+	"github.com/alecthomas/chroma/styles"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// CodeStyle 是代码高亮使用的 chroma 样式
+var CodeStyle = styles.Get("github")
+
+var codeFormatter = chromahtml.New(chromahtml.WithClasses(true))
+
+// classRegexp 是白名单允许的 class 属性，用于代码高亮和公式
+var classRegexp = regexp.MustCompile(`^[a-zA-Z0-9_ -]+$`)
+
+var markdown = goldmark.New(
+	goldmark.WithExtensions(
+		extension.GFM,
+		MathExtension,
+	),
+	goldmark.WithRendererOptions(
+		renderer.WithNodeRenderers(util.Prioritized(&codeRenderer{}, 100)),
+	),
+)
+
+var policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(classRegexp).OnElements("span", "pre", "code", "div")
+	return p
+}
+
+// Markdown 将 Markdown 渲染为经过白名单过滤的 HTML
+//
+// 原始 HTML 不会被输出，代码块使用 chroma 高亮，公式输出为 \( \) 和 \[ \] 由前端排版。
+// 返回值可以直接在 html/template 中使用。
+func Markdown(source string) (template.HTML, error) {
+	var buf bytes.Buffer
+	if err := markdown.Convert([]byte(source), &buf); err != nil {
+		return "", err
+	}
+	return template.HTML(policy.SanitizeBytes(buf.Bytes())), nil
+}
+
+// WriteCSS 输出代码高亮的样式表
+func WriteCSS(w io.Writer) error {
+	return codeFormatter.WriteCSS(w, CodeStyle)
+}
+
+type codeRenderer struct{}
+
+func (r *codeRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindFencedCodeBlock, r.renderFencedCodeBlock)
+}
+
+// renderFencedCodeBlock 高亮代码块，没有指定语言时自动识别
+func (r *codeRenderer) renderFencedCodeBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*ast.FencedCodeBlock)
+
+	var code bytes.Buffer
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		code.Write(line.Value(source))
+	}
+
+	var lexer chroma.Lexer
+	if language := n.Language(source); language != nil {
+		lexer = lexers.Get(string(language))
+	}
+	if lexer == nil {
+		lexer = lexers.Analyse(code.String())
+	}
+	if lexer == nil {
+		lexer = lexers.Fallback
+	}
+
+	iterator, err := chroma.Coalesce(lexer).Tokenise(nil, code.String())
+	if err != nil {
+		return ast.WalkStop, err
+	}
+	if err = codeFormatter.Format(w, CodeStyle, iterator); err != nil {
+		return ast.WalkStop, err
+	}
+	return ast.WalkSkipChildren, nil
+}
@@ -0,0 +1,46 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdownSanitize(t *testing.T) {
+	html, err := Markdown("<script>alert(1)</script>\n\n[x](javascript:alert(1)) <img src=x onerror=alert(1)>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"<script", "javascript:", "onerror"} {
+		if strings.Contains(string(html), s) {
+			t.Errorf("unsafe %q in %s", s, html)
+		}
+	}
+}
+
+func TestMarkdownCodeBlock(t *testing.T) {
+	html, err := Markdown("```go\nfunc main() {}\n```")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(html), `class="chroma"`) {
+		t.Errorf("code block is not highlighted: %s", html)
+	}
+}
+
+func TestMarkdownMath(t *testing.T) {
+	cases := map[string]string{
+		"$a_1 + b_1$":       `<span class="math math-inline">\(a_1 + b_1\)</span>`,
+		"$$x<y$$":           `<span class="math math-display">\[x&lt;y\]</span>`,
+		"$$\nx^2\n$$":       `<div class="math math-display">\[x^2` + "\n" + `\]</div>`,
+		"costs $5 and $10.": `costs $5 and $10.`,
+	}
+	for source, want := range cases {
+		html, err := Markdown(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(html), want) {
+			t.Errorf("Markdown(%q) = %s, want %s", source, html, want)
+		}
+	}
+}
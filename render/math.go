@@ -0,0 +1,177 @@
+package render
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// KindMath 是行内公式节点的类型
+var KindMath = ast.NewNodeKind("Math")
+
+// KindMathBlock 是独立成段的公式节点的类型
+var KindMathBlock = ast.NewNodeKind("MathBlock")
+
+// Math 是 $...$ 或 $$...$$ 包裹的行内公式
+type Math struct {
+	ast.BaseInline
+	Value   []byte
+	Display bool
+}
+
+// Kind implements ast.Node.Kind.
+func (n *Math) Kind() ast.NodeKind {
+	return KindMath
+}
+
+// Dump implements ast.Node.Dump.
+func (n *Math) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Value": string(n.Value)}, nil)
+}
+
+// MathBlock 是上下两行 $$ 包裹的公式段落
+type MathBlock struct {
+	ast.BaseBlock
+}
+
+// Kind implements ast.Node.Kind.
+func (n *MathBlock) Kind() ast.NodeKind {
+	return KindMathBlock
+}
+
+// IsRaw implements ast.Node.IsRaw.
+func (n *MathBlock) IsRaw() bool {
+	return true
+}
+
+// Dump implements ast.Node.Dump.
+func (n *MathBlock) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+type mathParser struct{}
+
+func (p *mathParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+func (p *mathParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+	opener := 1
+	if len(line) > 1 && line[1] == '$' {
+		opener = 2
+	}
+	if len(line) <= opener*2 {
+		return nil
+	}
+
+	delimiter := line[:opener]
+	end := bytes.Index(line[opener:], delimiter)
+	if end <= 0 {
+		return nil
+	}
+	value := line[opener : opener+end]
+	if opener == 1 && (util.IsSpace(value[0]) || util.IsSpace(value[len(value)-1])) {
+		// "$5 和 $10" 这样的文本不是公式
+		return nil
+	}
+
+	block.Advance(opener*2 + end)
+	return &Math{Value: append([]byte(nil), value...), Display: opener == 2}
+}
+
+type mathBlockParser struct{}
+
+func (p *mathBlockParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+func (p *mathBlockParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, _ := reader.PeekLine()
+	if !bytes.Equal(util.TrimRightSpace(util.TrimLeftSpace(line)), []byte("$$")) {
+		return nil, parser.NoChildren
+	}
+	reader.Advance(len(line) - 1)
+	return &MathBlock{}, parser.NoChildren
+}
+
+func (p *mathBlockParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, segment := reader.PeekLine()
+	if line == nil {
+		return parser.Close
+	}
+	if bytes.Equal(util.TrimRightSpace(util.TrimLeftSpace(line)), []byte("$$")) {
+		reader.Advance(segment.Len() - 1)
+		return parser.Close
+	}
+	node.Lines().Append(segment)
+	reader.Advance(segment.Len() - 1)
+	return parser.Continue | parser.NoChildren
+}
+
+func (p *mathBlockParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {}
+
+func (p *mathBlockParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (p *mathBlockParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+type mathRenderer struct{}
+
+func (r *mathRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindMath, r.renderMath)
+	reg.Register(KindMathBlock, r.renderMathBlock)
+}
+
+// renderMath 输出 \( \) 或 \[ \] 包裹的公式，由前端的 KaTeX 或 MathJax 排版
+func (r *mathRenderer) renderMath(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*Math)
+	if n.Display {
+		w.WriteString(`<span class="math math-display">\[`)
+		w.Write(util.EscapeHTML(n.Value))
+		w.WriteString(`\]</span>`)
+	} else {
+		w.WriteString(`<span class="math math-inline">\(`)
+		w.Write(util.EscapeHTML(n.Value))
+		w.WriteString(`\)</span>`)
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *mathRenderer) renderMathBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	w.WriteString(`<div class="math math-display">\[`)
+	lines := node.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		w.Write(util.EscapeHTML(line.Value(source)))
+	}
+	w.WriteString("\\]</div>\n")
+	return ast.WalkSkipChildren, nil
+}
+
+type mathExtension struct{}
+
+// MathExtension 支持 $...$、$$...$$ 以及 $$ 独立成段的 LaTeX 公式
+var MathExtension goldmark.Extender = &mathExtension{}
+
+func (e *mathExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(util.Prioritized(&mathParser{}, 150)),
+		parser.WithBlockParsers(util.Prioritized(&mathBlockParser{}, 150)),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(util.Prioritized(&mathRenderer{}, 150)))
+}
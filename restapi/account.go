@@ -0,0 +1,421 @@
+package restapi
+
+import (
+	"net/http"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/ent"
+	mailer "community.threetenth.chatgpt/mail"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// SessionCookie 是本地账号登录后保存会话令牌的 cookie 名称
+const SessionCookie = "community_session"
+
+const (
+	// verifyEmailMaxAge 是验证邮箱链接的有效期
+	verifyEmailMaxAge = 48 * time.Hour
+	// resetPasswordMaxAge 是重置密码链接的有效期
+	resetPasswordMaxAge = time.Hour
+	// verifyEmailCooldown 是向同一个用户发送验证邮件的最短间隔
+	verifyEmailCooldown = 10 * time.Minute
+)
+
+// AccountConfig 是本地账号的配置
+type AccountConfig struct {
+	// Signer 签发会话 cookie、验证邮箱和重置密码的令牌
+	Signer *auth.Signer
+	// SessionMaxAge 是会话 cookie 的有效期
+	SessionMaxAge time.Duration
+	// BaseURL 是站点对外的根地址，用于生成邮件中的链接，配置了 Mailer 时不能为空
+	BaseURL string
+	// Mailer 发送验证邮箱和重置密码的邮件
+	Mailer *mailer.Mailer
+	// OIDC 是 OpenID Connect 身份提供方，为空时不支持 OpenID Connect 登录
+	OIDC *auth.OIDCProvider
+}
+
+var accountConfig *AccountConfig
+
+// SetAccountConfig 设置本地账号的配置
+func SetAccountConfig(config *AccountConfig) {
+	accountConfig = config
+}
+
+// normalizeEmail 去掉邮箱两端的空白并转换为小写，格式错误时返回 false
+func normalizeEmail(email string) (string, bool) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", false
+	}
+	return email, true
+}
+
+// sessionStamp 是会话令牌的 stamp，更换后之前的会话全部失效
+//
+// stamp 是每个用户随机的值，没有密码的用户（ChatGPT 和 OIDC 登录）的会话同样可以撤销。
+func sessionStamp(u *ent.User) string {
+	return u.SessionStamp
+}
+
+// sessionUser 通过会话 cookie 认证当前用户，没有 cookie 时返回 nil
+func (api *API) sessionUser(c *gin.Context) (*ent.User, error) {
+	token, err := c.Cookie(SessionCookie)
+	if err != nil || token == "" || accountConfig == nil {
+		return nil, nil
+	}
+	u, err := api.store.GetUser(c.Request.Context(), auth.Subject(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, auth.ErrInvalidToken
+		}
+		return nil, err
+	}
+	// 匿名化注销的用户仍然存在，需要单独拒绝
+	if u.DeletedAt != nil {
+		return nil, auth.ErrInvalidToken
+	}
+	if _, err = accountConfig.Signer.Verify(token, auth.PurposeSession, sessionStamp(u)); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// setSessionCookie 设置会话 cookie，maxAge 小于 0 时删除 cookie
+func setSessionCookie(c *gin.Context, value string, maxAge int) {
+	setCookie(c, SessionCookie, value, maxAge)
+}
+
+// setCookie 设置一个 HttpOnly、SameSite=Lax 的 cookie，站点使用 https 时只通过 https 发送
+func setCookie(c *gin.Context, name, value string, maxAge int) {
+	secure := strings.HasPrefix(accountConfig.BaseURL, "https://") || c.Request.TLS != nil
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(name, value, maxAge, "/", "", secure, true)
+}
+
+// sendAccountMail 发送包含链接的账号邮件，发送失败只输出日志，避免泄露邮箱是否注册
+func sendAccountMail(to, subject, text, link string) {
+	err := accountConfig.Mailer.Send(to, subject, text+"\n\n"+link+"\n")
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.sendAccountMail",
+			"event":  "mail.Send",
+		}).Info(err.Error())
+	}
+}
+
+var (
+	verifyEmailMutex sync.Mutex
+	// verifyEmailSent 是冷却时间内发送过验证邮件的用户和发送的时间
+	verifyEmailSent = map[string]time.Time{}
+)
+
+// allowVerifyEmail 判断能否向用户发送验证邮件，可以发送时记录发送的时间
+//
+// 每个用户在 verifyEmailCooldown 内最多发送一封，反复登录未验证的账号不会发送大量邮件。
+func allowVerifyEmail(userID string, now time.Time) bool {
+	verifyEmailMutex.Lock()
+	defer verifyEmailMutex.Unlock()
+	if sent, ok := verifyEmailSent[userID]; ok && now.Sub(sent) < verifyEmailCooldown {
+		return false
+	}
+	for id, sent := range verifyEmailSent {
+		if now.Sub(sent) >= verifyEmailCooldown {
+			delete(verifyEmailSent, id)
+		}
+	}
+	verifyEmailSent[userID] = now
+	return true
+}
+
+// sendVerifyEmail 发送验证邮箱的邮件，链接中的令牌和邮箱绑定，冷却时间内已经发送过时不发送
+func sendVerifyEmail(u *ent.User) {
+	if !allowVerifyEmail(u.ID, time.Now()) {
+		return
+	}
+	token := accountConfig.Signer.Sign(auth.PurposeVerifyEmail, u.ID, u.Email, time.Now().Add(verifyEmailMaxAge))
+	sendAccountMail(u.Email, "Verify your email",
+		"Open the link below to verify your ChatGPT Community account.",
+		accountConfig.BaseURL+"/api/v1/account/verify?token="+token)
+}
+
+// requireAccount 检查是否配置了本地账号，没有配置时返回 404
+func requireAccount(c *gin.Context) bool {
+	if accountConfig == nil {
+		fail(c, newError(http.StatusNotFound, CodeDisabled, "local accounts are disabled"))
+		return false
+	}
+	return true
+}
+
+// PostAccountRegister 使用邮箱和密码注册一个本地账号，并发送验证邮件
+func (api *API) PostAccountRegister(c *gin.Context) {
+	if !requireAccount(c) {
+		return
+	}
+
+	var body struct {
+		Name     string `json:"name"`
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+	email, ok := normalizeEmail(body.Email)
+	if !ok {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid email"))
+		return
+	}
+
+	// 只通过 ChatGPT 登录过的用户，可以使用找回密码为已有的账号设置密码
+	exist, err := api.store.GetUserByEmail(c.Request.Context(), email)
+	if err == nil && exist != nil {
+		fail(c, newError(http.StatusConflict, CodeConflict, "email is already registered"))
+		return
+	}
+	if err != nil && !ent.IsNotFound(err) {
+		fail(c, err)
+		return
+	}
+
+	hash, err := auth.HashPassword(body.Password)
+	if err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+
+	u, err := api.store.CreateLocalUser(c.Request.Context(), strings.TrimSpace(body.Name), email, hash)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.PostAccountRegister",
+			"event":  "db.CreateLocalUser",
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+
+	api.audit(c, u.ID, "account.register", "user", u.ID, nil)
+	sendVerifyEmail(u)
+	c.JSON(http.StatusCreated, u)
+}
+
+// GetAccountVerify 验证邮箱，成功后跳转到登录页面
+func (api *API) GetAccountVerify(c *gin.Context) {
+	if !requireAccount(c) {
+		return
+	}
+
+	token := c.Query("token")
+	u, err := api.store.GetUser(c.Request.Context(), auth.Subject(token))
+	if err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid token"))
+		return
+	}
+	if _, err = accountConfig.Signer.Verify(token, auth.PurposeVerifyEmail, u.Email); err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid or expired token"))
+		return
+	}
+
+	if u.EmailVerifiedAt == nil {
+		if err = api.store.VerifyUserEmail(c.Request.Context(), u.ID); err != nil {
+			fail(c, err)
+			return
+		}
+		api.audit(c, u.ID, "account.verify_email", "user", u.ID, nil)
+	}
+	c.Redirect(http.StatusFound, "/login?verified=1")
+}
+
+// PostAccountLogin 使用邮箱和密码登录，成功后设置会话 cookie
+//
+// 邮箱没有验证时返回 403 并重新发送验证邮件，每个用户在 verifyEmailCooldown 内最多发送一封。
+func (api *API) PostAccountLogin(c *gin.Context) {
+	if !requireAccount(c) {
+		return
+	}
+
+	var body struct {
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+	email, _ := normalizeEmail(body.Email)
+
+	u, err := api.store.GetUserByEmail(c.Request.Context(), email)
+	if err != nil && !ent.IsNotFound(err) {
+		fail(c, err)
+		return
+	}
+	if u == nil || !auth.CheckPassword(u.PasswordHash, body.Password) {
+		api.audit(c, "", "account.login_failed", "user", "", map[string]interface{}{"email": email})
+		fail(c, newError(http.StatusUnauthorized, CodeUnauthorized, "invalid email or password"))
+		return
+	}
+	if u.EmailVerifiedAt == nil {
+		sendVerifyEmail(u)
+		fail(c, newError(http.StatusForbidden, CodeForbidden, "email is not verified, check your inbox for the verification email"))
+		return
+	}
+	if u.BannedAt != nil {
+		fail(c, newError(http.StatusForbidden, CodeBanned, "banned: "+u.BanReason))
+		return
+	}
+
+	startSession(c, u)
+	api.audit(c, u.ID, "account.login", "user", u.ID, map[string]interface{}{"method": "password"})
+	c.JSON(http.StatusOK, u)
+}
+
+// startSession 为用户签发会话令牌并设置会话 cookie
+func startSession(c *gin.Context, u *ent.User) {
+	maxAge := accountConfig.SessionMaxAge
+	token := accountConfig.Signer.Sign(auth.PurposeSession, u.ID, sessionStamp(u), time.Now().Add(maxAge))
+	setSessionCookie(c, token, int(maxAge/time.Second))
+}
+
+// PostAccountLogout 删除会话 cookie
+//
+// all=true 时更换用户的会话 stamp，退出所有设备上的会话，只能通过会话 cookie 调用。
+func (api *API) PostAccountLogout(c *gin.Context) {
+	if !requireAccount(c) {
+		return
+	}
+	if c.Query("all") == "true" {
+		u, err := api.sessionUser(c)
+		if err != nil || u == nil {
+			fail(c, errAuthorizationFailed)
+			return
+		}
+		if err = api.store.RotateSessionStamp(c.Request.Context(), u.ID); err != nil {
+			fail(c, err)
+			return
+		}
+		api.audit(c, u.ID, "account.logout_all", "user", u.ID, nil)
+	} else if userID := api.optionalUserID(c); userID != "" {
+		api.audit(c, userID, "account.logout", "user", userID, nil)
+	}
+	setSessionCookie(c, "", -1)
+	c.String(http.StatusOK, "OK")
+}
+
+// PostAccountPasswordForgot 发送重置密码的邮件
+//
+// 无论邮箱是否注册都返回 200，避免泄露注册的邮箱。
+func (api *API) PostAccountPasswordForgot(c *gin.Context) {
+	if !requireAccount(c) {
+		return
+	}
+
+	var body struct {
+		Email string `json:"email"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+
+	if email, ok := normalizeEmail(body.Email); ok {
+		u, err := api.store.GetUserByEmail(c.Request.Context(), email)
+		if err == nil {
+			// 令牌和当前的密码哈希绑定，重置密码之后链接失效
+			token := accountConfig.Signer.Sign(auth.PurposeResetPassword, u.ID, auth.PasswordStamp(u.PasswordHash), time.Now().Add(resetPasswordMaxAge))
+			sendAccountMail(u.Email, "Reset your password",
+				"Open the link below to set a new password for your ChatGPT Community account. If you didn't request it, ignore this email.",
+				accountConfig.BaseURL+"/reset-password?token="+token)
+			api.audit(c, "", "account.password_forgot", "user", u.ID, nil)
+		} else if !ent.IsNotFound(err) {
+			log.WithFields(log.Fields{
+				"method": "restapi.PostAccountPasswordForgot",
+				"event":  "db.GetUserByEmail",
+			}).Info(err.Error())
+		}
+	}
+	c.String(http.StatusOK, "OK")
+}
+
+// PostAccountPasswordReset 使用重置密码邮件中的令牌设置新密码
+//
+// 修改密码后之前签发的会话 cookie 和重置密码链接全部失效。
+func (api *API) PostAccountPasswordReset(c *gin.Context) {
+	if !requireAccount(c) {
+		return
+	}
+
+	var body struct {
+		Token    string `json:"token"`
+		Password string `json:"password"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+
+	u, err := api.store.GetUser(c.Request.Context(), auth.Subject(body.Token))
+	if err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid token"))
+		return
+	}
+	if _, err = accountConfig.Signer.Verify(body.Token, auth.PurposeResetPassword, auth.PasswordStamp(u.PasswordHash)); err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid or expired token"))
+		return
+	}
+
+	hash, err := auth.HashPassword(body.Password)
+	if err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+	if err = api.store.ResetUserPassword(c.Request.Context(), u.ID, hash); err != nil {
+		fail(c, err)
+		return
+	}
+	api.audit(c, u.ID, "account.password_reset", "user", u.ID, nil)
+	c.String(http.StatusOK, "OK")
+}
+
+// linkMutex 保证同一时间只有一个请求在关联 ChatGPT 账号
+var linkMutex sync.Mutex
+
+// linkChatGPTUser 将 ChatGPT 账号关联到已登录的本地账号
+//
+// 一个 ChatGPT 账号只能关联一个本地账号，已经关联到其他账号时返回 409。
+func (api *API) linkChatGPTUser(c *gin.Context, u *ent.User, openaiID string) bool {
+	linkMutex.Lock()
+	defer linkMutex.Unlock()
+
+	linked, err := api.store.GetUserByOpenAIID(c.Request.Context(), openaiID)
+	if err == nil && linked.ID != u.ID {
+		fail(c, newError(http.StatusConflict, CodeConflict, "ChatGPT account is linked to another user"))
+		return false
+	}
+	if err != nil && !ent.IsNotFound(err) {
+		fail(c, err)
+		return false
+	}
+	if u.OpenaiID != nil && *u.OpenaiID == openaiID {
+		return true
+	}
+
+	if err = api.store.LinkOpenAIUser(c.Request.Context(), u.ID, openaiID); err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.linkChatGPTUser",
+			"event":  "db.LinkOpenAIUser",
+		}).Info(err.Error())
+		fail(c, err)
+		return false
+	}
+	api.audit(c, u.ID, "account.link_chatgpt", "user", u.ID, map[string]interface{}{"openai_id": openaiID})
+	return true
+}
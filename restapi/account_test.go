@@ -0,0 +1,110 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/ent"
+	"github.com/gin-gonic/gin"
+)
+
+// logoutStore 更换用户的会话 stamp
+type logoutStore struct {
+	fakeStore
+}
+
+func (s *logoutStore) RotateSessionStamp(ctx context.Context, id string) error {
+	u := s.users[id]
+	u.SessionStamp += "-rotated"
+	return nil
+}
+
+func TestAllowVerifyEmail(t *testing.T) {
+	now := time.Now()
+	for _, tc := range []struct {
+		userID string
+		at     time.Time
+		want   bool
+	}{
+		{"alice", now, true},
+		// 冷却时间内反复登录不会再次发送
+		{"alice", now.Add(time.Second), false},
+		{"alice", now.Add(verifyEmailCooldown - time.Second), false},
+		{"bob", now.Add(time.Minute), true},
+		{"alice", now.Add(verifyEmailCooldown), true},
+		{"alice", now.Add(verifyEmailCooldown + time.Minute), false},
+	} {
+		if got := allowVerifyEmail(tc.userID, tc.at); got != tc.want {
+			t.Errorf("allowVerifyEmail(%s, +%s) = %v, want %v", tc.userID, tc.at.Sub(now), got, tc.want)
+		}
+	}
+	// 过期的发送记录会被清理
+	if _, ok := verifyEmailSent["bob"]; !ok {
+		t.Errorf("bob's record was removed before the cooldown")
+	}
+	allowVerifyEmail("carol", now.Add(2*verifyEmailCooldown))
+	if _, ok := verifyEmailSent["bob"]; ok {
+		t.Errorf("bob's record was kept after the cooldown")
+	}
+}
+
+func TestPostAccountLogoutAll(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	alice := &ent.User{ID: "alice", SessionStamp: "stamp"}
+	store := &logoutStore{
+		fakeStore: fakeStore{
+			keys: map[string]*ent.APIKey{
+				auth.HashAPIKey("cgc_alice"): {Scopes: []string{string(PermRead)}, Edges: ent.APIKeyEdges{User: alice}},
+			},
+		},
+	}
+	cookie := sessionCookie(t, &store.fakeStore, alice)
+	api := New(store)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.POST("/api/v1/account/logout", api.PostAccountLogout)
+	router.GET("/api/v1/session", func(c *gin.Context) {
+		u, err := api.sessionUser(c)
+		if err != nil || u == nil {
+			c.Status(http.StatusUnauthorized)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+	do := func(method, target string, cookie *http.Cookie, key string) int {
+		req := httptest.NewRequest(method, target, nil)
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		if key != "" {
+			req.Header.Set("Authorization", "Bearer "+key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	if code := do(http.MethodPost, "/api/v1/account/logout?all=true", nil, ""); code != http.StatusUnauthorized {
+		t.Errorf("logout all without a session = %d, want 401", code)
+	}
+	// API key 不能退出用户的所有会话
+	if code := do(http.MethodPost, "/api/v1/account/logout?all=true", nil, "cgc_alice"); code != http.StatusUnauthorized {
+		t.Errorf("logout all with an api key = %d, want 401", code)
+	}
+	if code := do(http.MethodPost, "/api/v1/account/logout", cookie, ""); code != http.StatusOK {
+		t.Errorf("logout = %d, want 200", code)
+	}
+	if code := do(http.MethodGet, "/api/v1/session", cookie, ""); code != http.StatusOK {
+		t.Errorf("session after logout = %d, want the cookie to stay valid on other devices", code)
+	}
+	if code := do(http.MethodPost, "/api/v1/account/logout?all=true", cookie, ""); code != http.StatusOK {
+		t.Errorf("logout all = %d, want 200", code)
+	}
+	if code := do(http.MethodGet, "/api/v1/session", cookie, ""); code != http.StatusUnauthorized {
+		t.Errorf("session after logout all = %d, want 401", code)
+	}
+}
@@ -0,0 +1,278 @@
+package restapi
+
+import (
+	"net/http"
+	"strconv"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/report"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// reportPageSize 是举报列表每页的记录数量
+const reportPageSize = 50
+
+// adminResult 统一处理管理员操作的结果，成功时记录审计日志
+func (api *API) adminResult(c *gin.Context, err error, actorID, action, targetType, targetID string, payload map[string]interface{}) {
+	if err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, newError(http.StatusNotFound, CodeNotFound, targetType+" not found"))
+			return
+		}
+		log.WithFields(log.Fields{
+			"method": "restapi.adminResult",
+			"event":  action,
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+
+	api.audit(c, actorID, action, targetType, targetID, payload)
+	c.String(http.StatusOK, "OK")
+}
+
+// GetReports 获取举报列表，默认返回未处理的举报
+func (api *API) GetReports(c *gin.Context) {
+	status := report.Status(c.DefaultQuery("status", string(report.StatusOpen)))
+	if err := report.StatusValidator(status); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	reports, err := api.store.ListReports(c.Request.Context(), status, (page-1)*reportPageSize, reportPageSize)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, reports)
+}
+
+// PostReportTriage 处理一个举报，action 为 resolve 或 dismiss
+//
+// 处理举报只改变举报的状态，隐藏或删除内容需要调用对应的接口。
+func (api *API) PostReportTriage(c *gin.Context) {
+	handlerID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid id"))
+		return
+	}
+
+	var body struct {
+		Action string `json:"action" binding:"required,oneof=resolve dismiss"`
+	}
+	if err = c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+
+	status := report.StatusResolved
+	if body.Action == "dismiss" {
+		status = report.StatusDismissed
+	}
+	r, err := api.store.HandleReport(c.Request.Context(), id, status, handlerID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, newError(http.StatusNotFound, CodeNotFound, "open report not found"))
+			return
+		}
+		fail(c, err)
+		return
+	}
+
+	api.audit(c, handlerID, "report."+body.Action, "report", c.Param("id"), nil)
+	c.JSON(http.StatusOK, r)
+}
+
+// bindFlag 解析请求中的布尔开关，例如 {"hidden": true}
+func bindFlag(c *gin.Context, name string) (bool, bool) {
+	var body map[string]bool
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return false, false
+	}
+	value, ok := body[name]
+	if !ok {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, name+" is required"))
+		return false, false
+	}
+	return value, true
+}
+
+// PostAdminMessageHidden 隐藏或公开一条消息，请求体为 {"hidden": true}
+func (api *API) PostAdminMessageHidden(c *gin.Context) {
+	actorID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+	hidden, ok := bindFlag(c, "hidden")
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.SetMessageHidden(c.Request.Context(), id, hidden)
+	api.adminResult(c, err, actorID, "message.hide", "message", id, map[string]interface{}{"hidden": hidden})
+}
+
+// DeleteAdminMessage 删除一条消息，消息在会话中显示为占位，作者不能恢复
+func (api *API) DeleteAdminMessage(c *gin.Context) {
+	actorID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.DeleteMessage(c.Request.Context(), id, actorID, true)
+	api.adminResult(c, err, actorID, "message.delete", "message", id, nil)
+}
+
+// PostAdminMessageRestore 恢复一条被删除的消息
+func (api *API) PostAdminMessageRestore(c *gin.Context) {
+	actorID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.RestoreMessage(c.Request.Context(), id, actorID, true)
+	api.adminResult(c, err, actorID, "message.restore", "message", id, nil)
+}
+
+// PostAdminTopicHidden 隐藏或公开一个主题，请求体为 {"hidden": true}
+func (api *API) PostAdminTopicHidden(c *gin.Context) {
+	actorID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+	hidden, ok := bindFlag(c, "hidden")
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.SetTopicHidden(c.Request.Context(), id, hidden)
+	api.adminResult(c, err, actorID, "topic.hide", "topic", id, map[string]interface{}{"hidden": hidden})
+}
+
+// PostAdminTopicLocked 锁定或解锁一个主题，请求体为 {"locked": true}
+func (api *API) PostAdminTopicLocked(c *gin.Context) {
+	actorID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+	locked, ok := bindFlag(c, "locked")
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.SetTopicLocked(c.Request.Context(), id, locked)
+	api.adminResult(c, err, actorID, "topic.lock", "topic", id, map[string]interface{}{"locked": locked})
+}
+
+// DeleteAdminTopic 删除一个主题，作者不能恢复
+func (api *API) DeleteAdminTopic(c *gin.Context) {
+	actorID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.DeleteTopic(c.Request.Context(), id, actorID, true)
+	api.adminResult(c, err, actorID, "topic.delete", "topic", id, nil)
+}
+
+// PostAdminTopicRestore 恢复一个被删除的主题，会话已经被作者删除时返回 404
+func (api *API) PostAdminTopicRestore(c *gin.Context) {
+	actorID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.RestoreTopic(c.Request.Context(), id, actorID, true)
+	api.adminResult(c, err, actorID, "topic.restore", "topic", id, nil)
+}
+
+// PostAdminUserBan 封禁或解封一个用户，只有管理员可以操作
+//
+// 请求体为 {"banned": true, "reason": "..."}，封禁后用户现有的登录会失效。
+func (api *API) PostAdminUserBan(c *gin.Context) {
+	actorID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Banned *bool  `json:"banned" binding:"required"`
+		Reason string `json:"reason"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+
+	id := c.Param("id")
+	if id == actorID {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "can't ban yourself"))
+		return
+	}
+
+	var err error
+	action := "user.unban"
+	if *body.Banned {
+		action = "user.ban"
+		err = api.store.BanUser(c.Request.Context(), id, body.Reason)
+		if err == nil {
+			// 删除保存的 ChatGPT 凭据，解封后需要重新更新 ChatGPT 会话
+			err = api.store.DeleteUserCredentials(c.Request.Context(), id)
+		}
+	} else {
+		err = api.store.UnbanUser(c.Request.Context(), id)
+	}
+	api.adminResult(c, err, actorID, action, "user", id, map[string]interface{}{"reason": body.Reason})
+}
+
+// PostAdminUserRole 授予用户论坛角色，请求体为 {"role": "trusted"}
+//
+// role 为空时清除授予的角色，恢复为配置的用户组映射的角色，默认为 member。
+func (api *API) PostAdminUserRole(c *gin.Context) {
+	actorID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Role string `json:"role"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+	if body.Role != "" {
+		if role, ok := ParseRole(body.Role); !ok || role == RoleGuest {
+			fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid role: "+body.Role))
+			return
+		}
+	}
+
+	id := c.Param("id")
+	if id == actorID {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "can't change your own role"))
+		return
+	}
+
+	err := api.store.SetUserRole(c.Request.Context(), id, body.Role)
+	api.adminResult(c, err, actorID, "user.role", "user", id, map[string]interface{}{"role": body.Role})
+}
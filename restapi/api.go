@@ -0,0 +1,15 @@
+package restapi
+
+import "community.threetenth.chatgpt/db"
+
+// API 是 REST 接口的处理函数
+//
+// 处理函数通过注入的 Store 读写数据，测试时可以使用实现了 Store 接口的假数据。
+type API struct {
+	store db.Store
+}
+
+// New 创建使用指定 Store 的 API
+func New(store db.Store) *API {
+	return &API{store: store}
+}
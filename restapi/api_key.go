@@ -0,0 +1,212 @@
+package restapi
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/ent"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultAPIKeyScopes 是创建 API key 时没有指定权限的默认权限
+var defaultAPIKeyScopes = []string{string(PermRead), string(PermConversation)}
+
+// apiKeyUser 通过个人 API key 认证当前用户，并在 gin.Context 中保存 key 的权限
+func (api *API) apiKeyUser(c *gin.Context, key string) (*ent.User, error) {
+	k, err := api.store.GetAPIKeyByHash(c.Request.Context(), auth.HashAPIKey(key))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errAuthorizationFailed
+		}
+		return nil, err
+	}
+	if err = api.store.TouchAPIKey(c.Request.Context(), k); err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.apiKeyUser",
+			"event":  "db.TouchAPIKey",
+		}).Info(err.Error())
+	}
+
+	scopes := make(map[Permission]bool, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		scopes[Permission(scope)] = true
+	}
+	c.Set(contextScopes, scopes)
+	return k.Edges.User, nil
+}
+
+// scopeAllows 判断使用 API key 认证时，key 是否拥有指定的权限，其他认证方式总是返回 true
+func scopeAllows(c *gin.Context, p Permission) bool {
+	v, ok := c.Get(contextScopes)
+	if !ok {
+		return true
+	}
+	return v.(map[Permission]bool)[p]
+}
+
+// apiKeyView 是返回给客户端的 API key，key 只在创建和轮换时返回一次
+type apiKeyView struct {
+	*ent.APIKey
+	Key string `json:"key,omitempty"`
+}
+
+// GetAPIKeys 获取当前用户所有未撤销的 API key
+func (api *API) GetAPIKeys(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+	keys, err := api.store.ListAPIKeys(c.Request.Context(), userID)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, keys)
+}
+
+// PostAPIKey 创建一个 API key
+//
+// scopes 只能包含当前角色拥有的权限，使用 API key 认证时还只能包含这个 key 拥有的权限；expires_in 是有效期的天数，为 0 时永不过期。
+func (api *API) PostAPIKey(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Name      string   `json:"name"`
+		Scopes    []string `json:"scopes"`
+		ExpiresIn int      `json:"expires_in"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+	if len(body.Scopes) == 0 {
+		body.Scopes = defaultAPIKeyScopes
+	}
+	role := currentRole(c)
+	for _, scope := range body.Scopes {
+		if _, known := permissionRoles[Permission(scope)]; !known {
+			fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "unknown scope: "+scope))
+			return
+		}
+		if !role.Can(Permission(scope)) {
+			fail(c, newError(http.StatusForbidden, CodeForbidden, "permission denied: "+scope))
+			return
+		}
+		if !scopeAllows(c, Permission(scope)) {
+			fail(c, newError(http.StatusForbidden, CodeForbidden, "api key scope denied: "+scope))
+			return
+		}
+	}
+	if body.ExpiresIn < 0 {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "expires_in must be positive"))
+		return
+	}
+	var expiresAt *time.Time
+	if body.ExpiresIn > 0 {
+		t := time.Now().AddDate(0, 0, body.ExpiresIn)
+		expiresAt = &t
+	}
+
+	key, prefix, hash, err := auth.NewAPIKey()
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	k, err := api.store.CreateAPIKey(c.Request.Context(), userID, body.Name, prefix, hash, body.Scopes, expiresAt)
+	if err != nil {
+		if ent.IsValidationError(err) {
+			fail(c, invalidRequest(err))
+			return
+		}
+		log.WithFields(log.Fields{
+			"method": "restapi.PostAPIKey",
+			"event":  "db.CreateAPIKey",
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+
+	api.audit(c, userID, "apikey.create", "apikey", strconv.Itoa(k.ID), map[string]interface{}{"scopes": k.Scopes})
+	c.JSON(http.StatusCreated, &apiKeyView{k, key})
+}
+
+// PostAPIKeyRotate 轮换一个 API key，旧的 key 立即失效
+func (api *API) PostAPIKeyRotate(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid id"))
+		return
+	}
+
+	// 新的 key 继承旧 key 的权限，和创建时一样不能超过当前 API key 的权限
+	keys, err := api.store.ListAPIKeys(c.Request.Context(), userID)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	for _, old := range keys {
+		if old.ID != id {
+			continue
+		}
+		for _, scope := range old.Scopes {
+			if !scopeAllows(c, Permission(scope)) {
+				fail(c, newError(http.StatusForbidden, CodeForbidden, "api key scope denied: "+scope))
+				return
+			}
+		}
+	}
+
+	key, prefix, hash, err := auth.NewAPIKey()
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	k, err := api.store.RotateAPIKey(c.Request.Context(), id, userID, prefix, hash)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, newError(http.StatusNotFound, CodeNotFound, "api key not found"))
+		} else {
+			fail(c, err)
+		}
+		return
+	}
+
+	api.audit(c, userID, "apikey.rotate", "apikey", c.Param("id"), map[string]interface{}{"new_id": k.ID})
+	c.JSON(http.StatusOK, &apiKeyView{k, key})
+}
+
+// DeleteAPIKey 撤销一个 API key
+func (api *API) DeleteAPIKey(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid id"))
+		return
+	}
+
+	if err = api.store.RevokeAPIKey(c.Request.Context(), id, userID); err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, newError(http.StatusNotFound, CodeNotFound, "api key not found"))
+		} else {
+			fail(c, err)
+		}
+		return
+	}
+
+	api.audit(c, userID, "apikey.revoke", "apikey", c.Param("id"), nil)
+	c.String(http.StatusOK, "OK")
+}
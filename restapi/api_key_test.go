@@ -0,0 +1,142 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/ent"
+	"github.com/gin-gonic/gin"
+)
+
+// rotateStore 保存用户的 API key，记录被轮换的 key
+type rotateStore struct {
+	fakeStore
+	owned   []*ent.APIKey
+	rotated []int
+}
+
+func (s *rotateStore) ListAPIKeys(ctx context.Context, userID string) ([]*ent.APIKey, error) {
+	return s.owned, nil
+}
+
+func (s *rotateStore) RotateAPIKey(ctx context.Context, id int, userID, prefix, hash string) (*ent.APIKey, error) {
+	for _, k := range s.owned {
+		if k.ID == id {
+			s.rotated = append(s.rotated, id)
+			return &ent.APIKey{ID: 100 + id, Name: k.Name, Prefix: prefix, Scopes: k.Scopes}, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func TestPostAPIKeyScopes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	alice := &ent.User{ID: "alice"}
+	store := &fakeStore{
+		keys: map[string]*ent.APIKey{
+			auth.HashAPIKey("cgc_manage"): {
+				Scopes: []string{string(PermAPIKey), string(PermRead)},
+				Edges:  ent.APIKeyEdges{User: alice},
+			},
+		},
+	}
+	cookie := sessionCookie(t, store, alice)
+	api := New(store)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.POST("/api/v1/keys", api.Require(PermAPIKey), api.PostAPIKey)
+
+	for _, tc := range []struct {
+		name   string
+		key    string
+		scopes string
+		status int
+	}{
+		{"session", "", `["read","conversation.post"]`, http.StatusCreated},
+		{"role denied", "", `["user.ban"]`, http.StatusForbidden},
+		{"unknown scope", "", `["everything"]`, http.StatusBadRequest},
+		{"key subset", "cgc_manage", `["read"]`, http.StatusCreated},
+		// API key 不能创建拥有自己没有的权限的 key
+		{"key escalation", "cgc_manage", `["read","conversation.post"]`, http.StatusForbidden},
+		{"key default scopes", "cgc_manage", `[]`, http.StatusForbidden},
+		// 不再接受 ChatGPT 的 accessToken
+		{"upstream access token", "eyJhbGciOiJSUzI1NiJ9.e30.sig", `["read"]`, http.StatusUnauthorized},
+	} {
+		created := len(store.created)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/keys", strings.NewReader(`{"name":"test","scopes":`+tc.scopes+`}`))
+		req.Header.Set("Content-Type", "application/json")
+		if tc.key != "" {
+			req.Header.Set("Authorization", "Bearer "+tc.key)
+		} else {
+			req.AddCookie(cookie)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != tc.status {
+			t.Errorf("%s: POST /api/v1/keys = %d %s, want %d", tc.name, w.Code, w.Body.String(), tc.status)
+		}
+		if added := len(store.created) > created; added != (tc.status == http.StatusCreated) {
+			t.Errorf("%s: key created = %v", tc.name, added)
+		}
+	}
+}
+
+func TestPostAPIKeyRotateScopes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	alice := &ent.User{ID: "alice"}
+	store := &rotateStore{
+		fakeStore: fakeStore{
+			keys: map[string]*ent.APIKey{
+				auth.HashAPIKey("cgc_manage"): {
+					ID:     1,
+					Scopes: []string{string(PermAPIKey), string(PermRead)},
+					Edges:  ent.APIKeyEdges{User: alice},
+				},
+			},
+		},
+		owned: []*ent.APIKey{
+			{ID: 1, Scopes: []string{string(PermAPIKey), string(PermRead)}},
+			{ID: 2, Scopes: []string{string(PermRead)}},
+			{ID: 3, Scopes: []string{string(PermRead), string(PermConversation)}},
+		},
+	}
+	cookie := sessionCookie(t, &store.fakeStore, alice)
+	api := New(store)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.POST("/api/v1/keys/:id/rotate", api.Require(PermAPIKey), api.PostAPIKeyRotate)
+
+	for _, tc := range []struct {
+		name   string
+		key    string
+		id     string
+		status int
+	}{
+		{"session", "", "3", http.StatusOK},
+		{"key subset", "cgc_manage", "2", http.StatusOK},
+		{"key itself", "cgc_manage", "1", http.StatusOK},
+		// 轮换不能取得当前 API key 没有的权限
+		{"key escalation", "cgc_manage", "3", http.StatusForbidden},
+		{"not found", "cgc_manage", "9", http.StatusNotFound},
+	} {
+		rotated := len(store.rotated)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/keys/"+tc.id+"/rotate", nil)
+		if tc.key != "" {
+			req.Header.Set("Authorization", "Bearer "+tc.key)
+		} else {
+			req.AddCookie(cookie)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != tc.status {
+			t.Errorf("%s: POST /api/v1/keys/%s/rotate = %d %s, want %d", tc.name, tc.id, w.Code, w.Body.String(), tc.status)
+		}
+		if done := len(store.rotated) > rotated; done != (tc.status == http.StatusOK) {
+			t.Errorf("%s: key rotated = %v", tc.name, done)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package restapi
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"community.threetenth.chatgpt/db"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// auditPageSize 是审计记录每页的数量
+const auditPageSize = 100
+
+// audit 记录一次操作，记录失败只输出日志
+//
+// IP 使用 gin 根据可信代理配置获取的客户端 IP，payload 中不能包含密码和令牌。
+func (api *API) audit(c *gin.Context, actorID, action, targetType, targetID string, payload map[string]interface{}) {
+	_, err := api.store.SaveAuditEvent(c.Request.Context(), actorID, action, targetType, targetID, c.ClientIP(), c.Request.UserAgent(), payload)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.audit",
+			"event":  "db.SaveAuditEvent",
+			"action": action,
+		}).Info(err.Error())
+	}
+}
+
+// optionalUserID 获取当前登录用户的 ID，未登录或认证失败时返回空字符串
+func (api *API) optionalUserID(c *gin.Context) string {
+	if v, exists := c.Get(contextUserID); exists {
+		return v.(string)
+	}
+	u, err := api.authenticate(c)
+	if err != nil || u == nil {
+		return ""
+	}
+	return u.ID
+}
+
+// parseTime 解析 RFC 3339 格式的时间，为空时返回零值
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// GetAuditEvents 查询审计记录
+//
+// 支持的过滤参数：actor、action（以 . 结尾时按前缀匹配）、target_type、target_id、ip、
+// since 和 until（RFC 3339 格式），以及分页参数 page。
+func (api *API) GetAuditEvents(c *gin.Context) {
+	filter := db.AuditFilter{
+		ActorID:    c.Query("actor"),
+		Action:     c.Query("action"),
+		TargetType: c.Query("target_type"),
+		TargetID:   c.Query("target_id"),
+		IP:         c.Query("ip"),
+	}
+	var err error
+	if filter.Since, err = parseTime(c.Query("since")); err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid since: "+err.Error()))
+		return
+	}
+	if filter.Until, err = parseTime(c.Query("until")); err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid until: "+err.Error()))
+		return
+	}
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	events, err := api.store.ListAuditEvents(c.Request.Context(), &filter, (page-1)*auditPageSize, auditPageSize)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, events)
+}
@@ -0,0 +1,55 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"community.threetenth.chatgpt/db"
+	"community.threetenth.chatgpt/ent"
+	"github.com/gin-gonic/gin"
+)
+
+// auditStore 记录查询审计记录的条件
+type auditStore struct {
+	fakeStore
+	filter *db.AuditFilter
+	offset int
+}
+
+func (s *auditStore) ListAuditEvents(ctx context.Context, filter *db.AuditFilter, offset, limit int) ([]*ent.AuditEvent, error) {
+	s.filter, s.offset = filter, offset
+	return []*ent.AuditEvent{}, nil
+}
+
+func TestGetAuditEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	store := &auditStore{}
+	api := New(store)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.GET("/api/v1/admin/audit", api.GetAuditEvents)
+
+	for _, tc := range []struct {
+		query  string
+		status int
+	}{
+		{"since=yesterday", http.StatusBadRequest},
+		{"until=2023-01-02", http.StatusBadRequest},
+		{"actor=alice&action=apikey.&ip=10.0.0.1&since=2023-01-02T03:04:05Z&page=3", http.StatusOK},
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit?"+tc.query, nil))
+		if w.Code != tc.status {
+			t.Errorf("GET ?%s = %d %s, want %d", tc.query, w.Code, w.Body.String(), tc.status)
+		}
+	}
+
+	since := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	f := store.filter
+	if f == nil || f.ActorID != "alice" || f.Action != "apikey." || f.IP != "10.0.0.1" || !f.Since.Equal(since) || !f.Until.IsZero() || store.offset != 2*auditPageSize {
+		t.Errorf("filter = %+v, offset = %d", f, store.offset)
+	}
+}
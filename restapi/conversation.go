@@ -0,0 +1,210 @@
+package restapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"community.threetenth.chatgpt/ent"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultModel 是没有配置可用模型时使用的 ChatGPT 模型
+const DefaultModel = "text-davinci-002-render"
+
+// DefaultMaxPromptLength 是提问默认的最大字符数
+const DefaultMaxPromptLength = 4000
+
+// ConversationConfig 是提交会话的限制
+type ConversationConfig struct {
+	// MaxPromptLength 是提问的最大字符数
+	MaxPromptLength int
+	// Models 是允许使用的模型，第一个是默认模型
+	Models []string
+	// DisableSummaries 为 true 时不自动生成会话的标题和摘要
+	DisableSummaries bool
+}
+
+var conversationConfig = &ConversationConfig{
+	MaxPromptLength: DefaultMaxPromptLength,
+	Models:          []string{DefaultModel},
+}
+
+// SetConversationConfig 设置提交会话的限制，未设置的值使用默认值
+func SetConversationConfig(config *ConversationConfig) {
+	if config.MaxPromptLength <= 0 {
+		config.MaxPromptLength = DefaultMaxPromptLength
+	}
+	if len(config.Models) == 0 {
+		config.Models = []string{DefaultModel}
+	}
+	conversationConfig = config
+}
+
+// conversationRequest 是提交会话的请求
+//
+// 消息的 ID 和角色由服务端生成，客户端只能提交提问的内容。
+// 继续已有的会话时需要同时提供 conversation_id 和 parent_message_id。
+type conversationRequest struct {
+	Prompt          string `json:"prompt" binding:"required"`
+	ConversationID  string `json:"conversation_id" binding:"omitempty,uuid"`
+	ParentMessageID string `json:"parent_message_id" binding:"omitempty,uuid"`
+	Model           string `json:"model"`
+}
+
+// bindConversationRequest 解析并校验提交会话的请求，失败时返回 false
+func (api *API) bindConversationRequest(c *gin.Context, userID string) (*conversationRequest, bool) {
+	var body conversationRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return nil, false
+	}
+
+	details := make(map[string]string)
+	body.Prompt = strings.TrimSpace(body.Prompt)
+	if body.Prompt == "" {
+		details["prompt"] = "required"
+	} else if utf8.RuneCountInString(body.Prompt) > conversationConfig.MaxPromptLength {
+		details["prompt"] = "max"
+	}
+	if body.Model == "" {
+		body.Model = conversationConfig.Models[0]
+	} else if !allowedModel(body.Model) {
+		details["model"] = "oneof"
+	}
+	if (body.ConversationID == "") != (body.ParentMessageID == "") {
+		details["parent_message_id"] = "required_with"
+	}
+	if len(details) > 0 {
+		fail(c, newError(http.StatusBadRequest, CodeValidation, "request validation failed").withDetails(details))
+		return nil, false
+	}
+
+	if body.ConversationID == "" {
+		// 新的会话由服务端生成父消息 ID
+		body.ParentMessageID = uuid.NewString()
+		return &body, true
+	}
+
+	// 只能继续自己的会话，父消息必须属于该会话并且没有被删除
+	if !api.checkConversationOwner(c, body.ConversationID, userID) {
+		return nil, false
+	}
+	parent, err := api.store.GetMessage(c.Request.Context(), body.ParentMessageID)
+	if err != nil && !ent.IsNotFound(err) {
+		fail(c, err)
+		return nil, false
+	}
+	if err != nil || parent.ConversationID != body.ConversationID || parent.DeletedAt != nil {
+		fail(c, newError(http.StatusNotFound, CodeNotFound, "parent message not found"))
+		return nil, false
+	}
+	return &body, true
+}
+
+// allowedModel 判断模型是否允许使用
+func allowedModel(model string) bool {
+	for _, m := range conversationConfig.Models {
+		if m == model {
+			return true
+		}
+	}
+	return false
+}
+
+// errConversationNotFound 是会话不存在或者没有权限读取，两种情况返回相同的错误，避免枚举 ID
+var errConversationNotFound = newError(http.StatusNotFound, CodeNotFound, "conversation not found")
+
+// canReadConversation 判断当前用户能否读取会话，返回能否读取被隐藏的消息
+//
+// 所有者可以读取全部消息；public 和 unlisted 主题的会话任何人都可以读取未隐藏的消息；
+// 拥有 PermReadPrivate 权限的管理员可以读取任何会话，使用 API key 时 key 也需要这个权限，每次读取都会记录审计。
+func (api *API) canReadConversation(c *gin.Context, conversationID, userID string, owner bool) (includeHidden bool, err error) {
+	if owner {
+		return true, nil
+	}
+	if conversationID != "" {
+		readable, err := api.store.IsConversationReadable(c.Request.Context(), conversationID)
+		if err != nil {
+			return false, err
+		}
+		if readable {
+			return false, nil
+		}
+	}
+	if userID != "" && currentRole(c).Can(PermReadPrivate) && scopeAllows(c, PermReadPrivate) {
+		api.audit(c, userID, "conversation.read_private", "conversation", conversationID, map[string]interface{}{
+			"path":  c.FullPath(),
+			"query": c.Request.URL.RawQuery,
+		})
+		return true, nil
+	}
+	return false, errConversationNotFound
+}
+
+// conversationPageSize 是会话列表每页的数量
+const conversationPageSize = 50
+
+// GetConversations 分页获取当前用户的会话，按最后活动时间倒序
+//
+// deleted=true 时获取回收站中被删除、还没有彻底删除的会话。
+func (api *API) GetConversations(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	deleted := c.Query("deleted") == "true"
+
+	conversations, err := api.store.ListUserConversations(c.Request.Context(), userID, deleted, (page-1)*conversationPageSize, conversationPageSize)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, conversations)
+}
+
+// PatchConversation 修改自己的会话的标题或系统提示，没有提供的字段不修改
+func (api *API) PatchConversation(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Title        *string `json:"title" binding:"omitempty,max=255"`
+		SystemPrompt *string `json:"system_prompt"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+	if body.Title != nil {
+		title := strings.TrimSpace(*body.Title)
+		body.Title = &title
+	}
+
+	conversation, err := api.store.UpdateConversation(c.Request.Context(), c.Param("id"), userID, body.Title, body.SystemPrompt)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, errConversationNotFound)
+			return
+		}
+		log.WithFields(log.Fields{
+			"method": "restapi.PatchConversation",
+			"event":  "db.UpdateConversation",
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, conversation)
+}
@@ -0,0 +1,211 @@
+package restapi
+
+import (
+	"mime"
+	"net/http"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/export"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// bulkExportPageSize 是批量导出分类时每次读取的主题数量
+const bulkExportPageSize = 100
+
+// attachment 返回下载文件的 Content-Disposition，文件名中的引号、换行和非 ASCII 字符会被转义
+func attachment(filename string) string {
+	if value := mime.FormatMediaType("attachment", map[string]string{"filename": filename}); value != "" {
+		return value
+	}
+	return "attachment"
+}
+
+// parseExportFormat 解析查询参数 format，默认为 Markdown
+func parseExportFormat(c *gin.Context) (export.Format, bool) {
+	format, ok := export.ParseFormat(c.DefaultQuery("format", string(export.FormatMarkdown)))
+	if !ok {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "format must be one of md, html, json and txt"))
+	}
+	return format, ok
+}
+
+// selectBranch 返回消息树中从根消息到 leafID 的分支，leafID 为空时选择最新的消息
+//
+// messages 按时间排序。没有权限读取的父消息不在 messages 中，分支从它的子消息开始。
+func selectBranch(messages []*ent.Message, leafID string) ([]*ent.Message, bool) {
+	if len(messages) == 0 {
+		return nil, leafID == ""
+	}
+	byID := make(map[string]*ent.Message, len(messages))
+	for _, m := range messages {
+		byID[m.ID] = m
+	}
+	leaf := messages[len(messages)-1]
+	if leafID != "" {
+		var ok bool
+		if leaf, ok = byID[leafID]; !ok {
+			return nil, false
+		}
+	}
+
+	var branch []*ent.Message
+	for m := leaf; m != nil && len(branch) < len(messages); m = byID[m.ParentMessageID] {
+		branch = append(branch, m)
+	}
+	for i, j := 0, len(branch)-1; i < j; i, j = i+1, j-1 {
+		branch[i], branch[j] = branch[j], branch[i]
+	}
+	return branch, true
+}
+
+// GetConversationExport 将会话中选择的分支导出为 Markdown、HTML、JSON 或纯文本文件
+//
+// 查询参数 message_id 选择分支的最后一条消息，默认为最新的消息。
+// 权限与读取会话相同，其他用户只能导出未隐藏的消息，被删除的消息只保留位置。
+func (api *API) GetConversationExport(c *gin.Context) {
+	format, ok := parseExportFormat(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	userID := api.optionalUserID(c)
+	owner := false
+	if userID != "" {
+		var err error
+		if owner, err = api.store.IsConversationOwner(ctx, id, userID); err != nil {
+			fail(c, err)
+			return
+		}
+	}
+	includeHidden, err := api.canReadConversation(c, id, userID, owner)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+
+	conversation, err := api.store.GetConversation(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, errConversationNotFound)
+			return
+		}
+		fail(c, err)
+		return
+	}
+	messages, err := api.store.GetConversationMessages(ctx, id, includeHidden)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	branch, ok := selectBranch(messages, c.Query("message_id"))
+	if !ok {
+		fail(c, newError(http.StatusNotFound, CodeNotFound, "message not found"))
+		return
+	}
+
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", attachment(id+"."+string(format)))
+	c.Status(http.StatusOK)
+	if err = format.Write(c.Writer, newExportConversation(conversation, branch)); err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.GetConversationExport",
+			"event":  "export.Write",
+		}).Info(err.Error())
+	}
+}
+
+// GetAdminExport 将一个分类中所有公开的主题导出为 zip 归档，每个主题一个文件
+//
+// 每个主题导出会话的默认分支，只包含公开显示的消息，文件名是主题 ID。
+// 归档边生成边发送，开始发送后出错时归档不完整，客户端无法解压。
+func (api *API) GetAdminExport(c *gin.Context) {
+	actorID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+	category := c.Query("category")
+	if category == "" {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "category is required"))
+		return
+	}
+	format, ok := parseExportFormat(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	topics, err := api.store.ListPublicTopics(ctx, category, 0, bulkExportPageSize)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	if len(topics) == 0 {
+		fail(c, newError(http.StatusNotFound, CodeNotFound, "category not found"))
+		return
+	}
+
+	api.audit(c, actorID, "export.category", "category", category, map[string]interface{}{
+		"format": string(format),
+	})
+
+	now := time.Now()
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", attachment(category+"-"+now.Format("20060102")+".zip"))
+	c.Status(http.StatusOK)
+	bundle := export.NewBundle(c.Writer, format, now)
+	for offset := 0; len(topics) > 0; {
+		for _, t := range topics {
+			if err = api.addTopicExport(c, bundle, t); err != nil {
+				log.WithFields(log.Fields{
+					"method": "restapi.GetAdminExport",
+					"event":  "addTopicExport",
+					"topic":  t.ID,
+				}).Info(err.Error())
+				return
+			}
+		}
+		if len(topics) < bulkExportPageSize {
+			break
+		}
+		offset += len(topics)
+		if topics, err = api.store.ListPublicTopics(ctx, category, offset, bulkExportPageSize); err != nil {
+			log.WithFields(log.Fields{
+				"method": "restapi.GetAdminExport",
+				"event":  "db.ListPublicTopics",
+			}).Info(err.Error())
+			return
+		}
+	}
+	if err = bundle.Close(); err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.GetAdminExport",
+			"event":  "bundle.Close",
+		}).Info(err.Error())
+	}
+}
+
+// addTopicExport 将主题的会话写入归档，标题和摘要使用主题的
+func (api *API) addTopicExport(c *gin.Context, bundle *export.Bundle, t *ent.Topic) error {
+	ctx := c.Request.Context()
+	conversation, err := api.store.GetConversation(ctx, t.ConversationID)
+	if err != nil {
+		return err
+	}
+	messages, err := api.store.GetConversationMessages(ctx, t.ConversationID, false)
+	if err != nil {
+		return err
+	}
+	branch, _ := selectBranch(messages, "")
+
+	e := newExportConversation(conversation, branch)
+	e.Title = t.Title
+	if t.Summary != "" {
+		e.Summary = t.Summary
+	}
+	return bundle.Add(t.ID, e)
+}
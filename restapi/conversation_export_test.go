@@ -0,0 +1,66 @@
+package restapi
+
+import (
+	"mime"
+	"reflect"
+	"testing"
+
+	"community.threetenth.chatgpt/ent"
+)
+
+func TestSelectBranch(t *testing.T) {
+	// messages 按 ID 和父消息 ID 创建按时间排序的消息
+	messages := func(pairs ...string) []*ent.Message {
+		ms := make([]*ent.Message, 0, len(pairs)/2)
+		for i := 0; i < len(pairs); i += 2 {
+			ms = append(ms, &ent.Message{ID: pairs[i], ParentMessageID: pairs[i+1]})
+		}
+		return ms
+	}
+	tree := messages("q1", "root", "a1", "q1", "a2", "q1", "q2", "a1")
+
+	for _, tc := range []struct {
+		name     string
+		messages []*ent.Message
+		leafID   string
+		want     []string
+		ok       bool
+	}{
+		{"empty", nil, "", nil, true},
+		{"empty with leaf", nil, "q1", nil, false},
+		{"latest", tree, "", []string{"q1", "a1", "q2"}, true},
+		{"other branch", tree, "a2", []string{"q1", "a2"}, true},
+		{"middle of a branch", tree, "a1", []string{"q1", "a1"}, true},
+		{"unknown leaf", tree, "missing", nil, false},
+		// 没有权限读取的父消息不在 messages 中，分支从它的子消息开始
+		{"hidden parent", messages("a1", "q1", "q2", "a1"), "", []string{"a1", "q2"}, true},
+		// 父消息形成环时最多返回所有消息，不会死循环
+		{"cycle", messages("m1", "m2", "m2", "m1"), "", []string{"m1", "m2"}, true},
+		{"self parent", messages("m1", "m1"), "", []string{"m1"}, true},
+	} {
+		branch, ok := selectBranch(tc.messages, tc.leafID)
+		var got []string
+		for _, m := range branch {
+			got = append(got, m.ID)
+		}
+		if ok != tc.ok || !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: selectBranch() = %v, %v, want %v, %v", tc.name, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestAttachment(t *testing.T) {
+	for _, filename := range []string{
+		"c1.md",
+		// 分类和会话 ID 来自用户输入
+		`a"; filename="evil.exe`,
+		"a\r\nSet-Cookie: x=1.zip",
+		"讨论-20230102.zip",
+	} {
+		value := attachment(filename)
+		disposition, params, err := mime.ParseMediaType(value)
+		if err != nil || disposition != "attachment" || params["filename"] != filename {
+			t.Errorf("attachment(%q) = %s, parsed %s %v %v", filename, value, disposition, params, err)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package restapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"github.com/gin-gonic/gin"
+)
+
+func TestBindConversationRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	const (
+		c1     = "11111111-1111-4111-8111-111111111111"
+		c2     = "22222222-2222-4222-8222-222222222222"
+		parent = "33333333-3333-4333-8333-333333333333"
+		other  = "44444444-4444-4444-8444-444444444444"
+		gone   = "55555555-5555-4555-8555-555555555555"
+	)
+	deletedAt := time.Now()
+	store := &fakeStore{
+		messages: map[string]*ent.Message{
+			parent: {ID: parent, ConversationID: c1},
+			other:  {ID: other, ConversationID: c2},
+			gone:   {ID: gone, ConversationID: c1, DeletedAt: &deletedAt},
+		},
+		conversations: map[string]string{c1: "alice", c2: "bob"},
+	}
+	api := New(store)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.POST("/", func(c *gin.Context) {
+		if body, ok := api.bindConversationRequest(c, "alice"); ok {
+			c.JSON(http.StatusOK, body)
+		}
+	})
+
+	for _, tc := range []struct {
+		name   string
+		body   string
+		status int
+		code   string
+	}{
+		{"new", `{"prompt":" hello "}`, http.StatusOK, ""},
+		{"continue", `{"prompt":"hello","conversation_id":"` + c1 + `","parent_message_id":"` + parent + `"}`, http.StatusOK, ""},
+		{"blank prompt", `{"prompt":"  "}`, http.StatusBadRequest, CodeValidation},
+		{"long prompt", `{"prompt":"` + strings.Repeat("a", DefaultMaxPromptLength+1) + `"}`, http.StatusBadRequest, CodeValidation},
+		{"unknown model", `{"prompt":"hello","model":"gpt-5"}`, http.StatusBadRequest, CodeValidation},
+		{"missing parent", `{"prompt":"hello","conversation_id":"` + c1 + `"}`, http.StatusBadRequest, CodeValidation},
+		{"invalid id", `{"prompt":"hello","conversation_id":"c1","parent_message_id":"p1"}`, http.StatusBadRequest, ""},
+		{"other owner", `{"prompt":"hello","conversation_id":"` + c2 + `","parent_message_id":"` + other + `"}`, http.StatusNotFound, CodeNotFound},
+		{"foreign parent", `{"prompt":"hello","conversation_id":"` + c1 + `","parent_message_id":"` + other + `"}`, http.StatusNotFound, CodeNotFound},
+		{"deleted parent", `{"prompt":"hello","conversation_id":"` + c1 + `","parent_message_id":"` + gone + `"}`, http.StatusNotFound, CodeNotFound},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != tc.status {
+			t.Errorf("%s: status = %d %s, want %d", tc.name, w.Code, w.Body.String(), tc.status)
+			continue
+		}
+		if w.Code == http.StatusOK {
+			var body conversationRequest
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil || body.Prompt != "hello" || body.Model != DefaultModel || body.ParentMessageID == "" {
+				t.Errorf("%s: body = %s", tc.name, w.Body.String())
+			}
+		} else if tc.code != "" {
+			var e Error
+			if err := json.Unmarshal(w.Body.Bytes(), &e); err != nil || e.Code != tc.code {
+				t.Errorf("%s: error = %s, want code %s", tc.name, w.Body.String(), tc.code)
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package restapi
+
+import (
+	"context"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/credential"
+	"community.threetenth.chatgpt/openai"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// accessTokenRefreshMargin 是访问令牌过期前提前刷新的时间
+const accessTokenRefreshMargin = time.Minute
+
+// saveUpstreamToken 保存用户加密后的 ChatGPT 访问令牌和会话令牌
+func (api *API) saveUpstreamToken(ctx context.Context, userID string, token *openai.Token) error {
+	err := api.store.SaveCredential(ctx, userID, credential.KindAccessToken,
+		string(token.AccessToken), token.AccessToken.KeyID(), "", &token.Expires)
+	if err != nil {
+		return err
+	}
+	return api.store.SaveCredential(ctx, userID, credential.KindSessionToken,
+		string(token.SessionToken), token.SessionToken.KeyID(), "", nil)
+}
+
+// upstreamAccessToken 获取用户加密的 ChatGPT 访问令牌
+//
+// 访问令牌即将过期时，使用保存的会话令牌重新获取。用户没有保存访问令牌时返回 NotFoundError。
+func (api *API) upstreamAccessToken(ctx context.Context, userID string) (openai.Credential, error) {
+	accessToken, err := api.store.GetCredential(ctx, userID, credential.KindAccessToken)
+	if err != nil {
+		return "", err
+	}
+	if accessToken.ExpiresAt == nil || time.Now().Add(accessTokenRefreshMargin).Before(*accessToken.ExpiresAt) {
+		return openai.Credential(accessToken.Value), nil
+	}
+
+	sessionToken, err := api.store.GetCredential(ctx, userID, credential.KindSessionToken)
+	if err != nil {
+		return "", err
+	}
+	token, err := openai.RefreshChatGPTSession(openai.Credential(sessionToken.Value))
+	if err != nil {
+		return "", err
+	}
+	if err = api.saveUpstreamToken(ctx, userID, token); err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+// LoadCredentials 恢复保存的 cf_clearance，并使用当前密钥重新加密其他密钥加密的凭据
+func (api *API) LoadCredentials(ctx context.Context) {
+	cf, err := api.store.GetCredential(ctx, "", credential.KindCfClearance)
+	if err == nil {
+		openai.RestoreCloudflareCaptcha(openai.Credential(cf.Value), cf.UserAgent)
+	} else if !ent.IsNotFound(err) {
+		log.WithFields(log.Fields{
+			"method": "restapi.LoadCredentials",
+			"event":  "db.GetCredential",
+		}).Warn(err.Error())
+	}
+
+	keyring := openai.CurrentKeyring()
+	credentials, err := api.store.ListCredentialsNotEncryptedWith(ctx, keyring.ActiveKey())
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.LoadCredentials",
+			"event":  "db.ListCredentialsNotEncryptedWith",
+		}).Warn(err.Error())
+		return
+	}
+	for _, c := range credentials {
+		sealed, err := keyring.Reseal(openai.Credential(c.Value))
+		if err == nil {
+			err = api.store.UpdateCredentialValue(ctx, c.ID, string(sealed), sealed.KeyID())
+		}
+		if err != nil {
+			// 密钥已经删除的凭据无法解密，用户需要重新更新 ChatGPT 会话
+			log.WithFields(log.Fields{
+				"method": "restapi.LoadCredentials",
+				"event":  "openai.Reseal",
+				"id":     c.ID,
+				"key_id": c.KeyID,
+			}).Warn(err.Error())
+		}
+	}
+}
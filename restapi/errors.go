@@ -0,0 +1,243 @@
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/db"
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/openai"
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// 错误码，客户端根据错误码处理错误，message 只用于展示
+const (
+	CodeInvalidRequest       = "invalid_request"
+	CodeValidation           = "validation_failed"
+	CodeUnauthorized         = "unauthorized"
+	CodeForbidden            = "forbidden"
+	CodeBanned               = "banned"
+	CodeNotFound             = "not_found"
+	CodeConflict             = "conflict"
+	CodePreconditionFailed   = "precondition_failed"
+	CodeModerationRejected   = "moderation_rejected"
+	CodeDisabled             = "feature_disabled"
+	CodeUpstream             = "upstream_error"
+	CodeUpstreamUnauthorized = "upstream_unauthorized"
+	CodeUpstreamChallenge    = "upstream_challenge"
+	CodeUpstreamRateLimited  = "upstream_rate_limited"
+	CodeInternal             = "internal_error"
+)
+
+// RequestIDHeader 是请求 ID 的 header，客户端没有提供时由服务端生成
+const RequestIDHeader = "X-Request-ID"
+
+const contextRequestID = "restapi.requestID"
+
+// upstreamMessageLength 是上游错误信息在 message 中保留的最大长度
+const upstreamMessageLength = 200
+
+// Error 是接口返回的错误
+//
+// 所有接口的错误都使用 {code, message, details, request_id} 的 JSON 格式返回，
+// 原始错误只输出到日志，不会返回给客户端。
+type Error struct {
+	Status    int         `json:"-"`
+	Code      string      `json:"code"`
+	Message   string      `json:"message"`
+	Details   interface{} `json:"details,omitempty"`
+	RequestID string      `json:"request_id"`
+
+	cause error
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return e.Message + ": " + e.cause.Error()
+	}
+	return e.Message
+}
+
+// Unwrap 返回原始错误
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// newError 创建一个接口错误
+func newError(status int, code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// withDetails 设置错误的详细信息
+func (e *Error) withDetails(details interface{}) *Error {
+	e.Details = details
+	return e
+}
+
+// withCause 设置原始错误，原始错误只输出到日志
+func (e *Error) withCause(err error) *Error {
+	e.cause = err
+	return e
+}
+
+// invalidRequest 创建一个请求参数错误，gin 绑定参数的校验错误会列出每个字段
+func invalidRequest(err error) *Error {
+	var fieldErrors validator.ValidationErrors
+	if errors.As(err, &fieldErrors) {
+		details := make(map[string]string, len(fieldErrors))
+		for _, fe := range fieldErrors {
+			details[fe.Field()] = fe.Tag()
+		}
+		return newError(http.StatusBadRequest, CodeValidation, "request validation failed").withDetails(details)
+	}
+
+	var syntaxError *json.SyntaxError
+	var typeError *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxError):
+		return newError(http.StatusBadRequest, CodeInvalidRequest, "request body is not valid JSON")
+	case errors.As(err, &typeError):
+		return newError(http.StatusBadRequest, CodeInvalidRequest, fmt.Sprintf("field %s must be %s", typeError.Field, typeError.Type))
+	}
+	return newError(http.StatusBadRequest, CodeInvalidRequest, err.Error())
+}
+
+// fail 中止请求并记录错误，由 ErrorHandler 统一返回错误
+func fail(c *gin.Context, err error) {
+	c.Error(err)
+	c.Abort()
+}
+
+// Fail 中止请求并记录错误，由 ErrorHandler 按错误码返回，用于服务端渲染的页面
+func Fail(c *gin.Context, err error) {
+	fail(c, err)
+}
+
+var htmlRegexp = regexp.MustCompile(`(?i)<\s*(!doctype|html|head|body)\b`)
+
+// upstreamError 转换 ChatGPT 返回的错误，Cloudflare 的 HTML 页面不会返回给客户端
+func upstreamError(e *openai.HTTPStatusError) *Error {
+	details := map[string]interface{}{"status": e.Code}
+	message := strings.TrimSpace(e.Text)
+	if htmlRegexp.MatchString(message) {
+		message = "upstream returned an HTML page"
+		details["html"] = true
+	} else if rs := []rune(message); len(rs) > upstreamMessageLength {
+		message = string(rs[:upstreamMessageLength]) + "…"
+	}
+	if message == "" {
+		message = e.Name
+	}
+
+	var err *Error
+	switch {
+	case e.Code == http.StatusUnauthorized:
+		err = newError(http.StatusUnauthorized, CodeUpstreamUnauthorized, message)
+	case e.Code == http.StatusForbidden && details["html"] != nil:
+		// Cloudflare 的验证页面，需要更新 cf_clearance
+		err = newError(http.StatusServiceUnavailable, CodeUpstreamChallenge, "upstream requires captcha verification")
+	case e.Code == http.StatusTooManyRequests:
+		err = newError(http.StatusTooManyRequests, CodeUpstreamRateLimited, message)
+	default:
+		err = newError(http.StatusBadGateway, CodeUpstream, message)
+	}
+	err.cause = e
+	return err.withDetails(details)
+}
+
+// toError 将错误转换为接口错误
+func toError(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	var upstream *openai.HTTPStatusError
+	if errors.As(err, &upstream) {
+		return upstreamError(upstream)
+	}
+
+	switch {
+	case ent.IsNotFound(err):
+		e = newError(http.StatusNotFound, CodeNotFound, "resource not found")
+	case ent.IsValidationError(err):
+		var ve *ent.ValidationError
+		errors.As(err, &ve)
+		e = newError(http.StatusUnprocessableEntity, CodeValidation, "validation failed").
+			withDetails(map[string]string{ve.Name: ve.Unwrap().Error()})
+	case ent.IsConstraintError(err):
+		e = newError(http.StatusConflict, CodeConflict, "resource already exists")
+	case errors.Is(err, db.ErrTurnFinished):
+		e = newError(http.StatusConflict, CodeConflict, "the question is no longer waiting for an answer")
+	case errors.Is(err, db.ErrRemoved):
+		e = newError(http.StatusForbidden, CodeForbidden, "removed by a moderator and can't be restored")
+	case errors.Is(err, errAuthorizationFailed), errors.Is(err, auth.ErrInvalidToken), errors.Is(err, auth.ErrExpiredToken):
+		e = newError(http.StatusUnauthorized, CodeUnauthorized, err.Error())
+	case errors.Is(err, openai.ErrInvalidCredential):
+		e = newError(http.StatusPreconditionFailed, CodePreconditionFailed, "ChatGPT session must be updated")
+	default:
+		e = newError(http.StatusInternalServerError, CodeInternal, "internal server error")
+	}
+	e.cause = err
+	return e
+}
+
+// requestID 获取当前请求的 ID
+func requestID(c *gin.Context) string {
+	return c.GetString(contextRequestID)
+}
+
+// ErrorHandler 返回统一处理错误的中间件
+//
+// 中间件为每个请求设置请求 ID，处理函数通过 fail 记录的最后一个错误会转换为 JSON 错误返回；
+// 流模式下已经开始回复时，错误作为 error 事件发送。
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if id == "" || len(id) > 64 {
+			id = uuid.NewString()
+		}
+		c.Set(contextRequestID, id)
+		c.Header(RequestIDHeader, id)
+
+		c.Next()
+
+		last := c.Errors.Last()
+		if last == nil {
+			return
+		}
+		e := *toError(last.Err)
+		e.RequestID = id
+
+		fields := log.Fields{
+			"method":     "restapi.ErrorHandler",
+			"event":      e.Code,
+			"path":       c.FullPath(),
+			"request_id": id,
+		}
+		if e.Status >= http.StatusInternalServerError {
+			log.WithFields(fields).Warn(last.Err.Error())
+		} else {
+			log.WithFields(fields).Info(last.Err.Error())
+		}
+
+		if c.Writer.Written() {
+			if strings.HasPrefix(c.Writer.Header().Get("Content-Type"), ContentTypeEventStream) {
+				if sse.Encode(c.Writer, sse.Event{Event: "error", Data: &e}) == nil {
+					c.Writer.Flush()
+				}
+			}
+			return
+		}
+		c.JSON(e.Status, &e)
+	}
+}
@@ -0,0 +1,54 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/db"
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/openai"
+)
+
+func TestToError(t *testing.T) {
+	topicLocked := newError(http.StatusForbidden, CodeForbidden, "topic is locked")
+	long := strings.Repeat("x", upstreamMessageLength+10)
+
+	for _, tc := range []struct {
+		name    string
+		err     error
+		status  int
+		code    string
+		message string
+	}{
+		{"api error", topicLocked, http.StatusForbidden, CodeForbidden, "topic is locked"},
+		{"wrapped api error", fmt.Errorf("save: %w", topicLocked), http.StatusForbidden, CodeForbidden, "topic is locked"},
+		{"not found", &ent.NotFoundError{}, http.StatusNotFound, CodeNotFound, "resource not found"},
+		{"constraint", &ent.ConstraintError{}, http.StatusConflict, CodeConflict, "resource already exists"},
+		{"turn finished", fmt.Errorf("complete: %w", db.ErrTurnFinished), http.StatusConflict, CodeConflict, "the question is no longer waiting for an answer"},
+		{"removed", db.ErrRemoved, http.StatusForbidden, CodeForbidden, "removed by a moderator and can't be restored"},
+		{"authorization", errAuthorizationFailed, http.StatusUnauthorized, CodeUnauthorized, errAuthorizationFailed.Error()},
+		{"expired token", auth.ErrExpiredToken, http.StatusUnauthorized, CodeUnauthorized, auth.ErrExpiredToken.Error()},
+		{"credential", openai.ErrInvalidCredential, http.StatusPreconditionFailed, CodePreconditionFailed, "ChatGPT session must be updated"},
+		{"upstream unauthorized", &openai.HTTPStatusError{Code: 401, Name: "401 Unauthorized", Text: "token expired"}, http.StatusUnauthorized, CodeUpstreamUnauthorized, "token expired"},
+		// Cloudflare 的验证页面不会返回给客户端
+		{"upstream challenge", &openai.HTTPStatusError{Code: 403, Name: "403 Forbidden", Text: "<!DOCTYPE html><html>captcha</html>"}, http.StatusServiceUnavailable, CodeUpstreamChallenge, "upstream requires captcha verification"},
+		{"upstream html", &openai.HTTPStatusError{Code: 502, Name: "502 Bad Gateway", Text: "<html>bad gateway</html>"}, http.StatusBadGateway, CodeUpstream, "upstream returned an HTML page"},
+		{"upstream rate limited", &openai.HTTPStatusError{Code: 429, Name: "429 Too Many Requests", Text: "slow down"}, http.StatusTooManyRequests, CodeUpstreamRateLimited, "slow down"},
+		{"upstream empty", &openai.HTTPStatusError{Code: 500, Name: "500 Internal Server Error"}, http.StatusBadGateway, CodeUpstream, "500 Internal Server Error"},
+		{"upstream long", &openai.HTTPStatusError{Code: 500, Text: long}, http.StatusBadGateway, CodeUpstream, long[:upstreamMessageLength] + "…"},
+		// 未知的错误不会返回原始的错误信息
+		{"internal", errors.New("dial tcp 10.0.0.1:5432: connection refused"), http.StatusInternalServerError, CodeInternal, "internal server error"},
+	} {
+		e := toError(tc.err)
+		if e.Status != tc.status || e.Code != tc.code || e.Message != tc.message {
+			t.Errorf("%s: toError() = %d %s %q, want %d %s %q", tc.name, e.Status, e.Code, e.Message, tc.status, tc.code, tc.message)
+		}
+		if !errors.Is(e, tc.err) && !errors.As(tc.err, new(*Error)) {
+			t.Errorf("%s: toError() does not wrap the original error", tc.name)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/dataexport"
+	"community.threetenth.chatgpt/export"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// exportTimeout 是生成一次用户数据归档的最长时间
+const exportTimeout = 10 * time.Minute
+
+// dataExportView 是返回给客户端的导出任务，生成完成后附带下载链接
+type dataExportView struct {
+	*ent.DataExport
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+// newDataExportView 创建导出任务的返回值
+func newDataExportView(e *ent.DataExport) *dataExportView {
+	view := &dataExportView{DataExport: e}
+	if e.Status == dataexport.StatusReady {
+		view.DownloadURL = exportDownloadURL(e.ID)
+	}
+	return view
+}
+
+// exportDownloadURL 返回下载归档的链接，配置了站点根地址时为绝对地址
+func exportDownloadURL(id string) string {
+	url := "/api/v1/account/exports/" + id + "/download"
+	if accountConfig != nil {
+		url = accountConfig.BaseURL + url
+	}
+	return url
+}
+
+// newExportConversation 将会话转换为导出的格式，被删除的消息只保留位置，不导出内容
+func newExportConversation(c *ent.Conversation, messages []*ent.Message) *export.Conversation {
+	conversation := &export.Conversation{
+		ID:        c.ID,
+		Title:     c.Title,
+		Model:     c.Model,
+		Summary:   c.Summary,
+		CreatedAt: c.CreatedAt,
+		Messages:  make([]*export.Message, 0, len(messages)),
+	}
+	for _, m := range messages {
+		message := &export.Message{
+			ID:              m.ID,
+			Role:            m.Role,
+			Content:         m.Content,
+			ParentMessageID: m.ParentMessageID,
+			CreatedAt:       m.CreatedAt,
+		}
+		if m.DeletedAt != nil {
+			message.Content = ""
+			message.Deleted = true
+		}
+		conversation.Messages = append(conversation.Messages, message)
+	}
+	return conversation
+}
+
+// PostAccountExport 在后台导出当前用户的全部数据，返回 202 和导出任务
+//
+// 已经有正在生成的导出时返回该任务；已经有还没有过期的归档时返回 200 和该归档，
+// 每个用户在归档过期前只生成一次。生成完成后可以通过 download_url 下载，
+// 邮箱已经验证的用户同时会收到邮件。
+func (api *API) PostAccountExport(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+	if privacyConfig.ExportDir == "" {
+		fail(c, newError(http.StatusNotFound, CodeDisabled, "data export is disabled"))
+		return
+	}
+
+	exports, err := api.store.ListDataExports(c.Request.Context(), userID)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	for _, e := range exports {
+		switch e.Status {
+		case dataexport.StatusPending:
+			c.JSON(http.StatusAccepted, newDataExportView(e))
+			return
+		case dataexport.StatusReady:
+			c.JSON(http.StatusOK, newDataExportView(e))
+			return
+		}
+	}
+
+	e, err := api.store.CreateDataExport(c.Request.Context(), userID, time.Now().Add(privacyConfig.ExportMaxAge))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.PostAccountExport",
+			"event":  "db.CreateDataExport",
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+	api.audit(c, userID, "account.export", "data_export", e.ID, nil)
+	api.buildExport(e, userID)
+	c.JSON(http.StatusAccepted, newDataExportView(e))
+}
+
+// GetAccountExports 获取当前用户未过期的导出任务
+func (api *API) GetAccountExports(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	exports, err := api.store.ListDataExports(c.Request.Context(), userID)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	views := make([]*dataExportView, len(exports))
+	for i, e := range exports {
+		views[i] = newDataExportView(e)
+	}
+	c.JSON(http.StatusOK, views)
+}
+
+// GetAccountExportDownload 下载自己的导出归档，还在生成时返回 409
+func (api *API) GetAccountExportDownload(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	e, err := api.store.GetDataExport(c.Request.Context(), c.Param("id"), userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, newError(http.StatusNotFound, CodeNotFound, "export not found"))
+			return
+		}
+		fail(c, err)
+		return
+	}
+	if e.Status != dataexport.StatusReady {
+		fail(c, newError(http.StatusConflict, CodeConflict, "export is "+string(e.Status)))
+		return
+	}
+
+	api.audit(c, userID, "account.export_download", "data_export", e.ID, nil)
+	c.FileAttachment(ExportPath(e.ID), "chatgpt-community-"+e.CreatedAt.Format("20060102")+".zip")
+}
+
+// buildExport 在后台生成导出归档，完成后通知用户，失败时标记为 failed
+func (api *API) buildExport(e *ent.DataExport, userID string) {
+	id := e.ID
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+		defer cancel()
+
+		size, err := api.writeExport(ctx, id, userID)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"method": "restapi.buildExport",
+				"event":  "writeExport",
+				"id":     id,
+			}).Info(err.Error())
+			err = api.store.SetDataExportFailed(ctx, id, "failed to build the archive")
+		} else {
+			err = api.store.SetDataExportReady(ctx, id, size)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"method": "restapi.buildExport",
+				"event":  "db.SetDataExportStatus",
+				"id":     id,
+			}).Info(err.Error())
+			return
+		}
+		if size > 0 {
+			api.notifyExportReady(ctx, e, userID)
+		}
+	}()
+}
+
+// writeExport 将用户的全部数据写入归档文件，返回文件的字节数
+//
+// 先写入临时文件，完成后再重命名，下载时不会读到不完整的归档。
+func (api *API) writeExport(ctx context.Context, id, userID string) (int64, error) {
+	data, err := api.store.GetUserData(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	// 每个会话的 Markdown 只包含最新的分支，完整的消息树在 data.json 中
+	conversations := make([]*export.Conversation, len(data.Conversations))
+	for i, c := range data.Conversations {
+		branch, _ := selectBranch(c.Edges.Messages, "")
+		conversations[i] = newExportConversation(c, branch)
+	}
+
+	f, err := os.CreateTemp(privacyConfig.ExportDir, id+"-*.tmp")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(f.Name())
+	if err = export.WriteArchive(f, data, conversations, time.Now()); err != nil {
+		f.Close()
+		return 0, err
+	}
+	if err = f.Close(); err != nil {
+		return 0, err
+	}
+	if err = os.Rename(f.Name(), ExportPath(id)); err != nil {
+		return 0, err
+	}
+	info, err := os.Stat(ExportPath(id))
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// notifyExportReady 向邮箱已经验证的用户发送归档的下载链接和过期时间
+func (api *API) notifyExportReady(ctx context.Context, e *ent.DataExport, userID string) {
+	if accountConfig == nil || accountConfig.Mailer == nil {
+		return
+	}
+	u, err := api.store.GetUser(ctx, userID)
+	if err != nil || u.EmailVerifiedAt == nil {
+		return
+	}
+	sendAccountMail(u.Email, "Your data export is ready",
+		"Your ChatGPT Community data export is ready. Sign in and open the link below to download it before "+
+			e.ExpiresAt.UTC().Format("2006-01-02")+".",
+		exportDownloadURL(e.ID))
+}
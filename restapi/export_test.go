@@ -0,0 +1,217 @@
+package restapi
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/db"
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/dataexport"
+	"github.com/gin-gonic/gin"
+)
+
+// privacyStore 返回固定的用户数据和导出任务，记录注销的账号
+type privacyStore struct {
+	fakeStore
+	data    *db.UserData
+	exports []*ent.DataExport
+	created int
+	deleted []string
+}
+
+func (s *privacyStore) GetUserData(ctx context.Context, userID string) (*db.UserData, error) {
+	return s.data, nil
+}
+
+func (s *privacyStore) ListDataExports(ctx context.Context, userID string) ([]*ent.DataExport, error) {
+	return s.exports, nil
+}
+
+func (s *privacyStore) CreateDataExport(ctx context.Context, userID string, expiresAt time.Time) (*ent.DataExport, error) {
+	s.created++
+	return &ent.DataExport{ID: "new", Status: dataexport.StatusPending, ExpiresAt: expiresAt}, nil
+}
+
+func (s *privacyStore) DeleteAccount(ctx context.Context, id string, purge bool) ([]string, error) {
+	s.deleted = append(s.deleted, id)
+	return nil, nil
+}
+
+// readZipFile 读取归档中的一个文件
+func readZipFile(t *testing.T, r *zip.ReadCloser, name string) string {
+	t.Helper()
+	f, err := r.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	b, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestWriteExport(t *testing.T) {
+	SetPrivacyConfig(&PrivacyConfig{ExportDir: t.TempDir()})
+	t.Cleanup(func() { SetPrivacyConfig(&PrivacyConfig{}) })
+
+	created := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	deletedAt := created.Add(time.Hour)
+	store := &privacyStore{data: &db.UserData{
+		User: &ent.User{ID: "alice"},
+		Conversations: []*ent.Conversation{{
+			ID:        "c1",
+			Title:     "Hello",
+			CreatedAt: created,
+			Edges: ent.ConversationEdges{Messages: []*ent.Message{
+				{ID: "q1", Content: "hello", Role: "user", ParentMessageID: "root", CreatedAt: created},
+				{ID: "a1", Content: "first answer", Role: "assistant", ParentMessageID: "q1", CreatedAt: created.Add(time.Second)},
+				// 重新生成的回复是另一个分支，Markdown 只导出最新的分支
+				{ID: "a2", Content: "second answer", Role: "assistant", ParentMessageID: "q1", CreatedAt: created.Add(2 * time.Second)},
+				{ID: "q2", Content: "removed", Role: "user", ParentMessageID: "a2", DeletedAt: &deletedAt, CreatedAt: created.Add(3 * time.Second)},
+			}},
+		}},
+	}}
+	api := New(store)
+
+	size, err := api.writeExport(context.Background(), "e1", "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := zip.OpenReader(ExportPath("e1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if size == 0 {
+		t.Errorf("writeExport() size = 0")
+	}
+
+	data := readZipFile(t, r, "data.json")
+	for _, content := range []string{"first answer", "second answer", "removed"} {
+		if !strings.Contains(data, content) {
+			t.Errorf("data.json does not contain %q", content)
+		}
+	}
+	markdown := readZipFile(t, r, "conversations/c1.md")
+	if !strings.Contains(markdown, "hello") || !strings.Contains(markdown, "second answer") {
+		t.Errorf("conversation markdown = %s", markdown)
+	}
+	if strings.Contains(markdown, "first answer") || strings.Contains(markdown, "removed") {
+		t.Errorf("conversation markdown contains another branch or deleted content:\n%s", markdown)
+	}
+}
+
+func TestPostAccountExport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	alice := &ent.User{ID: "alice"}
+	store := &privacyStore{}
+	cookie := sessionCookie(t, &store.fakeStore, alice)
+	api := New(store)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.POST("/api/v1/account/exports", api.Require(PermAccount), api.PostAccountExport)
+
+	// 没有配置导出目录时不能导出
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/account/exports", nil)
+	req.AddCookie(cookie)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("POST without an export dir = %d %s, want 404", w.Code, w.Body.String())
+	}
+	SetPrivacyConfig(&PrivacyConfig{ExportDir: t.TempDir()})
+	t.Cleanup(func() { SetPrivacyConfig(&PrivacyConfig{}) })
+
+	// 已经有正在生成或者还没有过期的导出时不重复生成
+	for _, tc := range []struct {
+		export *ent.DataExport
+		status int
+	}{
+		{&ent.DataExport{ID: "pending", Status: dataexport.StatusPending}, http.StatusAccepted},
+		{&ent.DataExport{ID: "ready", Status: dataexport.StatusReady}, http.StatusOK},
+	} {
+		store.exports = []*ent.DataExport{tc.export, {ID: "failed", Status: dataexport.StatusFailed}}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/account/exports", nil)
+		req.AddCookie(cookie)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		var view struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &view); w.Code != tc.status || err != nil || view.ID != tc.export.ID {
+			t.Errorf("POST with a %s export = %d %s, want %d", tc.export.Status, w.Code, w.Body.String(), tc.status)
+		}
+	}
+	if store.created != 0 {
+		t.Errorf("created %d exports, want 0", store.created)
+	}
+}
+
+func TestAccountDeletion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	alice := &ent.User{ID: "alice"}
+	store := &privacyStore{fakeStore: fakeStore{keys: map[string]*ent.APIKey{
+		auth.HashAPIKey("cgc_account"): {Scopes: []string{string(PermAccount)}, Edges: ent.APIKeyEdges{User: alice}},
+	}}}
+	cookie := sessionCookie(t, &store.fakeStore, alice)
+	api := New(store)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.POST("/api/v1/account/deletion", api.Require(PermAccount), api.PostAccountDeletion)
+	router.DELETE("/api/v1/account", api.Require(PermAccount), api.DeleteAccount)
+
+	send := func(method, path, body, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set("Authorization", "Bearer "+key)
+		} else {
+			req.AddCookie(cookie)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// API key 不能申请注销，也不能使用确认令牌注销
+	if w := send(http.MethodPost, "/api/v1/account/deletion", "", "cgc_account"); w.Code != http.StatusForbidden {
+		t.Errorf("POST deletion with an api key = %d %s", w.Code, w.Body.String())
+	}
+	w := send(http.MethodPost, "/api/v1/account/deletion", "", "")
+	var deletion struct {
+		Token  string         `json:"token"`
+		Policy DeletionPolicy `json:"policy"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &deletion); w.Code != http.StatusOK || err != nil || deletion.Token == "" || deletion.Policy != DeletionAnonymize {
+		t.Fatalf("POST deletion = %d %s", w.Code, w.Body.String())
+	}
+	if w = send(http.MethodDelete, "/api/v1/account", `{"token":"`+deletion.Token+`"}`, "cgc_account"); w.Code != http.StatusForbidden {
+		t.Errorf("DELETE with an api key = %d %s", w.Code, w.Body.String())
+	}
+
+	// 其他用途的令牌，例如会话 cookie，不能用来注销
+	if w = send(http.MethodDelete, "/api/v1/account", `{"token":"`+cookie.Value+`"}`, ""); w.Code != http.StatusBadRequest {
+		t.Errorf("DELETE with a session token = %d %s", w.Code, w.Body.String())
+	}
+	if len(store.deleted) != 0 {
+		t.Fatalf("deleted %v before confirmation", store.deleted)
+	}
+
+	w = send(http.MethodDelete, "/api/v1/account", `{"token":"`+deletion.Token+`"}`, "")
+	if w.Code != http.StatusOK || len(store.deleted) != 1 || store.deleted[0] != "alice" {
+		t.Errorf("DELETE = %d %s, deleted %v", w.Code, w.Body.String(), store.deleted)
+	}
+	if len(store.audits) != 1 || store.audits[0] != "account.delete" {
+		t.Errorf("audits = %v", store.audits)
+	}
+}
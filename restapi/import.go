@@ -0,0 +1,256 @@
+package restapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"community.threetenth.chatgpt/db"
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/moderation"
+	"community.threetenth.chatgpt/openai"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// importMaxBytes 是导入的 conversations.json 的最大字节数
+const importMaxBytes = 64 << 20
+
+// PublishAll 是发布所有导入的会话
+const PublishAll = "all"
+
+// ImportOptions 是导入 ChatGPT 导出数据的选项
+type ImportOptions struct {
+	// Publish 是导入后发布为公开主题的 ChatGPT 会话 ID，包含 PublishAll 时发布所有导入的会话
+	Publish []string
+	// Category 是发布的主题的分类，默认为 general
+	Category string
+	// DryRun 为 true 时只返回导入的结果，不保存也不发布
+	DryRun bool
+}
+
+// publishes 判断导入后是否发布指定的会话
+func (o *ImportOptions) publishes(upstreamID string) bool {
+	for _, id := range o.Publish {
+		if id == PublishAll || id == upstreamID {
+			return true
+		}
+	}
+	return false
+}
+
+// importModerationTimeout 是审核一条导入的消息的最长时间
+const importModerationTimeout = time.Minute
+
+// importModeration 是正在后台审核的导入，测试等待审核完成
+var importModeration sync.WaitGroup
+
+// importedMessages 是一个会话中新导入、等待审核的消息
+type importedMessages struct {
+	conversationID string
+	messages       []*db.ImportedMessage
+}
+
+// moderateImported 在后台逐条审核导入的消息，审核允许的消息改为公开显示
+//
+// 导入的消息可能很多，不在请求中等待审核。审核失败的消息保持隐藏，进入人工审核队列。
+func (api *API) moderateImported(userID string, skipReview bool, pending []*importedMessages) {
+	if len(pending) == 0 {
+		return
+	}
+	importModeration.Add(1)
+	go func() {
+		defer importModeration.Done()
+		for _, p := range pending {
+			for _, m := range p.messages {
+				stage := moderation.StagePrompt
+				if m.Role == "assistant" {
+					stage = moderation.StageAnswer
+				}
+				ctx, cancel := context.WithTimeout(context.Background(), importModerationTimeout)
+				if api.moderateContent(ctx, stage, m.Content, m.ID, p.conversationID, userID, skipReview).Decision == moderation.Allow {
+					if err := api.store.SetMessageHidden(ctx, m.ID, false); err != nil {
+						log.WithFields(log.Fields{
+							"method":     "restapi.moderateImported",
+							"event":      "db.SetMessageHidden",
+							"message_id": m.ID,
+						}).Info(err.Error())
+					}
+				}
+				cancel()
+			}
+		}
+	}()
+}
+
+// ImportResult 是导入一个会话的结果，发布后附带主题 ID
+type ImportResult struct {
+	*db.ImportResult
+	TopicID string `json:"topic_id,omitempty"`
+	// Error 是发布失败的原因，会话仍然导入
+	Error string `json:"error,omitempty"`
+}
+
+// NewImportedConversation 将 ChatGPT 导出的会话转换为导入的格式
+func NewImportedConversation(c *openai.ExportedConversation) *db.ImportedConversation {
+	tree := c.Messages()
+	messages := make([]*db.ImportedMessage, len(tree))
+	for i, m := range tree {
+		messages[i] = &db.ImportedMessage{
+			ID:              m.ID,
+			Role:            m.Role,
+			Content:         m.Content,
+			ParentMessageID: m.ParentMessageID,
+			CreatedAt:       m.CreatedAt,
+		}
+	}
+	return &db.ImportedConversation{
+		UpstreamID: c.UpstreamID(),
+		Title:      c.Title,
+		Model:      c.Model(),
+		CreatedAt:  c.Created(),
+		UpdatedAt:  c.Updated(),
+		Messages:   messages,
+	}
+}
+
+// ImportConversations 为用户导入 ChatGPT 导出的会话，并将选择的会话发布为公开主题
+//
+// moderate 不为空时，新导入的消息先隐藏，每个会话保存后将新导入的消息交给 moderate 审核；
+// 命令行导入由运营者执行，不审核。每个会话单独保存，出错时之前的会话已经导入，重新导入不会重复。
+func (api *API) ImportConversations(ctx context.Context, userID string, conversations []*openai.ExportedConversation, options *ImportOptions, moderate func(conversationID string, messages []*db.ImportedMessage)) ([]*ImportResult, error) {
+	category := options.Category
+	if category == "" {
+		category = "general"
+	}
+
+	results := make([]*ImportResult, 0, len(conversations))
+	for _, c := range conversations {
+		imported := NewImportedConversation(c)
+		imported.Hidden = moderate != nil
+		r, err := api.store.ImportConversation(ctx, userID, imported, options.DryRun)
+		if err != nil {
+			return results, err
+		}
+		result := &ImportResult{ImportResult: r}
+		results = append(results, result)
+		if options.DryRun || r.Status == db.ImportSkipped {
+			continue
+		}
+
+		if moderate != nil && len(r.MessageIDs) > 0 {
+			byID := make(map[string]*db.ImportedMessage, len(imported.Messages))
+			for _, m := range imported.Messages {
+				byID[m.ID] = m
+			}
+			messages := make([]*db.ImportedMessage, 0, len(r.MessageIDs))
+			for _, id := range r.MessageIDs {
+				messages = append(messages, byID[id])
+			}
+			moderate(r.ConversationID, messages)
+		}
+
+		if !options.publishes(r.UpstreamID) {
+			continue
+		}
+		t, err := api.store.SaveTopic(ctx, r.ConversationID, r.Title, category, topic.VisibilityPublic, userID)
+		if err != nil {
+			if !ent.IsNotFound(err) && !ent.IsValidationError(err) && !errors.Is(err, db.ErrRemoved) {
+				return results, err
+			}
+			result.Error = err.Error()
+			continue
+		}
+		result.TopicID = t.ID
+	}
+	return results, nil
+}
+
+// PostConversationImport 导入 ChatGPT 导出数据中的 conversations.json
+//
+// 文件可以通过 multipart 的 file 字段上传，也可以直接作为 JSON 请求体。
+// 查询参数 publish 是导入后发布为公开主题的 ChatGPT 会话 ID，可以重复或者用逗号分隔，all 发布全部；
+// dry_run=true 时只返回每个会话导入的结果，客户端可以据此让用户选择要发布的会话。
+// 新导入的消息先隐藏，导入后在后台和提问一样经过审核，审核允许后才公开显示。
+func (api *API) PostConversationImport(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	options := &ImportOptions{
+		Category: c.Query("category"),
+		DryRun:   c.Query("dry_run") == "true",
+	}
+	for _, value := range c.QueryArray("publish") {
+		for _, id := range strings.Split(value, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				options.Publish = append(options.Publish, id)
+			}
+		}
+	}
+	if len(options.Publish) > 0 && !(currentRole(c).Can(PermTopic) && scopeAllows(c, PermTopic)) {
+		fail(c, newError(http.StatusForbidden, CodeForbidden, "permission denied: "+string(PermTopic)))
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, importMaxBytes)
+	var r io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			fail(c, invalidRequest(err))
+			return
+		}
+		f, err := header.Open()
+		if err != nil {
+			fail(c, invalidRequest(err))
+			return
+		}
+		defer f.Close()
+		r = f
+	}
+	conversations, err := openai.ReadConversations(r)
+	if err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid conversations.json: "+err.Error()))
+		return
+	}
+
+	var pending []*importedMessages
+	results, err := api.ImportConversations(c.Request.Context(), userID, conversations, options,
+		func(conversationID string, messages []*db.ImportedMessage) {
+			pending = append(pending, &importedMessages{conversationID: conversationID, messages: messages})
+		})
+	// 出错前已经导入的消息也需要审核
+	api.moderateImported(userID, currentRole(c).Can(PermSkipReview), pending)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.PostConversationImport",
+			"event":  "ImportConversations",
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+
+	if !options.DryRun {
+		counts := map[string]int{}
+		for _, r := range results {
+			counts[r.Status]++
+			if r.TopicID != "" {
+				counts["published"]++
+			}
+		}
+		payload := make(map[string]interface{}, len(counts))
+		for k, n := range counts {
+			payload[k] = n
+		}
+		api.audit(c, userID, "conversation.import", "user", userID, payload)
+	}
+	c.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,139 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/db"
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/topic"
+	"community.threetenth.chatgpt/moderation"
+	"github.com/gin-gonic/gin"
+)
+
+// importStore 记录导入、发布和审核后公开的消息
+type importStore struct {
+	fakeStore
+	mu       sync.Mutex
+	imported []string
+	hidden   map[string]bool
+	topics   []string
+}
+
+func (s *importStore) ImportConversation(ctx context.Context, userID string, c *db.ImportedConversation, dryRun bool) (*db.ImportResult, error) {
+	r := &db.ImportResult{ConversationID: "conv-" + c.UpstreamID, UpstreamID: c.UpstreamID, Title: c.Title, Status: db.ImportCreated, Added: len(c.Messages)}
+	if dryRun {
+		return r, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.imported = append(s.imported, c.UpstreamID)
+	for _, m := range c.Messages {
+		r.MessageIDs = append(r.MessageIDs, m.ID)
+		s.hidden[m.ID] = c.Hidden
+	}
+	return r, nil
+}
+
+func (s *importStore) SetMessageHidden(ctx context.Context, id string, hidden bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hidden[id] = hidden
+	return nil
+}
+
+func (s *importStore) SaveModeration(ctx context.Context, stage, content, decision, reason, provider, messageID, conversationID, userID string) (*ent.Moderation, error) {
+	return &ent.Moderation{}, nil
+}
+
+func (s *importStore) SaveTopic(ctx context.Context, conversationID, title, category string, visibility topic.Visibility, userID string) (*ent.Topic, error) {
+	s.topics = append(s.topics, conversationID)
+	return &ent.Topic{ID: "topic-" + conversationID, ConversationID: conversationID, Title: title}, nil
+}
+
+const testImport = `[{
+	"title": "hello",
+	"conversation_id": "c1",
+	"current_node": "a1",
+	"mapping": {
+		"q1": {"id": "q1", "message": {"id": "q1", "author": {"role": "user"}, "content": {"content_type": "text", "parts": ["hello"]}}, "children": ["a1"]},
+		"a1": {"id": "a1", "parent": "q1", "message": {"id": "a1", "author": {"role": "assistant"}, "content": {"content_type": "text", "parts": ["buy spam"]}}}
+	}
+}]`
+
+func TestPostConversationImport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	blocklist, err := moderation.NewBlocklist([]string{"spam"}, nil, moderation.Hold)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetModerator(moderation.NewPipeline(blocklist))
+	t.Cleanup(func() { SetModerator(moderation.NewPipeline()) })
+
+	alice := &ent.User{ID: "alice"}
+	store := &importStore{
+		fakeStore: fakeStore{keys: map[string]*ent.APIKey{
+			auth.HashAPIKey("cgc_import"): {Scopes: []string{string(PermConversation)}, Edges: ent.APIKeyEdges{User: alice}},
+		}},
+		hidden: map[string]bool{},
+	}
+	cookie := sessionCookie(t, &store.fakeStore, alice)
+	api := New(store)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.POST("/api/v1/conversations/import", api.Require(PermConversation), api.PostConversationImport)
+
+	post := func(query, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/conversations/import"+query, strings.NewReader(testImport))
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set("Authorization", "Bearer "+key)
+		} else {
+			req.AddCookie(cookie)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		importModeration.Wait()
+		return w
+	}
+
+	// 只预览导入的结果，不保存、不发布也不审核
+	w := post("?dry_run=true&publish=all", "")
+	var results []*ImportResult
+	if err = json.Unmarshal(w.Body.Bytes(), &results); w.Code != http.StatusOK || err != nil || len(results) != 1 || results[0].Added != 2 {
+		t.Fatalf("dry run = %d %s", w.Code, w.Body.String())
+	}
+	if len(store.imported) != 0 || len(store.topics) != 0 || len(store.audits) != 0 {
+		t.Errorf("dry run saved %v, published %v, audited %v", store.imported, store.topics, store.audits)
+	}
+
+	// 没有 topic.write 权限的 key 不能在导入时发布
+	if w = post("?publish=c1", "cgc_import"); w.Code != http.StatusForbidden {
+		t.Errorf("publish with a key without topic.write = %d %s", w.Code, w.Body.String())
+	}
+	if len(store.imported) != 0 {
+		t.Errorf("rejected import saved %v", store.imported)
+	}
+
+	// 导入的消息先隐藏，后台审核允许的消息改为公开显示，被标记的消息等待人工审核
+	w = post("?publish=c1", "")
+	results = nil
+	if err = json.Unmarshal(w.Body.Bytes(), &results); w.Code != http.StatusOK || err != nil || len(results) != 1 || results[0].TopicID != "topic-conv-c1" {
+		t.Fatalf("import = %d %s", w.Code, w.Body.String())
+	}
+	if len(store.imported) != 1 || len(store.topics) != 1 {
+		t.Errorf("imported %v, published %v", store.imported, store.topics)
+	}
+	if store.hidden["q1"] || !store.hidden["a1"] {
+		t.Errorf("hidden after moderation = %v", store.hidden)
+	}
+	if len(store.audits) != 1 || store.audits[0] != "conversation.import" {
+		t.Errorf("audits = %v", store.audits)
+	}
+}
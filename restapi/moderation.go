@@ -0,0 +1,122 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"community.threetenth.chatgpt/ent"
+	entmoderation "community.threetenth.chatgpt/ent/moderation"
+	"community.threetenth.chatgpt/moderation"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// moderationPageSize 是审核队列每页的记录数量
+const moderationPageSize = 50
+
+var moderator moderation.Moderator = moderation.NewPipeline()
+
+// SetModerator 设置提问和回复使用的审核者
+func SetModerator(m moderation.Moderator) {
+	moderator = m
+}
+
+// moderate 审核一段内容，并记录审核结果
+//
+// 拥有 PermSkipReview 权限的用户，等待审核的结果会直接允许。
+// 记录失败不会影响审核结果，只会输出日志。
+func (api *API) moderate(c *gin.Context, stage moderation.Stage, content, messageID, conversationID, userID string) *moderation.Result {
+	return api.moderateContent(c.Request.Context(), stage, content, messageID, conversationID, userID, currentRole(c).Can(PermSkipReview))
+}
+
+// moderateContent 审核一段内容，并记录审核结果，skipReview 为 true 时等待审核的结果会直接允许
+func (api *API) moderateContent(ctx context.Context, stage moderation.Stage, content, messageID, conversationID, userID string, skipReview bool) *moderation.Result {
+	result, err := moderator.Moderate(ctx, stage, content)
+	if err != nil {
+		result = &moderation.Result{Decision: moderation.Hold, Reason: err.Error()}
+	}
+	if result.Decision == moderation.Hold && skipReview {
+		// 受信任的用户不需要等待人工审核，但仍然记录被标记的原因
+		result = &moderation.Result{Decision: moderation.Allow, Reason: result.Reason, Provider: result.Provider}
+	}
+
+	_, err = api.store.SaveModeration(ctx,
+		string(stage),
+		content,
+		string(result.Decision),
+		result.Reason,
+		result.Provider,
+		messageID,
+		conversationID,
+		userID,
+	)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.moderate",
+			"event":  "db.SaveModeration",
+		}).Info(err.Error())
+	}
+
+	return result
+}
+
+// GetModerations 获取审核记录，默认返回等待审核的队列
+func (api *API) GetModerations(c *gin.Context) {
+	review := entmoderation.Review(c.DefaultQuery("review", string(entmoderation.ReviewPending)))
+	if err := entmoderation.ReviewValidator(review); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	moderations, err := api.store.ListModerations(c.Request.Context(), review, (page-1)*moderationPageSize, moderationPageSize)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, moderations)
+}
+
+// PostModerationReview 管理员通过或拒绝一条等待审核的记录
+func (api *API) PostModerationReview(c *gin.Context) {
+	reviewerID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid id"))
+		return
+	}
+
+	var body struct {
+		Action string `json:"action" binding:"required,oneof=approve reject"`
+	}
+	if err = c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+
+	m, err := api.store.ReviewModeration(c.Request.Context(), id, body.Action == "approve", reviewerID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, newError(http.StatusNotFound, CodeNotFound, "pending moderation not found"))
+			return
+		}
+		log.WithFields(log.Fields{
+			"method": "restapi.PostModerationReview",
+			"event":  "db.ReviewModeration",
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+
+	api.audit(c, reviewerID, "moderation."+body.Action, "moderation", c.Param("id"), nil)
+	c.JSON(http.StatusOK, m)
+}
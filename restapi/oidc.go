@@ -0,0 +1,183 @@
+package restapi
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/ent"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// oidcCookie 保存 OpenID Connect 登录过程中的 state、nonce 和 PKCE verifier
+const oidcCookie = "community_oidc"
+
+// oidcLoginMaxAge 是在身份提供方登录的最长时间
+const oidcLoginMaxAge = 10 * time.Minute
+
+// requireOIDC 检查是否配置了 OpenID Connect，没有配置时返回 404
+func requireOIDC(c *gin.Context) bool {
+	if accountConfig == nil || accountConfig.OIDC == nil {
+		fail(c, newError(http.StatusNotFound, CodeDisabled, "OpenID Connect login is disabled"))
+		return false
+	}
+	return true
+}
+
+// GetOIDCLogin 跳转到身份提供方登录
+func (api *API) GetOIDCLogin(c *gin.Context) {
+	if !requireOIDC(c) {
+		return
+	}
+
+	values := make([]string, 3)
+	for i := range values {
+		v, err := auth.RandomString()
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		values[i] = v
+	}
+	state, nonce, verifier := values[0], values[1], values[2]
+
+	authURL, err := accountConfig.OIDC.AuthCodeURL(c.Request.Context(), state, nonce, verifier)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.GetOIDCLogin",
+			"event":  "oidc.AuthCodeURL",
+		}).Info(err.Error())
+		fail(c, newError(http.StatusBadGateway, CodeUpstream, "identity provider is unavailable").withCause(err))
+		return
+	}
+
+	token := accountConfig.Signer.Sign(auth.PurposeOIDC, strings.Join(values, "."), "", time.Now().Add(oidcLoginMaxAge))
+	setCookie(c, oidcCookie, token, int(oidcLoginMaxAge/time.Second))
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// GetOIDCCallback 处理身份提供方登录后的回调，登录成功后设置会话 cookie 并跳转到首页
+//
+// 用户按 issuer#sub 匹配；已经登录时关联到当前用户。第一次登录时，如果身份提供方验证过的邮箱
+// 已经注册并且验证过，则关联到该用户；邮箱没有验证或者已经关联其他身份时返回 409，需要先登录再关联。
+// 邮箱没有注册时创建新用户。
+func (api *API) GetOIDCCallback(c *gin.Context) {
+	if !requireOIDC(c) {
+		return
+	}
+	if e := c.Query("error"); e != "" {
+		fail(c, newError(http.StatusUnauthorized, CodeUnauthorized, e+": "+c.Query("error_description")))
+		return
+	}
+
+	cookie, err := c.Cookie(oidcCookie)
+	if err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "login session is missing, please try again"))
+		return
+	}
+	setCookie(c, oidcCookie, "", -1)
+	subject, err := accountConfig.Signer.Verify(cookie, auth.PurposeOIDC, "")
+	if err != nil {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "login session is expired, please try again").withCause(err))
+		return
+	}
+	values := strings.Split(subject, ".")
+	if len(values) != 3 || values[0] != c.Query("state") {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "state mismatch"))
+		return
+	}
+
+	identity, err := accountConfig.OIDC.Exchange(c.Request.Context(), c.Query("code"), values[1], values[2])
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.GetOIDCCallback",
+			"event":  "oidc.Exchange",
+		}).Info(err.Error())
+		api.audit(c, "", "account.login_failed", "user", "", map[string]interface{}{"method": "oidc", "error": err.Error()})
+		fail(c, newError(http.StatusUnauthorized, CodeUnauthorized, "identity provider login failed").withCause(err))
+		return
+	}
+
+	u, ok := api.oidcUser(c, identity)
+	if !ok {
+		return
+	}
+	if u.BannedAt != nil {
+		fail(c, newError(http.StatusForbidden, CodeBanned, "banned: "+u.BanReason))
+		return
+	}
+
+	startSession(c, u)
+	api.audit(c, u.ID, "account.login", "user", u.ID, map[string]interface{}{"method": "oidc", "issuer": identity.Issuer})
+	c.Redirect(http.StatusFound, "/")
+}
+
+// errOIDCLinkRequired 是邮箱已经注册，但不能自动关联身份提供方的用户
+var errOIDCLinkRequired = newError(http.StatusConflict, CodeConflict,
+	"email is already registered, sign in with your password first and then sign in with the identity provider to link it")
+
+// oidcUser 获取或创建身份提供方认证的用户
+//
+// 已经登录时关联到当前用户。第一次登录时，只有邮箱已经验证、还没有关联其他身份的本地账号才会自动关联，
+// 否则攻击者可以预先用他人的邮箱注册未验证的账号，等对方用身份提供方登录后获得该账号。
+func (api *API) oidcUser(c *gin.Context, identity *auth.Identity) (*ent.User, bool) {
+	ctx := c.Request.Context()
+	id := ""
+	u, err := api.store.GetUserByOIDCID(ctx, identity.ID())
+	if err == nil {
+		id = u.ID
+	} else if !ent.IsNotFound(err) {
+		fail(c, err)
+		return nil, false
+	} else {
+		email, ok := normalizeEmail(identity.Email)
+		if !ok {
+			fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "email claim is required"))
+			return nil, false
+		}
+		identity.Email = email
+
+		current, err := api.sessionUser(c)
+		if err != nil {
+			fail(c, err)
+			return nil, false
+		}
+		if current != nil {
+			if current.OidcID != nil {
+				fail(c, newError(http.StatusConflict, CodeConflict, "account is already linked to another identity"))
+				return nil, false
+			}
+			id = current.ID
+		} else if identity.EmailVerified {
+			u, err = api.store.GetUserByEmail(ctx, email)
+			if err == nil {
+				if u.EmailVerifiedAt == nil || u.OidcID != nil {
+					fail(c, errOIDCLinkRequired)
+					return nil, false
+				}
+				id = u.ID
+			} else if !ent.IsNotFound(err) {
+				fail(c, err)
+				return nil, false
+			}
+		}
+	}
+
+	u, err = api.store.SaveOIDCUser(c.Request.Context(), id, identity.ID(), identity.Name, identity.Email, identity.Image, identity.Groups)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.oidcUser",
+			"event":  "db.SaveOIDCUser",
+		}).Info(err.Error())
+		if ent.IsConstraintError(err) {
+			fail(c, errOIDCLinkRequired)
+		} else {
+			fail(c, err)
+		}
+		return nil, false
+	}
+	return u, true
+}
@@ -0,0 +1,97 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/ent"
+	"github.com/gin-gonic/gin"
+)
+
+// oidcStore 按邮箱和 OIDC ID 查找用户，记录关联或创建的用户
+type oidcStore struct {
+	fakeStore
+	// saved 是 SaveOIDCUser 的用户 ID，新建的用户为空
+	saved []string
+}
+
+func (s *oidcStore) GetUserByOIDCID(ctx context.Context, oidcID string) (*ent.User, error) {
+	for _, u := range s.users {
+		if u.OidcID != nil && *u.OidcID == oidcID {
+			return u, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (s *oidcStore) GetUserByEmail(ctx context.Context, email string) (*ent.User, error) {
+	for _, u := range s.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (s *oidcStore) SaveOIDCUser(ctx context.Context, id, oidcID, name, email, image string, groups []string) (*ent.User, error) {
+	s.saved = append(s.saved, id)
+	if id == "" {
+		id = "new"
+	}
+	return &ent.User{ID: id, Email: email, OidcID: &oidcID}, nil
+}
+
+func TestOIDCUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	verified := time.Now()
+	linked := "https://idp.example.com#someone-else"
+	store := &oidcStore{}
+	verifiedUser := &ent.User{ID: "verified", Email: "verified@example.com", EmailVerifiedAt: &verified}
+	cookie := sessionCookie(t, &store.fakeStore, verifiedUser)
+	store.users["unverified"] = &ent.User{ID: "unverified", Email: "victim@example.com"}
+	store.users["linked"] = &ent.User{ID: "linked", Email: "linked@example.com", EmailVerifiedAt: &verified, OidcID: &linked}
+	api := New(store)
+
+	for _, tc := range []struct {
+		name          string
+		email         string
+		emailVerified bool
+		session       bool
+		status        int
+		linkedTo      string
+	}{
+		{"verified account", "verified@example.com", true, false, 0, "verified"},
+		// 攻击者用他人的邮箱注册、没有验证的账号不会自动关联
+		{"unverified account", "victim@example.com", true, false, http.StatusConflict, ""},
+		// 已经关联其他身份的账号不会被覆盖
+		{"linked account", "linked@example.com", true, false, http.StatusConflict, ""},
+		{"email not verified by the provider", "verified@example.com", false, false, 0, ""},
+		{"new user", "new@example.com", true, false, 0, ""},
+		// 登录后关联到当前用户，与邮箱无关
+		{"logged in", "other@example.com", true, true, 0, "verified"},
+	} {
+		store.saved = nil
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/account/oidc/callback", nil)
+		if tc.session {
+			c.Request.AddCookie(cookie)
+		}
+		identity := &auth.Identity{Issuer: "https://idp.example.com", Subject: "sub", Email: tc.email, EmailVerified: tc.emailVerified}
+		_, ok := api.oidcUser(c, identity)
+
+		if tc.status != 0 {
+			if ok || len(c.Errors) == 0 || toError(c.Errors.Last().Err).Status != tc.status || len(store.saved) != 0 {
+				t.Errorf("%s: oidcUser() = %v, errors %v, saved %v, want %d", tc.name, ok, c.Errors, store.saved, tc.status)
+			}
+			continue
+		}
+		if !ok || len(store.saved) != 1 || store.saved[0] != tc.linkedTo {
+			t.Errorf("%s: oidcUser() = %v, errors %v, saved %v, want linked to %q", tc.name, ok, c.Errors, store.saved, tc.linkedTo)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package restapi
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OpenAPI 是 /api/v1 接口的 OpenAPI 3 文档
+//
+// 修改接口时需要同时修改 openapi.json，main 包的测试会检查路由和文档是否一致。
+//
+//go:embed openapi.json
+var OpenAPI []byte
+
+// GetOpenAPI 返回接口的 OpenAPI 文档
+func (api *API) GetOpenAPI(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", OpenAPI)
+}
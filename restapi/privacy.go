@@ -0,0 +1,167 @@
+package restapi
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"community.threetenth.chatgpt/auth"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DeletionPolicy 是注销账号时处理用户内容的策略
+type DeletionPolicy string
+
+const (
+	// DeletionAnonymize 删除没有发布的会话，发布的主题保留，作者显示为已注销
+	DeletionAnonymize DeletionPolicy = "anonymize"
+	// DeletionPurge 删除用户的全部内容和用户本身
+	DeletionPurge DeletionPolicy = "purge"
+)
+
+// DefaultExportMaxAge 是导出的归档默认可以下载的时间
+const DefaultExportMaxAge = 7 * 24 * time.Hour
+
+// deleteAccountMaxAge 是注销账号确认令牌的有效期
+const deleteAccountMaxAge = 15 * time.Minute
+
+// PrivacyConfig 是导出用户数据和注销账号的配置
+type PrivacyConfig struct {
+	// ExportDir 是保存导出归档的目录，只有当前用户可以访问，为空时不能导出
+	ExportDir string
+	// ExportMaxAge 是归档可以下载的时间，过期后删除
+	ExportMaxAge time.Duration
+	// DeletionPolicy 是注销账号时处理用户内容的策略
+	DeletionPolicy DeletionPolicy
+}
+
+var privacyConfig = &PrivacyConfig{
+	ExportMaxAge:   DefaultExportMaxAge,
+	DeletionPolicy: DeletionAnonymize,
+}
+
+// SetPrivacyConfig 设置导出用户数据和注销账号的配置，未设置的值使用默认值
+func SetPrivacyConfig(config *PrivacyConfig) {
+	if config.ExportMaxAge <= 0 {
+		config.ExportMaxAge = DefaultExportMaxAge
+	}
+	if config.DeletionPolicy == "" {
+		config.DeletionPolicy = DeletionAnonymize
+	}
+	privacyConfig = config
+}
+
+// ExportPath 返回导出任务的归档文件路径
+func ExportPath(id string) string {
+	return filepath.Join(privacyConfig.ExportDir, id+".zip")
+}
+
+// removeExports 删除导出任务的归档文件，文件不存在时忽略，失败只输出日志
+func removeExports(ids []string) {
+	for _, id := range ids {
+		if err := os.Remove(ExportPath(id)); err != nil && !os.IsNotExist(err) {
+			log.WithFields(log.Fields{
+				"method": "restapi.removeExports",
+				"event":  "os.Remove",
+			}).Info(err.Error())
+		}
+	}
+}
+
+// requireSessionLogin 检查当前用户是否通过会话 cookie 登录，使用 API key 认证时返回 403
+func requireSessionLogin(c *gin.Context) bool {
+	if _, apiKey := c.Get(contextScopes); apiKey {
+		fail(c, newError(http.StatusForbidden, CodeForbidden, "account deletion requires a session login"))
+		return false
+	}
+	return true
+}
+
+// PostAccountDeletion 申请注销当前账号，返回确认令牌和注销后内容的处理策略
+//
+// 令牌在有效期内提交到 DELETE /api/v1/account 才会注销，客户端应当在提交前向用户确认。
+// 使用 API key 认证时不能申请注销。
+func (api *API) PostAccountDeletion(c *gin.Context) {
+	if !requireAccount(c) {
+		return
+	}
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+	if !requireSessionLogin(c) {
+		return
+	}
+	u, err := api.store.GetUser(c.Request.Context(), userID)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+
+	expires := time.Now().Add(deleteAccountMaxAge)
+	c.JSON(http.StatusOK, gin.H{
+		"token":   accountConfig.Signer.Sign(auth.PurposeDeleteAccount, u.ID, sessionStamp(u), expires),
+		"policy":  privacyConfig.DeletionPolicy,
+		"expires": expires,
+	})
+}
+
+// DeleteAccount 使用确认令牌注销当前账号，并删除会话 cookie
+//
+// 根据配置的策略匿名化或者删除用户的内容，凭据、API key、分享和导出的归档总是删除。
+// 和申请注销一样，使用 API key 认证时不能注销。
+func (api *API) DeleteAccount(c *gin.Context) {
+	if !requireAccount(c) {
+		return
+	}
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+	if !requireSessionLogin(c) {
+		return
+	}
+
+	var body struct {
+		Token string `json:"token" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+
+	u, err := api.store.GetUser(c.Request.Context(), userID)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	subject, err := accountConfig.Signer.Verify(body.Token, auth.PurposeDeleteAccount, sessionStamp(u))
+	if err != nil || subject != u.ID {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "invalid or expired token"))
+		return
+	}
+
+	purge := privacyConfig.DeletionPolicy == DeletionPurge
+	exports, err := api.store.DeleteAccount(c.Request.Context(), u.ID, purge)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.DeleteAccount",
+			"event":  "db.DeleteAccount",
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+	removeExports(exports)
+
+	// 彻底删除的用户不存在，审计记录没有操作者
+	actorID := u.ID
+	if purge {
+		actorID = ""
+	}
+	api.audit(c, actorID, "account.delete", "user", u.ID, map[string]interface{}{"policy": privacyConfig.DeletionPolicy})
+	setSessionCookie(c, "", -1)
+	c.String(http.StatusOK, "OK")
+}
@@ -0,0 +1,219 @@
+package restapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/ent"
+	"github.com/gin-gonic/gin"
+)
+
+// Role 是论坛用户的角色，高级别的角色拥有低级别角色的所有权限
+type Role int
+
+const (
+	// RoleGuest 是未登录的访客
+	RoleGuest Role = iota
+	// RoleMember 是已登录的普通用户
+	RoleMember
+	// RoleTrusted 是受信任的用户，提问和回复不需要等待人工审核
+	RoleTrusted
+	// RoleModerator 是版主，可以处理举报和审核队列，隐藏、删除内容和锁定主题
+	RoleModerator
+	// RoleAdmin 是管理员，可以封禁用户和授予角色
+	RoleAdmin
+)
+
+var roleNames = []string{"guest", "member", "trusted", "moderator", "admin"}
+
+func (r Role) String() string {
+	if r < RoleGuest || r > RoleAdmin {
+		return "unknown"
+	}
+	return roleNames[r]
+}
+
+// ParseRole 解析角色名称
+func ParseRole(name string) (Role, bool) {
+	for i, n := range roleNames {
+		if n == name {
+			return Role(i), true
+		}
+	}
+	return RoleGuest, false
+}
+
+// Permission 是接口需要的权限
+type Permission string
+
+const (
+	// PermRead 读取公开的会话、消息和主题
+	PermRead Permission = "read"
+	// PermConversation 向 ChatGPT 提问
+	PermConversation Permission = "conversation.post"
+	// PermTopic 发布和修改自己的主题
+	PermTopic Permission = "topic.write"
+	// PermShare 创建和撤销自己的分享
+	PermShare Permission = "share.write"
+	// PermReport 举报消息和主题
+	PermReport Permission = "report.create"
+	// PermAPIKey 创建、轮换和撤销自己的个人 API key
+	PermAPIKey Permission = "apikey.manage"
+	// PermAccount 导出自己的数据和注销自己的账号
+	PermAccount Permission = "account.manage"
+	// PermSkipReview 内容被标记为等待审核时直接发布
+	PermSkipReview Permission = "moderation.skip_review"
+	// PermModerate 处理举报和审核队列，隐藏、删除内容和锁定主题
+	PermModerate Permission = "moderation.manage"
+	// PermBanUser 封禁和解封用户
+	PermBanUser Permission = "user.ban"
+	// PermGrantRole 授予用户角色
+	PermGrantRole Permission = "role.grant"
+	// PermAudit 查询审计记录
+	PermAudit Permission = "audit.read"
+	// PermBulkExport 批量导出一个分类中所有公开的主题
+	PermBulkExport Permission = "export.bulk"
+	// PermReadPrivate 读取其他用户未公开的会话和消息，每次读取都会记录审计
+	PermReadPrivate Permission = "conversation.read_private"
+)
+
+// permissionRoles 是每个权限需要的最低角色
+var permissionRoles = map[Permission]Role{
+	PermRead:         RoleGuest,
+	PermConversation: RoleMember,
+	PermTopic:        RoleMember,
+	PermShare:        RoleMember,
+	PermReport:       RoleMember,
+	PermAPIKey:       RoleMember,
+	PermAccount:      RoleMember,
+	PermSkipReview:   RoleTrusted,
+	PermModerate:     RoleModerator,
+	PermBanUser:      RoleAdmin,
+	PermGrantRole:    RoleAdmin,
+	PermAudit:        RoleAdmin,
+	PermBulkExport:   RoleAdmin,
+	PermReadPrivate:  RoleAdmin,
+}
+
+// Can 判断角色是否拥有指定的权限，未知的权限只有管理员拥有
+func (r Role) Can(p Permission) bool {
+	required, ok := permissionRoles[p]
+	if !ok {
+		required = RoleAdmin
+	}
+	return r >= required
+}
+
+const (
+	contextUserID = "restapi.userID"
+	contextRole   = "restapi.role"
+	// contextScopes 是使用 API key 认证时 key 的权限，其他认证方式没有这个值
+	contextScopes = "restapi.scopes"
+)
+
+// groupRoles 是管理员配置的用户组到论坛角色的映射
+var groupRoles map[string]Role
+
+// SetGroupRoles 设置用户组到论坛角色的映射
+//
+// 用户组是登录时身份提供方的 groups 声明，只有配置在映射中的用户组才会授予角色。
+func SetGroupRoles(roles map[string]Role) {
+	groupRoles = roles
+}
+
+// userRole 获取用户在论坛中的角色
+//
+// 管理员授予的角色优先；没有授予角色时，使用配置的用户组映射中最高的角色，默认为 member。
+// OpenAI 返回的 groups 和 features 不会直接解析为角色，避免身份提供方的同名用户组获得论坛权限。
+func userRole(u *ent.User) Role {
+	if u.Role != nil {
+		if role, ok := ParseRole(u.Role.String()); ok {
+			return role
+		}
+	}
+
+	role := RoleMember
+	for _, group := range u.Groups {
+		if r, ok := groupRoles[group]; ok && r > role {
+			role = r
+		}
+	}
+	return role
+}
+
+// errAuthorizationFailed 是 Authorization header 中的 API key 无效
+var errAuthorizationFailed = errors.New("Authorization failed")
+
+// errAccessTokenUnsupported 是 Authorization header 中不是个人 API key
+//
+// 之前的版本使用 ChatGPT 的 accessToken 认证，现在只接受 cgc_ 开头的个人 API key。
+var errAccessTokenUnsupported = newError(http.StatusUnauthorized, CodeUnauthorized,
+	"ChatGPT access tokens are no longer accepted, sign in and create a personal API key (cgc_...) with POST /api/v1/keys")
+
+// authenticate 认证当前用户，未登录时返回 nil
+//
+// 优先使用 Authorization header 中的个人 API key，其次使用会话 cookie。
+// Authorization header 中不是个人 API key 时返回 401，不再接受 ChatGPT 的 accessToken。
+func (api *API) authenticate(c *gin.Context) (*ent.User, error) {
+	accessToken := c.GetHeader("Authorization")
+	if accessToken == "" {
+		return api.sessionUser(c)
+	}
+	if key := strings.TrimPrefix(accessToken, "Bearer "); auth.IsAPIKey(key) {
+		return api.apiKeyUser(c, key)
+	}
+	return nil, errAccessTokenUnsupported
+}
+
+// Require 返回检查当前用户权限的中间件
+//
+// 通过检查后，当前用户的 ID 和角色会保存在 gin.Context 中。
+// 需要 guest 权限的接口不要求登录，其他接口未登录时返回 401，权限不足时返回 403。
+func (api *API) Require(p Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		u, err := api.authenticate(c)
+		if err != nil {
+			fail(c, err)
+			return
+		}
+
+		role := RoleGuest
+		if u == nil {
+			if !role.Can(p) {
+				fail(c, newError(http.StatusUnauthorized, CodeUnauthorized, "Authorization is required"))
+				return
+			}
+			c.Set(contextRole, role)
+			c.Next()
+			return
+		}
+
+		if u.BannedAt != nil {
+			fail(c, newError(http.StatusForbidden, CodeBanned, "banned: "+u.BanReason))
+			return
+		}
+
+		role = userRole(u)
+		if !role.Can(p) {
+			fail(c, newError(http.StatusForbidden, CodeForbidden, "permission denied: "+string(p)))
+			return
+		}
+		if !scopeAllows(c, p) {
+			fail(c, newError(http.StatusForbidden, CodeForbidden, "api key scope denied: "+string(p)))
+			return
+		}
+		c.Set(contextUserID, u.ID)
+		c.Set(contextRole, role)
+		c.Next()
+	}
+}
+
+// currentRole 获取当前用户的角色，没有经过 Require 中间件时为 guest
+func currentRole(c *gin.Context) Role {
+	if v, ok := c.Get(contextRole); ok {
+		return v.(Role)
+	}
+	return RoleGuest
+}
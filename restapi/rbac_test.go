@@ -0,0 +1,30 @@
+package restapi
+
+import (
+	"testing"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/user"
+)
+
+func TestUserRole(t *testing.T) {
+	SetGroupRoles(map[string]Role{"forum-moderators": RoleModerator})
+	defer SetGroupRoles(nil)
+
+	admin := user.RoleAdmin
+	for _, tc := range []struct {
+		name string
+		u    *ent.User
+		want Role
+	}{
+		{"default", &ent.User{}, RoleMember},
+		{"granted", &ent.User{Role: &admin, Groups: []string{"forum-moderators"}}, RoleAdmin},
+		{"mapped group", &ent.User{Groups: []string{"staff", "forum-moderators"}}, RoleModerator},
+		// 身份提供方或 OpenAI 返回的同名用户组和 features 不授予角色
+		{"unmapped group", &ent.User{Groups: []string{"admin"}, Features: []string{"moderator"}}, RoleMember},
+	} {
+		if got := userRole(tc.u); got != tc.want {
+			t.Errorf("%s: userRole() = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package restapi
+
+import (
+	"net/http"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/report"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// PostReport 举报一条消息或一个主题
+//
+// target_type 为 message 或 topic，reason 为 spam、abuse、harassment、sexual、
+// violence、misinformation、privacy 或 other。
+func (api *API) PostReport(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		TargetType string `json:"target_type" binding:"required"`
+		TargetID   string `json:"target_id" binding:"required"`
+		Reason     string `json:"reason" binding:"required"`
+		Detail     string `json:"detail"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+
+	targetType := report.TargetType(body.TargetType)
+	if err := report.TargetTypeValidator(targetType); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+	reason := report.Reason(body.Reason)
+	if err := report.ReasonValidator(reason); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+
+	var err error
+	switch targetType {
+	case report.TargetTypeMessage:
+		_, err = api.store.GetMessage(c.Request.Context(), body.TargetID)
+	case report.TargetTypeTopic:
+		_, err = api.store.GetTopic(c.Request.Context(), body.TargetID)
+	}
+	if err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, newError(http.StatusNotFound, CodeNotFound, body.TargetType+" not found"))
+		} else {
+			fail(c, err)
+		}
+		return
+	}
+
+	r, err := api.store.SaveReport(c.Request.Context(), targetType, body.TargetID, reason, body.Detail, userID)
+	if err != nil {
+		if ent.IsValidationError(err) {
+			fail(c, invalidRequest(err))
+			return
+		}
+		log.WithFields(log.Fields{
+			"method": "restapi.PostReport",
+			"event":  "db.SaveReport",
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, r)
+}
@@ -1,15 +1,21 @@
 package restapi
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"net/http"
+	"time"
 
 	"community.threetenth.chatgpt/db"
 	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/credential"
+	entmessage "community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/moderation"
 	"community.threetenth.chatgpt/openai"
+	"community.threetenth.chatgpt/render"
 	"github.com/gin-contrib/sse"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -19,73 +25,227 @@ const (
 	ContentTypeEventStream = "text/event-stream"
 )
 
-var userTokenMap map[string]string
+// getUserID 获取当前用户的 ID
+//
+// 经过 Require 中间件时直接使用中间件认证的用户，否则通过 Authorization header 或会话 cookie 认证。
+// 如果认证失败，会直接返回 401 错误，调用方只需要判断 ok。
+func (api *API) getUserID(c *gin.Context) (userID string, ok bool) {
+	if v, exists := c.Get(contextUserID); exists {
+		return v.(string), true
+	}
+
+	u, err := api.authenticate(c)
+	if err != nil {
+		fail(c, err)
+		return "", false
+	}
+	if u == nil {
+		fail(c, newError(http.StatusUnauthorized, CodeUnauthorized, "Authorization is required"))
+		return "", false
+	}
+	return u.ID, true
+}
+
+// chatGPTAccessToken 获取调用 ChatGPT 使用的加密 accessToken
+//
+// 使用用户最近一次更新 ChatGPT 会话时保存的凭据，没有保存时返回 412。
+func (api *API) chatGPTAccessToken(c *gin.Context, userID string) (openai.Credential, bool) {
+	accessToken, err := api.upstreamAccessToken(c.Request.Context(), userID)
+	if err == nil {
+		return accessToken, true
+	}
+	if ent.IsNotFound(err) {
+		fail(c, newError(http.StatusPreconditionFailed, CodePreconditionFailed, "ChatGPT session is not linked, please update the ChatGPT session first"))
+	} else {
+		log.WithFields(log.Fields{
+			"method": "restapi.chatGPTAccessToken",
+			"event":  "upstreamAccessToken",
+		}).Info(err.Error())
+		fail(c, err)
+	}
+	return "", false
+}
 
-func init() {
-	userTokenMap = make(map[string]string)
+// messageView 是返回给客户端的消息，附带服务端预渲染的 content_html
+type messageView struct {
+	*ent.Message
+	ContentHTML string `json:"content_html"`
+}
+
+// newMessageView 渲染消息的 Markdown 内容，渲染失败时 content_html 为空
+//
+// 被删除的消息只返回占位，不返回内容，客户端根据 deleted_at 显示。
+func newMessageView(message *ent.Message) *messageView {
+	if message.DeletedAt != nil {
+		tombstone := *message
+		tombstone.Content = ""
+		tombstone.DeletedBy = ""
+		return &messageView{Message: &tombstone}
+	}
+	html, err := render.Markdown(message.Content)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.newMessageView",
+			"event":  "render.Markdown",
+		}).Info(err.Error())
+	}
+	return &messageView{message, string(html)}
 }
 
 // GetChatGPTConversation 获取一个指定的会话
-func GetChatGPTConversation(c *gin.Context) {
+//
+// 会话的所有者可以读取全部消息，其他用户只能读取 public 或 unlisted 主题中未隐藏的消息，
+// 没有权限时返回 404。
+func (api *API) GetChatGPTConversation(c *gin.Context) {
 	getIDAndOkJSON(c, func(id string) (interface{}, error) {
-		return db.GetConversation(id)
+		userID := api.optionalUserID(c)
+		owner := false
+		if userID != "" {
+			var err error
+			if owner, err = api.store.IsConversationOwner(c.Request.Context(), id, userID); err != nil {
+				return nil, err
+			}
+		}
+		includeHidden, err := api.canReadConversation(c, id, userID, owner)
+		if err != nil {
+			return nil, err
+		}
+
+		messages, err := api.store.GetConversationMessages(c.Request.Context(), id, includeHidden)
+		if err != nil {
+			return nil, err
+		}
+		views := make([]*messageView, len(messages))
+		for i, message := range messages {
+			views[i] = newMessageView(message)
+		}
+		return views, nil
 	})
 }
 
 // GetChatGPTMessage 获取一个指定的消息
-func GetChatGPTMessage(c *gin.Context) {
+//
+// 权限与所在的会话相同，被隐藏的消息只有所有者可以读取，没有权限时返回 404。
+func (api *API) GetChatGPTMessage(c *gin.Context) {
 	getIDAndOkJSON(c, func(id string) (interface{}, error) {
-		return db.GetMessage(id)
+		message, err := api.store.GetMessage(c.Request.Context(), id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				// 不存在和没有权限返回相同的错误，避免枚举 ID
+				return nil, errConversationNotFound
+			}
+			return nil, err
+		}
+
+		userID := api.optionalUserID(c)
+		owner := false
+		if userID != "" {
+			if owner, err = api.store.IsMessageOwner(c.Request.Context(), id, userID); err != nil {
+				return nil, err
+			}
+		}
+		includeHidden, err := api.canReadConversation(c, message.ConversationID, userID, owner)
+		if err != nil {
+			return nil, err
+		}
+		if message.Hidden && !includeHidden {
+			return nil, errConversationNotFound
+		}
+		return newMessageView(message), nil
 	})
 }
 
 // PostChatGPTConversation 提交一个 ChatGPT 会话，并获取回复
 //
 // 支持 text/event-stream 流模式和文本模式
-func PostChatGPTConversation(c *gin.Context) {
+func (api *API) PostChatGPTConversation(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
 	// 获取 accessToken
-	accessToken := c.GetHeader("Authorization")
-	if accessToken == "" {
-		c.String(http.StatusUnauthorized, "Authorization header is required")
+	accessToken, ok := api.chatGPTAccessToken(c, userID)
+	if !ok {
 		return
 	}
 
-	userID, ok := userTokenMap[accessToken]
+	body, ok := api.bindConversationRequest(c, userID)
 	if !ok {
-		c.String(http.StatusUnauthorized, "Authorization failed")
 		return
 	}
 
-	var message *ent.Message
-	var err error
-	if err = c.ShouldBindJSON(message); err != nil {
-		// json 结构解析错误，返回错误
-		c.String(http.StatusBadRequest, err.Error())
+	// 继续会话时使用会话在 ChatGPT 中的 ID
+	upstreamConversationID := ""
+	if body.ConversationID != "" {
+		locked, err := api.store.IsConversationLocked(c.Request.Context(), body.ConversationID)
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		if locked {
+			fail(c, newError(http.StatusForbidden, CodeForbidden, "topic is locked"))
+			return
+		}
+
+		conversation, err := api.store.GetConversation(c.Request.Context(), body.ConversationID)
+		if err != nil {
+			fail(c, err)
+			return
+		}
+		if conversation.UpstreamID == nil {
+			// 第一个提问没有得到回复的会话在 ChatGPT 中不存在
+			fail(c, newError(http.StatusConflict, CodeConflict, "conversation cannot be continued"))
+			return
+		}
+		upstreamConversationID = *conversation.UpstreamID
+	}
+
+	// 提问在发送给 ChatGPT 之前审核
+	messageID := uuid.NewString()
+	promptResult := api.moderate(c, moderation.StagePrompt, body.Prompt, messageID, body.ConversationID, userID)
+	if promptResult.Decision == moderation.Reject {
+		fail(c, newError(http.StatusUnprocessableEntity, CodeModerationRejected, "rejected by moderation: "+promptResult.Reason))
 		return
 	}
 
-	message, err = db.SaveMessage(
-		message.ID,
-		message.Content,
-		message.ContentType,
-		message.Role,
-		message.ConversationID,
-		message.ParentMessageID,
-		userID,
-	)
+	// 等待审核的提问不会发送给 ChatGPT，这一轮不会有回复
+	hold := promptResult.Decision == moderation.Hold
+	status := entmessage.StatusPending
+	if hold {
+		status = entmessage.StatusCancelled
+	}
+	message, err := api.store.StartTurn(c.Request.Context(), &db.Question{
+		ID:              messageID,
+		Prompt:          body.Prompt,
+		ConversationID:  body.ConversationID,
+		ParentMessageID: body.ParentMessageID,
+		Model:           body.Model,
+		UserID:          userID,
+		Status:          status,
+		Hidden:          hold,
+	})
 
 	if err != nil {
 		log.WithFields(log.Fields{
 			"method": "restapi.PostChatGPTConversation",
-			"event":  "db.SaveMessage",
+			"event":  "db.StartTurn",
 		}).Info(err.Error())
-		c.String(http.StatusInternalServerError, err.Error())
+		fail(c, err)
+		return
+	}
+
+	if hold {
+		c.JSON(http.StatusAccepted, gin.H{
+			"message":    newMessageView(message),
+			"moderation": promptResult,
+		})
 		return
 	}
 
 	chatRequestBody := openai.ChatRequestBody{
 		Action:         "next",
-		ConversationID: message.ConversationID,
+		ConversationID: upstreamConversationID,
 		Messages: []*openai.ChatMessage{
 			{
 				ID:   message.ID,
@@ -96,15 +256,15 @@ func PostChatGPTConversation(c *gin.Context) {
 				},
 			},
 		},
-		ParentMessageID: message.ParentMessageID,
-		Model:           "text-davinci-002-render",
+		ParentMessageID: body.ParentMessageID,
+		Model:           body.Model,
 	}
 
 	var chatResponseBody *openai.ChatResponseBody
 
 	accept := c.GetHeader("accept")
 	if accept == ContentTypeEventStream {
-		chatResponseBody, err = getChatGPTConversationStream(c, accessToken, &chatRequestBody)
+		chatResponseBody, err = api.getChatGPTConversationStream(c, message, accessToken, &chatRequestBody)
 	} else {
 		chatResponseBody, err = getChatGPTConversationText(c, accessToken, &chatRequestBody)
 	}
@@ -114,66 +274,138 @@ func PostChatGPTConversation(c *gin.Context) {
 			"method": "restapi.PostChatGPTConversation",
 			"event":  accept,
 		}).Info(err.Error())
-		c.String(http.StatusServiceUnavailable, err.Error())
+		api.finishTurn(messageID, entmessage.StatusFailed)
+		fail(c, err)
+		return
+	}
+	if chatResponseBody == nil {
+		// 客户端在回复完成之前断开了连接
+		api.finishTurn(messageID, entmessage.StatusCancelled)
 		return
 	}
 
-	message, err = db.SaveMessage(
-		chatResponseBody.Message.ID,
-		chatResponseBody.Message.Content.Parts[0],
-		chatResponseBody.Message.Content.ContentType,
-		chatResponseBody.Message.Role,
-		chatResponseBody.ConversationID,
-		message.ID,
-		userID,
-	)
+	// 回复在保存和发布之前审核，流模式下回复已经发送给提问者，只是不会公开
+	answer := chatResponseBody.Message.Content.Parts[0]
+	answerResult := api.moderate(c, moderation.StageAnswer, answer, chatResponseBody.Message.ID, message.ConversationID, userID)
+	if answerResult.Decision == moderation.Reject {
+		api.finishTurn(messageID, entmessage.StatusFailed)
+		if accept == ContentTypeEventStream {
+			writeModerationEvent(c, answerResult)
+		} else {
+			fail(c, newError(http.StatusUnprocessableEntity, CodeModerationRejected, "rejected by moderation: "+answerResult.Reason))
+		}
+		return
+	}
+
+	message, err = api.store.CompleteTurn(c.Request.Context(), message.ID, chatResponseBody.ConversationID, userID, &db.Answer{
+		ID:          chatResponseBody.Message.ID,
+		Content:     answer,
+		ContentType: chatResponseBody.Message.Content.ContentType,
+		Role:        chatResponseBody.Message.Role,
+		Hidden:      answerResult.Decision == moderation.Hold,
+	})
 
 	if err != nil {
 		log.WithFields(log.Fields{
 			"method": "restapi.PostChatGPTConversation",
-			"event":  "db.SaveMessage",
+			"event":  "db.CompleteTurn",
 		}).Info(err.Error())
-		c.String(http.StatusInternalServerError, err.Error())
+		api.finishTurn(messageID, entmessage.StatusFailed)
+		fail(c, err)
 		return
 	}
 
-	if accept != ContentTypeEventStream {
-		c.JSON(http.StatusOK, message)
+	// 等待审核的回复不会公开显示，也不用于生成标题和摘要
+	if answerResult.Decision != moderation.Hold {
+		api.summarize(message.ConversationID, accessToken, body.Model)
+	}
+
+	if accept == ContentTypeEventStream {
+		if answerResult.Decision == moderation.Hold {
+			writeModerationEvent(c, answerResult)
+		}
+	} else if answerResult.Decision == moderation.Hold {
+		c.JSON(http.StatusAccepted, gin.H{
+			"message":    newMessageView(message),
+			"moderation": answerResult,
+		})
+	} else {
+		c.JSON(http.StatusOK, newMessageView(message))
+	}
+}
+
+// finishTurn 结束一轮没有保存回复的提问，记录失败或取消的状态
+//
+// 客户端断开连接后请求的 context 已经取消，这里使用新的 context 保存状态。
+func (api *API) finishTurn(id string, status entmessage.Status) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := api.store.SetTurnStatus(ctx, id, status); err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.finishTurn",
+			"event":  "db.SetTurnStatus",
+		}).Info(err.Error())
+	}
+}
+
+// writeModerationEvent 在流模式下发送一个 moderation 事件，告知客户端回复的审核结果
+func writeModerationEvent(c *gin.Context, result *moderation.Result) {
+	err := sse.Encode(c.Writer, sse.Event{
+		Event: "moderation",
+		Data:  result,
+	})
+	if err == nil {
+		c.Writer.Flush()
 	}
 }
 
-func getChatGPTConversationText(c *gin.Context, accessToken string, chatRequestBody *openai.ChatRequestBody) (*openai.ChatResponseBody, error) {
+func getChatGPTConversationText(c *gin.Context, accessToken openai.Credential, chatRequestBody *openai.ChatRequestBody) (*openai.ChatResponseBody, error) {
 	// 调用 PostChatGPTText 函数，并返回结果
-	return openai.PostChatGPTText(accessToken, chatRequestBody)
+	return openai.PostChatGPTText(c.Request.Context(), accessToken, chatRequestBody)
 }
 
-func getChatGPTConversationStream(c *gin.Context, accessToken string, chatRequestBody *openai.ChatRequestBody) (*openai.ChatResponseBody, error) {
+// getChatGPTConversationStream 以流模式获取回复，开始回复时将提问标记为 streaming
+//
+// 发送给客户端的回复使用论坛中的会话 ID。客户端在回复完成之前断开连接时返回 nil。
+func (api *API) getChatGPTConversationStream(c *gin.Context, question *ent.Message, accessToken openai.Credential, chatRequestBody *openai.ChatRequestBody) (*openai.ChatResponseBody, error) {
 	var err error
 	return openai.PostChatGPTStream(accessToken, chatRequestBody, func() {
+		if err := api.store.SetTurnStatus(c.Request.Context(), question.ID, entmessage.StatusStreaming); err != nil {
+			log.WithFields(log.Fields{
+				"method": "restapi.getChatGPTConversationStream",
+				"event":  "db.SetTurnStatus",
+			}).Info(err.Error())
+		}
+
 		// 回复支持 text/event-stream 格式
 		c.Header("Content-Type", "text/event-stream")
 		c.Header("Cache-Control", "no-cache")
 		c.Header("Connection", "keep-alive")
 		c.Header("Transfer-Encoding", "chunked")
 	}, func(msg *openai.ChatResponseBody) (bool, error) {
+		chunk := *msg
+		chunk.ConversationID = question.ConversationID
 		err = sse.Encode(c.Writer, sse.Event{
-			Data: msg,
+			Data: &chunk,
 		})
 		if err == nil {
 			c.Writer.Flush()
 		}
 
-		return !c.IsAborted(), nil
+		return !c.IsAborted() && c.Request.Context().Err() == nil, nil
 	})
 }
 
 // UpdateChatGPTSession 更新 ChatGPT 用户的身份令牌
-func UpdateChatGPTSession(c *gin.Context) {
+//
+// ChatGPT 的访问令牌和会话令牌加密后保存，不会返回给客户端；
+// 更新成功后设置论坛的会话 cookie，之后的请求使用会话 cookie 认证。
+func (api *API) UpdateChatGPTSession(c *gin.Context) {
 	// 从请求的 header 中获取 sessionToken
 	sessionToken := c.Request.Header.Get("Authorization")
 	if sessionToken == "" {
 		// 如果 sessionToken 为空，返回 HTTP 400 错误
-		c.String(http.StatusUnauthorized, `Authorization is required. Please click "Application" - "Cookies" - "https://chat.openai.com" in the debugging tool of the ChatGPT page after login, and then copy the value of "__Secure-next-auth.session-token" inside , this value is the value of the current API Authorization.`)
+		fail(c, newError(http.StatusUnauthorized, CodeUnauthorized, `Authorization is required. Please click "Application" - "Cookies" - "https://chat.openai.com" in the debugging tool of the ChatGPT page after login, and then copy the value of "__Secure-next-auth.session-token" inside , this value is the value of the current API Authorization.`))
 		return
 	}
 
@@ -185,11 +417,72 @@ func UpdateChatGPTSession(c *gin.Context) {
 			"method": "restapi.UpdateChatGPTSession",
 			"event":  "openai.UpdateChatGPTSession",
 		}).Info(err.Error())
-		c.String(http.StatusInternalServerError, err.Error())
+		fail(c, err)
+		return
+	}
+
+	u, ok := api.chatGPTSessionUser(c, token)
+	if !ok {
+		return
+	}
+	if u.BannedAt != nil {
+		fail(c, newError(http.StatusForbidden, CodeBanned, "banned: "+u.BanReason))
+		return
+	}
+
+	if err = api.saveUpstreamToken(c.Request.Context(), u.ID, token); err != nil {
+		log.WithFields(log.Fields{
+			"method": "restapi.UpdateChatGPTSession",
+			"event":  "saveUpstreamToken",
+		}).Info(err.Error())
+		fail(c, err)
 		return
 	}
+	if accountConfig != nil {
+		startSession(c, u)
+	}
+	api.audit(c, u.ID, "session.refresh", "user", u.ID, map[string]interface{}{"openai_id": token.User.ID})
+
+	c.JSON(http.StatusOK, gin.H{
+		"user":    u,
+		"expires": token.Expires,
+	})
+}
+
+// chatGPTSessionUser 获取 ChatGPT 会话对应的论坛用户
+//
+// 已经登录本地账号时，将 ChatGPT 账号关联到当前账号；
+// 否则使用关联了该 ChatGPT 账号的本地账号，没有关联时保存为 ChatGPT 用户。
+func (api *API) chatGPTSessionUser(c *gin.Context, token *openai.Token) (*ent.User, bool) {
+	u, err := api.sessionUser(c)
+	if err != nil {
+		fail(c, err)
+		return nil, false
+	}
+	if u != nil {
+		return u, api.linkChatGPTUser(c, u, token.User.ID)
+	}
 
-	err = db.SaveUser(
+	u, err = api.store.GetUserByOpenAIID(c.Request.Context(), token.User.ID)
+	if err == nil {
+		return u, true
+	}
+	if !ent.IsNotFound(err) {
+		fail(c, err)
+		return nil, false
+	}
+	// ChatGPT 用户的 ID 就是 ChatGPT 账号的 ID，注销后不能通过再次登录覆盖匿名化的用户
+	u, err = api.store.GetUser(c.Request.Context(), token.User.ID)
+	if err == nil && u.DeletedAt != nil {
+		fail(c, newError(http.StatusForbidden, CodeForbidden, "account is deleted"))
+		return nil, false
+	}
+	if err != nil && !ent.IsNotFound(err) {
+		fail(c, err)
+		return nil, false
+	}
+
+	err = api.store.SaveUser(c.Request.Context(),
 		token.User.ID,
 		token.User.Name,
 		token.User.Email,
@@ -205,56 +498,55 @@ func UpdateChatGPTSession(c *gin.Context) {
 			"event": "db.SaveUser",
 			"data":  string(bs),
 		}).Info(err.Error())
-		c.String(http.StatusInternalServerError, err.Error())
-		return
+		fail(c, err)
+		return nil, false
 	}
 
-	userTokenMap[token.AccessToken] = token.User.ID
-
-	// 将 token 的值作为 HTTP 响应返回给客户端
-	c.JSON(http.StatusOK, &token)
+	u, err = api.store.GetUser(c.Request.Context(), token.User.ID)
+	if err != nil {
+		fail(c, err)
+		return nil, false
+	}
+	return u, true
 }
 
 func getIDAndOkJSON(c *gin.Context, handle func(id string) (interface{}, error)) {
 	id := c.Query("id")
 	if id == "" {
-		c.String(http.StatusUnauthorized, "id can't empty")
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "id is required"))
 		return
 	}
 	data, err := handle(id)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			c.String(http.StatusNotFound, err.Error())
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		fail(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, &data)
 }
 
 // PostCaptcha is 更新 cloudflare 验证码
-func PostCaptcha(c *gin.Context) {
+//
+// cf_clearance 是所有用户共用的上游凭据，只有版主和管理员可以更新。
+func (api *API) PostCaptcha(c *gin.Context) {
 	var captcha struct {
 		CfClearance string `json:"cfClearance"`
 		UserAgent   string `json:"userAgent"`
 	}
 
 	if err := c.ShouldBindJSON(&captcha); err != nil {
-		c.String(http.StatusForbidden, err.Error())
+		fail(c, invalidRequest(err))
 		return
 	}
 
-	err := openai.UpdateCloudflareCaptcha(captcha.CfClearance, captcha.UserAgent)
+	cfClearance, err := openai.UpdateCloudflareCaptcha(captcha.CfClearance, captcha.UserAgent)
+	if err == nil {
+		err = api.store.SaveCredential(c.Request.Context(), "", credential.KindCfClearance, string(cfClearance), cfClearance.KeyID(), captcha.UserAgent, nil)
+	}
 	if err != nil {
-		var e *openai.HTTPStatusError
-		if errors.As(err, &e) {
-			c.String(e.Code, e.Text)
-		} else {
-			c.String(http.StatusInternalServerError, err.Error())
-		}
+		fail(c, err)
 		return
 	}
 
+	api.audit(c, api.optionalUserID(c), "captcha.update", "", "", nil)
 	c.String(http.StatusOK, "OK")
 }
@@ -0,0 +1,253 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"community.threetenth.chatgpt/auth"
+	"community.threetenth.chatgpt/db"
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/user"
+	"github.com/gin-gonic/gin"
+)
+
+// fakeStore 是测试使用的 Store，只实现测试用到的方法
+type fakeStore struct {
+	db.Store
+	messages map[string]*ent.Message
+	// owners 是消息 ID 到用户 ID 的映射
+	owners map[string]string
+	// conversations 是会话 ID 到所有者的用户 ID 的映射
+	conversations map[string]string
+	// readable 是可以通过链接访问的会话
+	readable map[string]bool
+	keys     map[string]*ent.APIKey
+	users    map[string]*ent.User
+	// created 是创建的 API key
+	created []*ent.APIKey
+	// audits 是记录的审计操作
+	audits []string
+}
+
+func (s *fakeStore) GetMessage(ctx context.Context, id string) (*ent.Message, error) {
+	if m, ok := s.messages[id]; ok {
+		return m, nil
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (s *fakeStore) IsMessageOwner(ctx context.Context, id, userID string) (bool, error) {
+	return s.owners[id] == userID, nil
+}
+
+func (s *fakeStore) IsConversationOwner(ctx context.Context, conversationID, userID string) (bool, error) {
+	owner, ok := s.conversations[conversationID]
+	return ok && owner == userID, nil
+}
+
+func (s *fakeStore) GetConversationMessages(ctx context.Context, id string, includeHidden bool) ([]*ent.Message, error) {
+	var messages []*ent.Message
+	for _, m := range s.messages {
+		if m.ConversationID == id && (includeHidden || !m.Hidden) {
+			messages = append(messages, m)
+		}
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		if !messages[i].CreatedAt.Equal(messages[j].CreatedAt) {
+			return messages[i].CreatedAt.Before(messages[j].CreatedAt)
+		}
+		return messages[i].ID < messages[j].ID
+	})
+	return messages, nil
+}
+
+func (s *fakeStore) IsConversationReadable(ctx context.Context, conversationID string) (bool, error) {
+	return s.readable[conversationID], nil
+}
+
+func (s *fakeStore) GetAPIKeyByHash(ctx context.Context, hash string) (*ent.APIKey, error) {
+	if k, ok := s.keys[hash]; ok {
+		return k, nil
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (s *fakeStore) TouchAPIKey(ctx context.Context, k *ent.APIKey) error {
+	return nil
+}
+
+func (s *fakeStore) GetUser(ctx context.Context, id string) (*ent.User, error) {
+	if u, ok := s.users[id]; ok {
+		return u, nil
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+// sessionCookie 配置本地账号并返回用户的会话 cookie
+func sessionCookie(t *testing.T, store *fakeStore, u *ent.User) *http.Cookie {
+	t.Helper()
+	signer, err := auth.NewSigner([]byte("test secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetAccountConfig(&AccountConfig{Signer: signer, SessionMaxAge: time.Hour})
+	t.Cleanup(func() { SetAccountConfig(nil) })
+	if store.users == nil {
+		store.users = make(map[string]*ent.User)
+	}
+	store.users[u.ID] = u
+	token := signer.Sign(auth.PurposeSession, u.ID, sessionStamp(u), time.Now().Add(time.Hour))
+	return &http.Cookie{Name: SessionCookie, Value: token}
+}
+
+func (s *fakeStore) CreateAPIKey(ctx context.Context, userID, name, prefix, hash string, scopes []string, expiresAt *time.Time) (*ent.APIKey, error) {
+	k := &ent.APIKey{ID: len(s.created) + 1, Name: name, Prefix: prefix, Scopes: scopes, ExpiresAt: expiresAt}
+	s.created = append(s.created, k)
+	return k, nil
+}
+
+func (s *fakeStore) SaveAuditEvent(ctx context.Context, actorID, action, targetType, targetID, ip, userAgent string, payload map[string]interface{}) (*ent.AuditEvent, error) {
+	s.audits = append(s.audits, action)
+	return &ent.AuditEvent{}, nil
+}
+
+func TestGetChatGPTMessage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	admin := user.RoleAdmin
+	root := &ent.User{ID: "root", Role: &admin}
+	store := &fakeStore{
+		messages: map[string]*ent.Message{
+			"private": {ID: "private", Content: "secret", Role: "user", ConversationID: "c1"},
+			"public":  {ID: "public", Content: "hello", Role: "user", ConversationID: "c2"},
+			"hidden":  {ID: "hidden", Content: "pending", Role: "user", ConversationID: "c2", Hidden: true},
+		},
+		owners:   map[string]string{"private": "alice", "public": "alice", "hidden": "alice"},
+		readable: map[string]bool{"c2": true},
+		keys: map[string]*ent.APIKey{
+			auth.HashAPIKey("cgc_alice"): {Scopes: []string{string(PermRead)}, Edges: ent.APIKeyEdges{User: &ent.User{ID: "alice"}}},
+			auth.HashAPIKey("cgc_bob"):   {Scopes: []string{string(PermRead)}, Edges: ent.APIKeyEdges{User: &ent.User{ID: "bob"}}},
+			// 管理员的只读 key 不能读取其他用户未公开的会话
+			auth.HashAPIKey("cgc_root_read"):    {Scopes: []string{string(PermRead)}, Edges: ent.APIKeyEdges{User: root}},
+			auth.HashAPIKey("cgc_root_private"): {Scopes: []string{string(PermRead), string(PermReadPrivate)}, Edges: ent.APIKeyEdges{User: root}},
+		},
+	}
+	api := New(store)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.GET("/api/v1/message", api.Require(PermRead), api.GetChatGPTMessage)
+
+	for _, tc := range []struct {
+		id     string
+		key    string
+		status int
+	}{
+		{"private", "", http.StatusNotFound},
+		{"private", "cgc_bob", http.StatusNotFound},
+		{"private", "cgc_alice", http.StatusOK},
+		{"public", "", http.StatusOK},
+		{"hidden", "cgc_bob", http.StatusNotFound},
+		{"hidden", "cgc_alice", http.StatusOK},
+		{"missing", "cgc_alice", http.StatusNotFound},
+		{"private", "cgc_root_read", http.StatusNotFound},
+		{"private", "cgc_root_private", http.StatusOK},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/message?id="+tc.id, nil)
+		if tc.key != "" {
+			req.Header.Set("Authorization", "Bearer "+tc.key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != tc.status {
+			t.Errorf("GET %s with %q = %d, want %d", tc.id, tc.key, w.Code, tc.status)
+			continue
+		}
+		if w.Code == http.StatusNotFound {
+			var e Error
+			// 不存在和没有权限读取的消息返回相同的错误
+			if err := json.Unmarshal(w.Body.Bytes(), &e); err != nil || e.Code != CodeNotFound || e.Message != errConversationNotFound.Message || e.RequestID == "" {
+				t.Errorf("GET %s with %q error = %s", tc.id, tc.key, w.Body.String())
+			}
+		}
+	}
+	if len(store.audits) != 1 || store.audits[0] != "conversation.read_private" {
+		t.Errorf("audits = %v", store.audits)
+	}
+}
+
+func TestGetChatGPTConversation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	created := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	deletedAt := created.Add(time.Hour)
+	store := &fakeStore{
+		messages: map[string]*ent.Message{
+			"q1": {ID: "q1", Content: "hello", Role: "user", ConversationID: "c1", CreatedAt: created},
+			"a1": {ID: "a1", Content: "hi", Role: "assistant", ConversationID: "c1", CreatedAt: created.Add(time.Second)},
+			"q2": {ID: "q2", Content: "pending", Role: "user", ConversationID: "c1", Hidden: true, CreatedAt: created.Add(2 * time.Second)},
+			"q3": {ID: "q3", Content: "removed", Role: "user", ConversationID: "c1", DeletedAt: &deletedAt, CreatedAt: created.Add(3 * time.Second)},
+			"p1": {ID: "p1", Content: "secret", Role: "user", ConversationID: "c2", CreatedAt: created},
+		},
+		conversations: map[string]string{"c1": "alice", "c2": "alice"},
+		readable:      map[string]bool{"c1": true},
+		keys: map[string]*ent.APIKey{
+			auth.HashAPIKey("cgc_alice"): {Scopes: []string{string(PermRead)}, Edges: ent.APIKeyEdges{User: &ent.User{ID: "alice"}}},
+			auth.HashAPIKey("cgc_bob"):   {Scopes: []string{string(PermRead)}, Edges: ent.APIKeyEdges{User: &ent.User{ID: "bob"}}},
+		},
+	}
+	api := New(store)
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.GET("/api/v1/conversation", api.Require(PermRead), api.GetChatGPTConversation)
+
+	for _, tc := range []struct {
+		id     string
+		key    string
+		status int
+		want   []string
+	}{
+		// 所有者可以读取被隐藏的消息，被删除的消息只保留位置
+		{"c1", "cgc_alice", http.StatusOK, []string{"q1:hello", "a1:hi", "q2:pending", "q3:"}},
+		{"c1", "cgc_bob", http.StatusOK, []string{"q1:hello", "a1:hi", "q3:"}},
+		{"c1", "", http.StatusOK, []string{"q1:hello", "a1:hi", "q3:"}},
+		{"c2", "cgc_alice", http.StatusOK, []string{"p1:secret"}},
+		{"c2", "cgc_bob", http.StatusNotFound, nil},
+		{"c2", "", http.StatusNotFound, nil},
+		{"missing", "cgc_bob", http.StatusNotFound, nil},
+		{"", "cgc_alice", http.StatusBadRequest, nil},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/conversation?id="+tc.id, nil)
+		if tc.key != "" {
+			req.Header.Set("Authorization", "Bearer "+tc.key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != tc.status {
+			t.Errorf("GET %s with %q = %d, want %d", tc.id, tc.key, w.Code, tc.status)
+			continue
+		}
+		switch w.Code {
+		case http.StatusOK:
+			var messages []*ent.Message
+			if err := json.Unmarshal(w.Body.Bytes(), &messages); err != nil {
+				t.Fatal(err)
+			}
+			got := make([]string, len(messages))
+			for i, m := range messages {
+				got[i] = m.ID + ":" + m.Content
+			}
+			if strings.Join(got, "|") != strings.Join(tc.want, "|") {
+				t.Errorf("GET %s with %q = %v, want %v", tc.id, tc.key, got, tc.want)
+			}
+		case http.StatusNotFound:
+			var e Error
+			if err := json.Unmarshal(w.Body.Bytes(), &e); err != nil || e.Message != errConversationNotFound.Message {
+				t.Errorf("GET %s with %q error = %s", tc.id, tc.key, w.Body.String())
+			}
+		}
+	}
+}
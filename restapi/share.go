@@ -0,0 +1,147 @@
+package restapi
+
+import (
+	"net/http"
+
+	"community.threetenth.chatgpt/ent"
+	"community.threetenth.chatgpt/ent/topic"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// PostTopic 将自己的会话发布为主题，或修改主题的标题和可见性
+//
+// visibility 可选 private、unlisted、public，默认为 private；category 默认为 general。
+func (api *API) PostTopic(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		ConversationID string `json:"conversation_id" binding:"required"`
+		Title          string `json:"title" binding:"required"`
+		Visibility     string `json:"visibility"`
+		Category       string `json:"category"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+
+	visibility := topic.VisibilityPrivate
+	if body.Visibility != "" {
+		visibility = topic.Visibility(body.Visibility)
+		if err := topic.VisibilityValidator(visibility); err != nil {
+			fail(c, invalidRequest(err))
+			return
+		}
+	}
+
+	if body.Category == "" {
+		body.Category = "general"
+	}
+
+	if !api.checkConversationOwner(c, body.ConversationID, userID) {
+		return
+	}
+
+	t, err := api.store.SaveTopic(c.Request.Context(), body.ConversationID, body.Title, body.Category, visibility, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, newError(http.StatusNotFound, CodeNotFound, "conversation not found"))
+			return
+		}
+		if ent.IsValidationError(err) {
+			fail(c, invalidRequest(err))
+			return
+		}
+		log.WithFields(log.Fields{
+			"method": "restapi.PostTopic",
+			"event":  "db.SaveTopic",
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, t)
+}
+
+// PostShare 为自己的会话创建一个只读快照分享
+func (api *API) PostShare(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		ConversationID string `json:"conversation_id" binding:"required"`
+		Title          string `json:"title"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		fail(c, invalidRequest(err))
+		return
+	}
+
+	s, err := api.store.CreateShare(c.Request.Context(), body.ConversationID, body.Title, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, newError(http.StatusNotFound, CodeNotFound, "conversation not found"))
+			return
+		}
+		log.WithFields(log.Fields{
+			"method": "restapi.PostShare",
+			"event":  "db.CreateShare",
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+
+	api.audit(c, userID, "share.create", "share", s.ID, map[string]interface{}{"conversation_id": s.ConversationID})
+	c.JSON(http.StatusOK, s)
+}
+
+// DeleteShare 撤销一个自己创建的分享
+func (api *API) DeleteShare(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Query("id")
+	if id == "" {
+		fail(c, newError(http.StatusBadRequest, CodeInvalidRequest, "id can't empty"))
+		return
+	}
+
+	if err := api.store.RevokeShare(c.Request.Context(), id, userID); err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, newError(http.StatusNotFound, CodeNotFound, "share not found"))
+			return
+		}
+		log.WithFields(log.Fields{
+			"method": "restapi.DeleteShare",
+			"event":  "db.RevokeShare",
+		}).Info(err.Error())
+		fail(c, err)
+		return
+	}
+
+	api.audit(c, userID, "share.revoke", "share", id, nil)
+	c.String(http.StatusOK, "OK")
+}
+
+// checkConversationOwner 检查会话是否属于当前用户，不属于时返回 404
+func (api *API) checkConversationOwner(c *gin.Context, conversationID, userID string) bool {
+	ok, err := api.store.IsConversationOwner(c.Request.Context(), conversationID, userID)
+	if err != nil {
+		fail(c, err)
+		return false
+	}
+	if !ok {
+		fail(c, newError(http.StatusNotFound, CodeNotFound, "conversation not found"))
+		return false
+	}
+	return true
+}
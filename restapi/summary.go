@@ -0,0 +1,76 @@
+package restapi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	entmessage "community.threetenth.chatgpt/ent/message"
+	"community.threetenth.chatgpt/openai"
+	"community.threetenth.chatgpt/summary"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// summaryTimeout 是生成一次标题和摘要的最长时间
+const summaryTimeout = 2 * time.Minute
+
+// summarizing 是正在生成标题和摘要的会话，同一个会话同时只生成一次
+var summarizing sync.Map
+
+// summarize 在后台使用会话所有者的 ChatGPT 凭据为会话生成标题和摘要
+//
+// 第一次回复后生成，之后会话的消息数量明显增长时重新生成；
+// 生成失败只输出日志，下一次回复后会再次尝试。
+func (api *API) summarize(conversationID string, accessToken openai.Credential, model string) {
+	if conversationConfig.DisableSummaries {
+		return
+	}
+	if _, running := summarizing.LoadOrStore(conversationID, true); running {
+		return
+	}
+	go func() {
+		defer summarizing.Delete(conversationID)
+		ctx, cancel := context.WithTimeout(context.Background(), summaryTimeout)
+		defer cancel()
+
+		if err := api.generateSummary(ctx, conversationID, summary.NewChatGPT(accessToken, model)); err != nil {
+			log.WithFields(log.Fields{
+				"method":          "restapi.summarize",
+				"event":           "generateSummary",
+				"conversation_id": conversationID,
+			}).Info(err.Error())
+		}
+	}()
+}
+
+// generateSummary 在会话需要时生成并保存标题和摘要，只使用公开显示并且没有被删除的消息
+func (api *API) generateSummary(ctx context.Context, conversationID string, generator summary.Generator) error {
+	c, err := api.store.GetConversation(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if !summary.NeedsUpdate(c.MessageCount, c.SummaryMessageCount) {
+		return nil
+	}
+
+	messages, err := api.store.GetConversationMessages(ctx, conversationID, false)
+	if err != nil {
+		return err
+	}
+	input := make([]*summary.Message, 0, len(messages))
+	for _, m := range messages {
+		if m.Status == entmessage.StatusComplete && m.DeletedAt == nil {
+			input = append(input, &summary.Message{Role: m.Role, Content: m.Content})
+		}
+	}
+	if len(input) == 0 {
+		return nil
+	}
+
+	s, err := generator.Generate(ctx, input)
+	if err != nil {
+		return err
+	}
+	return api.store.SaveConversationSummary(ctx, conversationID, s.Title, s.Summary, c.MessageCount)
+}
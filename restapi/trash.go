@@ -0,0 +1,107 @@
+package restapi
+
+import (
+	"errors"
+	"net/http"
+
+	"community.threetenth.chatgpt/db"
+	"community.threetenth.chatgpt/ent"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// trashResult 统一处理作者删除和恢复的结果，成功时记录审计日志
+//
+// 不存在、已经是目标状态或者不属于当前用户时都返回 404，避免枚举 ID。
+func (api *API) trashResult(c *gin.Context, err error, userID, action, targetType, targetID string) {
+	if err != nil {
+		if ent.IsNotFound(err) {
+			fail(c, newError(http.StatusNotFound, CodeNotFound, targetType+" not found"))
+			return
+		}
+		if !errors.Is(err, db.ErrRemoved) {
+			log.WithFields(log.Fields{
+				"method": "restapi.trashResult",
+				"event":  action,
+			}).Info(err.Error())
+		}
+		fail(c, err)
+		return
+	}
+
+	api.audit(c, userID, action, targetType, targetID, nil)
+	c.String(http.StatusOK, "OK")
+}
+
+// DeleteConversation 删除自己的会话，会话进入回收站，保留期限内可以恢复
+func (api *API) DeleteConversation(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.DeleteConversation(c.Request.Context(), id, userID)
+	api.trashResult(c, err, userID, "conversation.delete", "conversation", id)
+}
+
+// PostConversationRestore 从回收站恢复自己的会话
+func (api *API) PostConversationRestore(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.RestoreConversation(c.Request.Context(), id, userID)
+	api.trashResult(c, err, userID, "conversation.restore", "conversation", id)
+}
+
+// DeleteMessage 删除自己的一条消息，消息在会话中显示为占位
+func (api *API) DeleteMessage(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.DeleteMessage(c.Request.Context(), id, userID, false)
+	api.trashResult(c, err, userID, "message.delete", "message", id)
+}
+
+// PostMessageRestore 恢复自己删除的一条消息，被管理员删除的消息不能恢复
+func (api *API) PostMessageRestore(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.RestoreMessage(c.Request.Context(), id, userID, false)
+	api.trashResult(c, err, userID, "message.restore", "message", id)
+}
+
+// DeleteTopic 删除自己发布的主题，会话恢复为 private，会话中的消息不受影响
+func (api *API) DeleteTopic(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.DeleteTopic(c.Request.Context(), id, userID, false)
+	api.trashResult(c, err, userID, "topic.delete", "topic", id)
+}
+
+// PostTopicRestore 恢复自己删除的主题，被管理员删除的主题不能恢复
+func (api *API) PostTopicRestore(c *gin.Context) {
+	userID, ok := api.getUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	err := api.store.RestoreTopic(c.Request.Context(), id, userID, false)
+	api.trashResult(c, err, userID, "topic.restore", "topic", id)
+}
@@ -0,0 +1,63 @@
+package summary
+
+import (
+	"context"
+
+	"community.threetenth.chatgpt/openai"
+	"github.com/google/uuid"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ChatGPT 使用会话所有者的 ChatGPT 凭据生成标题和摘要
+type ChatGPT struct {
+	accessToken openai.Credential
+	model       string
+}
+
+// NewChatGPT 创建一个使用 ChatGPT 生成标题和摘要的 Generator
+func NewChatGPT(accessToken openai.Credential, model string) *ChatGPT {
+	return &ChatGPT{accessToken: accessToken, model: model}
+}
+
+// Generate 实现 Generator 接口
+//
+// 提示在一个新的 ChatGPT 会话中发送，回复后在用户的 ChatGPT 会话列表中隐藏该会话。
+// ctx 取消或超时时中断上游请求。
+func (g *ChatGPT) Generate(ctx context.Context, messages []*Message) (*Summary, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	response, err := openai.PostChatGPTText(ctx, g.accessToken, &openai.ChatRequestBody{
+		Action: "next",
+		Messages: []*openai.ChatMessage{
+			{
+				ID:   uuid.NewString(),
+				Role: "user",
+				Content: &openai.ChatContent{
+					ContentType: "text",
+					Parts:       []string{Prompt(messages)},
+				},
+			},
+		},
+		ParentMessageID: uuid.NewString(),
+		Model:           g.model,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.ConversationID != "" {
+		if err = openai.HideChatGPTConversation(ctx, g.accessToken, response.ConversationID); err != nil {
+			log.WithFields(log.Fields{
+				"method": "summary.ChatGPT.Generate",
+				"event":  "openai.HideChatGPTConversation",
+			}).Info(err.Error())
+		}
+	}
+
+	if response.Message == nil || response.Message.Content == nil || len(response.Message.Content.Parts) == 0 {
+		return nil, ErrInvalidAnswer
+	}
+	return Parse(response.Message.Content.Parts[0])
+}
@@ -0,0 +1,109 @@
+package summary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// MaxTitleLength 是生成的标题的最大字符数
+const MaxTitleLength = 64
+
+// maxMessageLength 是提示中每条消息的最大字符数
+const maxMessageLength = 1000
+
+// maxMessages 是提示中最多包含的消息数量，超出时保留开头的 headMessages 条和最后的消息
+const maxMessages = 20
+
+const headMessages = 4
+
+// ErrInvalidAnswer 是回复中没有可以解析的标题和摘要
+var ErrInvalidAnswer = errors.New("summary: answer does not contain a title and summary")
+
+// Message 是会话中的一条消息
+type Message struct {
+	Role    string
+	Content string
+}
+
+// Summary 是会话的标题和一段摘要，使用会话的语言
+type Summary struct {
+	Title   string `json:"title"`
+	Summary string `json:"summary"`
+}
+
+// Generator 根据会话的消息生成标题和摘要
+type Generator interface {
+	Generate(ctx context.Context, messages []*Message) (*Summary, error)
+}
+
+// NeedsUpdate 判断会话是否需要生成标题和摘要
+//
+// 第一次回复后生成；之后会话的消息数量增长到上次生成时的两倍时重新生成。
+// summarized 是上次生成时会话的消息数量，没有生成过时为 0。
+func NeedsUpdate(messageCount, summarized int) bool {
+	if summarized == 0 {
+		return messageCount >= 2
+	}
+	return messageCount >= summarized*2
+}
+
+// Prompt 创建生成标题和摘要的提示，要求以 JSON 回复
+func Prompt(messages []*Message) string {
+	if len(messages) > maxMessages {
+		tail := messages[len(messages)-(maxMessages-headMessages):]
+		messages = append(messages[:headMessages:headMessages], tail...)
+	}
+
+	var b strings.Builder
+	b.WriteString("Read the conversation below, then write a short title (at most 8 words) and a one-paragraph summary of it. ")
+	b.WriteString("Write both in the same language as the conversation. ")
+	b.WriteString(`Reply with only a JSON object like {"title": "...", "summary": "..."}.`)
+	b.WriteString("\n")
+	for _, m := range messages {
+		speaker := "ChatGPT"
+		if m.Role == "user" {
+			speaker = "User"
+		}
+		b.WriteString("\n")
+		b.WriteString(speaker)
+		b.WriteString(": ")
+		b.WriteString(truncate(strings.TrimSpace(m.Content), maxMessageLength))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Parse 解析回复中的标题和摘要
+//
+// 回复可能在 JSON 前后带有说明或者代码块标记，只解析第一个 { 和最后一个 } 之间的内容。
+// 标题超过 MaxTitleLength 时截断，摘要合并为一段。
+func Parse(answer string) (*Summary, error) {
+	start := strings.Index(answer, "{")
+	end := strings.LastIndex(answer, "}")
+	if start < 0 || end < start {
+		return nil, ErrInvalidAnswer
+	}
+	var s Summary
+	if err := json.Unmarshal([]byte(answer[start:end+1]), &s); err != nil {
+		return nil, ErrInvalidAnswer
+	}
+
+	s.Title = strings.Trim(strings.Join(strings.Fields(s.Title), " "), `"'“”「」《》`)
+	s.Title = truncate(s.Title, MaxTitleLength)
+	s.Summary = strings.Join(strings.Fields(s.Summary), " ")
+	if s.Title == "" || s.Summary == "" {
+		return nil, ErrInvalidAnswer
+	}
+	return &s, nil
+}
+
+// truncate 按字符截断文本，超出部分以省略号代替
+func truncate(s string, n int) string {
+	rs := []rune(s)
+	if len(rs) <= n {
+		return s
+	}
+	return string(rs[:n]) + "…"
+}
@@ -0,0 +1,65 @@
+package summary
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNeedsUpdate(t *testing.T) {
+	cases := []struct {
+		count, summarized int
+		want              bool
+	}{
+		{1, 0, false},
+		{2, 0, true},
+		{3, 2, false},
+		{4, 2, true},
+		{7, 4, false},
+		{8, 4, true},
+	}
+	for _, c := range cases {
+		if got := NeedsUpdate(c.count, c.summarized); got != c.want {
+			t.Errorf("NeedsUpdate(%d, %d) = %v, want %v", c.count, c.summarized, got, c.want)
+		}
+	}
+}
+
+func TestPrompt(t *testing.T) {
+	var messages []*Message
+	for i := 0; i < 30; i++ {
+		role := "user"
+		if i%2 == 1 {
+			role = "assistant"
+		}
+		messages = append(messages, &Message{Role: role, Content: string(rune('a'+i%26)) + strings.Repeat("x", 2000)})
+	}
+	prompt := Prompt(messages)
+	if n := strings.Count(prompt, "\nUser: ") + strings.Count(prompt, "\nChatGPT: "); n != maxMessages {
+		t.Errorf("prompt contains %d messages, want %d", n, maxMessages)
+	}
+	if !strings.Contains(prompt, "User: a") || !strings.Contains(prompt, "ChatGPT: d") || strings.Contains(prompt, "User: e") {
+		t.Error("prompt should keep the first messages and drop the middle of the conversation")
+	}
+	if strings.Contains(prompt, strings.Repeat("x", maxMessageLength+1)) {
+		t.Error("long messages should be truncated")
+	}
+	if len(messages) != 30 || !strings.HasPrefix(messages[4].Content, "e") {
+		t.Error("Prompt should not modify the messages")
+	}
+}
+
+func TestParse(t *testing.T) {
+	s, err := Parse("Here it is:\n```json\n{\"title\": \" \\\"Go 并发\\\" \", \"summary\": \"讨论了 goroutine\\n\\n和 channel。\"}\n```")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Title != "Go 并发" || s.Summary != "讨论了 goroutine 和 channel。" {
+		t.Errorf("Parse = %+v", s)
+	}
+
+	for _, answer := range []string{"no json", `{"title": "", "summary": "x"}`, `{"title": "x"}`, `{"title": 1}`} {
+		if _, err = Parse(answer); err != ErrInvalidAnswer {
+			t.Errorf("Parse(%q) = %v, want ErrInvalidAnswer", answer, err)
+		}
+	}
+}
@@ -33,9 +33,9 @@ function captcha(c) {
           cfClearance: cfClearanceInput.value,
           userAgent: userAgentInput.value,
         })
-      }).then(response => {
+      }).then(async response => {
         if (response.status !== 200) {
-          throw new Error(`${response.status} ${response.statusText}`)
+          throw new Error(await errorMessage(response))
         }
         router.start(indexState)
       }).catch(e => {
@@ -53,6 +53,46 @@ function captcha(c) {
   c.push(false)
 }
 
+/**
+ * 读取接口返回的错误信息，错误为 {code, message, details, request_id} 格式
+ * @param {Response} response
+ * @returns {Promise<string>}
+ */
+async function errorMessage(response) {
+  let text = await response.text()
+  try {
+    let err = JSON.parse(text)
+    return `${err.message} (${err.code}, request id: ${err.request_id})`
+  } catch (e) {
+    return `${response.status} ${text}`
+  }
+}
+
+/**
+ * 提交 JSON 到接口，失败时在 container 中显示错误
+ * @param {string} url 接口地址
+ * @param {object} body 请求的 JSON 数据
+ * @param {HTMLElement} container 显示错误的元素
+ * @returns {Promise<Response>}
+ */
+function postJSON(url, body, container) {
+  return fetch(url, {
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    body: JSON.stringify(body),
+  }).then(async response => {
+    if (response.status >= 300) {
+      throw new Error(await errorMessage(response))
+    }
+    return response
+  }).catch(e => {
+    let errEl = div()
+    errEl.innerText = e.message
+    container.appendChild(errEl)
+    throw e
+  })
+}
+
 /**
  * @param {Context} c
  */
@@ -60,12 +100,74 @@ function login(c) {
   surface.innerText = ''
   let container = div()
   container.innerText = "Login"
+  let emailInput = input("Email")
+  let passwordInput = input("Password")
+  passwordInput.type = "password"
+  let loginButton = button("Login", {
+    onclick: () => {
+      postJSON("/api/v1/account/login", {
+        email: emailInput.value,
+        password: passwordInput.value,
+      }, container).then(() => {
+        localStorage.setItem("userSession", "cookie")
+        router.start(indexState)
+      })
+    }
+  })
+  let forgotButton = button("Forgot password", {
+    onclick: () => {
+      postJSON("/api/v1/account/password/forgot", {
+        email: emailInput.value,
+      }, container).then(() => {
+        let okEl = div()
+        okEl.innerText = "If the email is registered, a reset link has been sent."
+        container.appendChild(okEl)
+      })
+    }
+  })
+  let ssoButton = button("Login with SSO", {
+    onclick: () => {
+      localStorage.setItem("userSession", "cookie")
+      window.location.href = "/api/v1/account/oidc/login"
+    }
+  })
   let sessionInput = input("Your session token")
+  container.appendChild(emailInput)
+  container.appendChild(passwordInput)
+  container.appendChild(loginButton)
+  container.appendChild(forgotButton)
+  container.appendChild(ssoButton)
   container.appendChild(sessionInput)
   surface.appendChild(container)
   c.push(false)
 }
 
+/**
+ * 使用重置密码邮件中的链接设置新密码
+ * @param {Context} c
+ */
+function resetPassword(c) {
+  surface.innerText = ''
+  let container = div()
+  container.innerText = "Reset password"
+  let passwordInput = input("New password")
+  passwordInput.type = "password"
+  let commitButton = button("Commit", {
+    onclick: () => {
+      postJSON("/api/v1/account/password/reset", {
+        token: c.state.path.query.token,
+        password: passwordInput.value,
+      }, container).then(() => {
+        router.start(loginState)
+      })
+    }
+  })
+  container.appendChild(passwordInput)
+  container.appendChild(commitButton)
+  surface.appendChild(container)
+  c.push(false)
+}
+
 /**
  * @param {Context} c
  */
@@ -98,14 +200,28 @@ function create(c) {
   c.push(true)
 }
 
+/**
+ * 服务端渲染的页面，内容已经在 #ssr 中，不需要再渲染
+ * @param {Context} c
+ */
+function ssr(c) {
+  c.push(false)
+}
+
 const router = new Router()
 
 router.bind("/", index)
 router.bind("/login", login)
-router.bind("/captcha", captcha)
+router.bind("/reset-password", resetPassword)
+router.bind("/topics", ssr)
+router.bind("/topics/:id", ssr)
+router.bind("/c/:category", ssr)
+router.bind("/u/:id", ssr)
+router.bind("/share/:slug", ssr)
 
 const authRouter = router.group("/")
 authRouter.use(authn)
 authRouter.bind("/create", create)
+authRouter.bind("/captcha", captcha)
 
 router.launch()
\ No newline at end of file
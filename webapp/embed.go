@@ -2,8 +2,13 @@ package webapp
 
 import (
 	"embed"
+	htmltemplate "html/template"
+	"io"
+	"os"
 	"path"
 	"text/template"
+
+	"community.threetenth.chatgpt/render"
 )
 
 //go:embed *
@@ -13,8 +18,45 @@ var Dir embed.FS
 // IndexHTML is index.html file name
 var IndexHTML = "index.html"
 
+// ShareHTML is 只读分享页面的文件名
+var ShareHTML = "share.html"
+
+// TopicsHTML is 主题列表页面的文件名
+var TopicsHTML = "topics.html"
+
+// TopicHTML is 主题详情页面的文件名
+var TopicHTML = "topic.html"
+
+// UserHTML is 用户资料页面的文件名
+var UserHTML = "user.html"
+
+// ExportHTML is 导出的会话页面的文件名
+var ExportHTML = "export.html"
+
+// RSSXML is RSS 2.0 feed 的模板文件名
+var RSSXML = "rss.xml"
+
+// AtomXML is Atom feed 的模板文件名
+var AtomXML = "atom.xml"
+
+// SitemapXML is sitemap.xml 的模板文件名
+var SitemapXML = "sitemap.xml"
+
+// Template 是 webapp 中可以执行的模板
+//
+// .html 文件使用 html/template 解析，会根据上下文自动转义数据；
+// 其他文件（.xml、.js 等）使用 text/template 解析，需要在模板中自行转义。
+type Template interface {
+	Execute(wr io.Writer, data interface{}) error
+}
+
+// Funcs 是 html 模板中可以使用的函数
+var Funcs = htmltemplate.FuncMap{
+	"markdown": render.Markdown,
+}
+
 // Webapp 返回 webapp 文件
-func Webapp(name string, debug bool) (*template.Template, error) {
+func Webapp(name string, debug bool) (Template, error) {
 	if name == "" || name == "/" {
 		name = IndexHTML
 	} else {
@@ -23,30 +65,28 @@ func Webapp(name string, debug bool) (*template.Template, error) {
 			name = IndexHTML
 		}
 	}
-	if debug {
-		tmpl, err := template.ParseFiles("../webapp/" + name)
-		if err != nil {
-			tmpl, err = Webapp(IndexHTML, debug)
-			if err != nil {
-				return nil, err
-			}
-		}
-		return tmpl, nil
+
+	tmpl, err := parse(name, debug)
+	if err != nil && name != IndexHTML {
+		return Webapp(IndexHTML, debug)
 	}
+	return tmpl, err
+}
 
-	tmpl := template.New(name)
-	bs, err := Dir.ReadFile(name)
+func parse(name string, debug bool) (Template, error) {
+	var bs []byte
+	var err error
+	if debug {
+		bs, err = os.ReadFile("../webapp/" + name)
+	} else {
+		bs, err = Dir.ReadFile(name)
+	}
 	if err != nil {
-		tmpl, err = Webapp(IndexHTML, debug)
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
 	}
-	if _, err = tmpl.Parse(string(bs)); err != nil {
-		tmpl, err = Webapp(IndexHTML, debug)
-		if err != nil {
-			return nil, err
-		}
+
+	if path.Ext(name) == ".html" {
+		return htmltemplate.New(name).Funcs(Funcs).Parse(string(bs))
 	}
-	return tmpl, nil
+	return template.New(name).Parse(string(bs))
 }